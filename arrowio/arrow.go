@@ -0,0 +1,192 @@
+// Package arrowio converts gambas DataFrame objects to and from Apache Arrow records.
+// It is a separate module, so that only programs that use Arrow depend on it.
+package arrowio
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/jpoly1219/gambas"
+)
+
+// arrowIndexKey is the schema metadata key that stores the names of the index columns.
+const arrowIndexKey = "gambas.index"
+
+// ToArrow converts a DataFrame object to an Arrow record.
+// int, float64, string, and bool columns become int64, float64, utf8, and boolean Arrow columns, and missing values become nulls.
+// The names of the index columns are kept in the schema metadata, so that FromArrow can restore the index.
+// The caller is responsible for calling Release on the returned record.
+func ToArrow(df *gambas.DataFrame) (arrow.Record, error) {
+	series := df.Series()
+	fields := make([]arrow.Field, len(series))
+	for i, ser := range series {
+		var dataType arrow.DataType
+		switch ser.Dtype() {
+		case "int":
+			dataType = arrow.PrimitiveTypes.Int64
+		case "float64":
+			dataType = arrow.PrimitiveTypes.Float64
+		case "string":
+			dataType = arrow.BinaryTypes.String
+		case "bool":
+			dataType = arrow.FixedWidthTypes.Boolean
+		default:
+			return nil, fmt.Errorf("cannot convert column '%v' of dtype %q to Arrow", ser.Name(), ser.Dtype())
+		}
+		fields[i] = arrow.Field{Name: ser.Name(), Type: dataType, Nullable: true}
+	}
+
+	// only index names that refer to columns can be restored, so a RangeIndex is not stored
+	indexCols := make([]string, 0)
+	for _, name := range df.Index().Names() {
+		if containsString(df.Columns(), name) {
+			indexCols = append(indexCols, name)
+		}
+	}
+	indexJson, err := json.Marshal(indexCols)
+	if err != nil {
+		return nil, err
+	}
+	metadata := arrow.NewMetadata([]string{arrowIndexKey}, []string{string(indexJson)})
+	schema := arrow.NewSchema(fields, &metadata)
+
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+
+	for i, ser := range series {
+		switch fb := b.Field(i).(type) {
+		case *array.Int64Builder:
+			for _, data := range ser.Data() {
+				if v, ok := data.(int); ok {
+					fb.Append(int64(v))
+				} else {
+					fb.AppendNull()
+				}
+			}
+		case *array.Float64Builder:
+			for _, data := range ser.Data() {
+				if v, ok := data.(float64); ok && !math.IsNaN(v) {
+					fb.Append(v)
+				} else {
+					fb.AppendNull()
+				}
+			}
+		case *array.StringBuilder:
+			for _, data := range ser.Data() {
+				if isMissing(data) {
+					fb.AppendNull()
+				} else {
+					fb.Append(fmt.Sprint(data))
+				}
+			}
+		case *array.BooleanBuilder:
+			for _, data := range ser.Data() {
+				if v, ok := data.(bool); ok {
+					fb.Append(v)
+				} else {
+					fb.AppendNull()
+				}
+			}
+		}
+	}
+
+	return b.NewRecord(), nil
+}
+
+// FromArrow creates a new DataFrame object from an Arrow record.
+// Integer columns become int columns, floating point columns become float64 columns,
+// and utf8 and boolean columns become string and bool columns.
+// Nulls become NA in int columns and NaN in the other columns.
+// If the record was created by ToArrow, the index is restored. Otherwise, a RangeIndex is created.
+func FromArrow(rec arrow.Record) (gambas.DataFrame, error) {
+	columns := make([]string, rec.NumCols())
+	data := make([][]interface{}, rec.NumCols())
+	for i, col := range rec.Columns() {
+		columns[i] = rec.ColumnName(i)
+		data[i] = make([]interface{}, col.Len())
+
+		var value func(j int) interface{}
+		var missing interface{} = math.NaN()
+		switch arr := col.(type) {
+		case *array.Int8:
+			value, missing = func(j int) interface{} { return int(arr.Value(j)) }, gambas.NA
+		case *array.Int16:
+			value, missing = func(j int) interface{} { return int(arr.Value(j)) }, gambas.NA
+		case *array.Int32:
+			value, missing = func(j int) interface{} { return int(arr.Value(j)) }, gambas.NA
+		case *array.Int64:
+			value, missing = func(j int) interface{} { return int(arr.Value(j)) }, gambas.NA
+		case *array.Uint8:
+			value, missing = func(j int) interface{} { return int(arr.Value(j)) }, gambas.NA
+		case *array.Uint16:
+			value, missing = func(j int) interface{} { return int(arr.Value(j)) }, gambas.NA
+		case *array.Uint32:
+			value, missing = func(j int) interface{} { return int(arr.Value(j)) }, gambas.NA
+		case *array.Float32:
+			value = func(j int) interface{} { return float64(arr.Value(j)) }
+		case *array.Float64:
+			value = func(j int) interface{} { return arr.Value(j) }
+		case *array.String:
+			value = func(j int) interface{} { return arr.Value(j) }
+		case *array.LargeString:
+			value = func(j int) interface{} { return arr.Value(j) }
+		case *array.Boolean:
+			value = func(j int) interface{} { return arr.Value(j) }
+		default:
+			return gambas.DataFrame{}, fmt.Errorf("cannot convert column '%v' of Arrow type %v", columns[i], col.DataType())
+		}
+
+		for j := 0; j < col.Len(); j++ {
+			if col.IsNull(j) {
+				data[i][j] = missing
+			} else {
+				data[i][j] = value(j)
+			}
+		}
+	}
+
+	var indexCols []string
+	metadata := rec.Schema().Metadata()
+	if k := metadata.FindKey(arrowIndexKey); k >= 0 {
+		if err := json.Unmarshal([]byte(metadata.Values()[k]), &indexCols); err != nil {
+			return gambas.DataFrame{}, err
+		}
+		if len(indexCols) == 0 {
+			indexCols = nil
+		}
+	}
+	for _, indexCol := range indexCols {
+		if !containsString(columns, indexCol) {
+			return gambas.DataFrame{}, fmt.Errorf("%w: %v", gambas.ErrColumnNotFound, indexCol)
+		}
+	}
+
+	return gambas.NewDataFrame(data, columns, indexCols)
+}
+
+// isMissing checks whether a single element of a Series represents a missing value, in the same way as gambas does.
+func isMissing(data interface{}) bool {
+	switch v := data.(type) {
+	case nil:
+		return true
+	case float64:
+		return math.IsNaN(v)
+	case string:
+		return v == "NaN"
+	}
+	return data == gambas.NA
+}
+
+// containsString checks whether s is one of the strings in strs.
+func containsString(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package arrowio
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jpoly1219/gambas"
+)
+
+func TestArrowRoundTrip(t *testing.T) {
+	type arrowRoundTripTest struct {
+		data      [][]interface{}
+		columns   []string
+		indexCols []string
+	}
+	tests := []arrowRoundTripTest{
+		{
+			[][]interface{}{
+				{"Avery Bradley", "John Holland", "Jonas Jerebko"},
+				{0, gambas.NA, 8},
+				{25.0, math.NaN(), 29.0},
+				{true, false, true},
+			},
+			[]string{"Name", "Number", "Age", "Starter"},
+			[]string{"Name"},
+		},
+		{
+			[][]interface{}{
+				{"Boston Celtics", math.NaN(), "Utah Jazz"},
+				{1.5, 2.5, 3.5},
+			},
+			[]string{"Team", "Weight"},
+			nil,
+		},
+		{
+			[][]interface{}{
+				{"Boston Celtics", "Boston Celtics", "Utah Jazz"},
+				{"PG", "SF", "C"},
+				{1, 2, 3},
+			},
+			[]string{"Team", "Position", "Number"},
+			[]string{"Team", "Position"},
+		},
+	}
+	for _, test := range tests {
+		df, err := gambas.NewDataFrame(test.data, test.columns, test.indexCols)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rec, err := ToArrow(&df)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer rec.Release()
+
+		output, err := FromArrow(rec)
+		if !cmp.Equal(output, df, cmp.AllowUnexported(gambas.DataFrame{}, gambas.Series{}, gambas.IndexData{}, gambas.Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", df, output, err)
+		}
+	}
+}
+
+func TestArrowToArrowNulls(t *testing.T) {
+	df, err := gambas.NewDataFrame(
+		[][]interface{}{
+			{1, gambas.NA, 3},
+			{1.0, math.NaN(), 3.0},
+			{"a", math.NaN(), "c"},
+		},
+		[]string{"Int", "Float", "String"},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec, err := ToArrow(&df)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer rec.Release()
+
+	for i, col := range rec.Columns() {
+		if col.NullN() != 1 || !col.IsNull(1) {
+			t.Fatalf("expected one null at position 1 in column %v, got %v nulls", df.Columns()[i], col.NullN())
+		}
+	}
+	expectedTypes := []string{"int64", "float64", "utf8"}
+	for i, field := range rec.Schema().Fields() {
+		if fmt.Sprint(field.Type) != expectedTypes[i] {
+			t.Fatalf("expected type %v, got %v", expectedTypes[i], field.Type)
+		}
+	}
+}
+
+func BenchmarkArrowToArrow(b *testing.B) {
+	df, _ := gambas.ReadCsv("../testfiles/nba.csv", []string{"Name"})
+	for i := 0; i < b.N; i++ {
+		rec, _ := ToArrow(&df)
+		rec.Release()
+	}
+}
@@ -1,11 +1,20 @@
 package gambas
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
+	"math/rand"
 	"os"
+	"regexp"
 	"sort"
+	"strings"
 	"text/tabwriter"
 )
 
@@ -28,32 +37,107 @@ func (df DataFrame) Columns() []string {
 	return df.columns
 }
 
+// IndexValues returns the index tuple of each row, in row order.
+// The returned slices are copies, so modifying them does not change the DataFrame.
+func (df DataFrame) IndexValues() [][]interface{} {
+	values := make([][]interface{}, len(df.index.index))
+	for i, index := range df.index.index {
+		values[i] = append([]interface{}{}, index.value...)
+	}
+
+	return values
+}
+
+// seriesJson, indexJson and dataFrameJson define the serialized layout shared by the JSON and gob methods.
+type seriesJson struct {
+	Data  []interface{} `json:"data"`
+	Name  string        `json:"name"`
+	Dtype string        `json:"dtype"`
+}
+
+type indexJson struct {
+	Id    int           `json:"id"`
+	Value []interface{} `json:"value"`
+}
+
+type indexDataJson struct {
+	Index []indexJson `json:"index"`
+	Names []string    `json:"names"`
+}
+
+type dataFrameJson struct {
+	Series  []seriesJson  `json:"series"`
+	Index   indexDataJson `json:"index"`
+	Columns []string      `json:"columns"`
+}
+
 // MarshalJSON is used to implement the json.Marshaler interface{}.
-func (df *DataFrame) MarshalJSON() ([]byte, error) {
-	type serJson struct {
-		Data  []interface{} `json:"data"`
-		Name  string        `json:"name"`
-		Dtype string        `json:"dtype"`
+// The index is stored alongside the series so that UnmarshalJSON can restore it.
+// It has a value receiver so that DataFrame fields are encoded even when the enclosing struct is not addressable.
+func (df DataFrame) MarshalJSON() ([]byte, error) {
+	dfj := df.toDataFrameJson(jsonValue)
+	return json.Marshal(dfj)
+}
+
+// UnmarshalJSON is used to implement the json.Unmarshaler interface{}.
+// It reads the layout written by MarshalJSON.
+// If the "index" key is absent, as in JSON written by older versions, a RangeIndex is created instead.
+// Numbers are decoded as json.Number, and each value is converted back using the dtype of its series.
+func (df *DataFrame) UnmarshalJSON(b []byte) error {
+	dfj := new(dataFrameJson)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(dfj); err != nil {
+		return err
+	}
+
+	newDf, err := dfj.toDataFrame()
+	if err != nil {
+		return err
+	}
+	*df = newDf
+	return nil
+}
+
+// list cells are sent as []interface{} values, which gob needs to know about to encode them inside an interface{}
+func init() {
+	gob.Register([]interface{}{})
+}
+
+// GobEncode is used to implement the gob.GobEncoder interface{}.
+// It writes the same layout as MarshalJSON, but NaN values are kept as they are.
+func (df DataFrame) GobEncode() ([]byte, error) {
+	dfj := df.toDataFrameJson(gobValue)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(dfj); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode is used to implement the gob.GobDecoder interface{}.
+func (df *DataFrame) GobDecode(b []byte) error {
+	dfj := new(dataFrameJson)
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(dfj); err != nil {
+		return err
 	}
-	type dfJson struct {
-		Series  []serJson `json:"series"`
-		Columns []string  `json:"columns"`
+
+	newDf, err := dfj.toDataFrame()
+	if err != nil {
+		return err
 	}
+	*df = newDf
+	return nil
+}
 
-	serjs := make([]serJson, 0)
+// toDataFrameJson copies df into the serialized layout, passing every value through convert.
+func (df DataFrame) toDataFrameJson(convert func(interface{}) interface{}) dataFrameJson {
+	serjs := make([]seriesJson, 0)
 	for _, ser := range df.series {
-		serj := new(serJson)
+		serj := new(seriesJson)
 		for _, data := range ser.data {
-			switch v := data.(type) {
-			case float64:
-				if math.IsNaN(v) {
-					serj.Data = append(serj.Data, nil)
-				} else {
-					serj.Data = append(serj.Data, data)
-				}
-			default:
-				serj.Data = append(serj.Data, data)
-			}
+			serj.Data = append(serj.Data, convert(data))
 		}
 		serj.Name = ser.name
 		serj.Dtype = ser.dtype
@@ -61,54 +145,152 @@ func (df *DataFrame) MarshalJSON() ([]byte, error) {
 		serjs = append(serjs, *serj)
 	}
 
-	dfj := new(dfJson)
+	dfj := new(dataFrameJson)
 	dfj.Series = append(dfj.Series, serjs...)
+	for _, index := range df.index.index {
+		value := make([]interface{}, len(index.value))
+		for i, data := range index.value {
+			value[i] = convert(data)
+		}
+		dfj.Index.Index = append(dfj.Index.Index, indexJson{index.id, value})
+	}
+	dfj.Index.Names = append(dfj.Index.Names, df.index.names...)
 	dfj.Columns = append(dfj.Columns, df.columns...)
-	return json.Marshal(dfj)
+	return *dfj
 }
 
-// Print prints all data in a DataFrame object.
-func (df *DataFrame) Print() {
-	w := new(tabwriter.Writer)
-
-	w.Init(os.Stdout, 5, 0, 4, ' ', 0)
+// toDataFrame rebuilds a DataFrame from the serialized layout.
+func (dfj *dataFrameJson) toDataFrame() (DataFrame, error) {
+	if len(dfj.Series) != len(dfj.Columns) {
+		return DataFrame{}, fmt.Errorf("length of series (%d) and columns (%d) does not match", len(dfj.Series), len(dfj.Columns))
+	}
 
-	for i := range df.index.names {
-		fmt.Fprint(w, df.index.names[i], "\t")
+	if dfj.Index.Index == nil && dfj.Index.Names == nil {
+		length := 0
+		if len(dfj.Series) > 0 {
+			length = len(dfj.Series[0].Data)
+		}
+		rangeIndex := CreateRangeIndex(length)
+		for _, index := range rangeIndex.index {
+			dfj.Index.Index = append(dfj.Index.Index, indexJson{index.id, index.value})
+		}
+		dfj.Index.Names = rangeIndex.names
 	}
 
-	fmt.Fprint(w, "|", "\t")
+	dtypes := make(map[string]string)
+	for _, serj := range dfj.Series {
+		dtypes[serj.Name] = serj.Dtype
+	}
 
-	for i := range df.columns {
-		fmt.Fprint(w, df.columns[i], "\t")
+	newIndex := IndexData{make([]Index, len(dfj.Index.Index)), append([]string{}, dfj.Index.Names...)}
+	for i, indexj := range dfj.Index.Index {
+		if len(indexj.Value) != len(newIndex.names) {
+			return DataFrame{}, fmt.Errorf("index %v does not match index names %v", indexj.Value, newIndex.names)
+		}
+		value := make([]interface{}, len(indexj.Value))
+		for j, data := range indexj.Value {
+			dtype, ok := dtypes[newIndex.names[j]]
+			if !ok {
+				// index levels without a matching column, such as a RangeIndex, hold ints
+				dtype = "int"
+			}
+			converted, err := fromJsonValue(data, dtype)
+			if err != nil {
+				return DataFrame{}, err
+			}
+			value[j] = converted
+		}
+		newIndex.index[i] = Index{indexj.Id, value}
 	}
-	fmt.Fprintln(w)
 
-	for i := 0; i < len(df.series[0].data); i++ {
-		if len(df.index.index[i].value) > 1 {
-			for j := range df.index.index[i].value {
-				fmt.Fprint(w, df.index.index[i].value[j], "\t")
+	newSeries := make([]Series, len(dfj.Series))
+	for i, serj := range dfj.Series {
+		if len(serj.Data) != len(newIndex.index) {
+			return DataFrame{}, fmt.Errorf("length of series '%v' (%d) and index (%d) does not match", serj.Name, len(serj.Data), len(newIndex.index))
+		}
+		data := make([]interface{}, len(serj.Data))
+		for j, value := range serj.Data {
+			converted, err := fromJsonValue(value, serj.Dtype)
+			if err != nil {
+				return DataFrame{}, err
 			}
-		} else {
-			fmt.Fprint(w, df.index.index[i].value[0], "\t")
+			data[j] = converted
 		}
 
-		fmt.Fprint(w, "|", "\t")
+		seriesIndex := IndexData{make([]Index, len(newIndex.index)), make([]string, len(newIndex.names))}
+		copy(seriesIndex.index, newIndex.index)
+		copy(seriesIndex.names, newIndex.names)
+		newSeries[i] = Series{data, seriesIndex, serj.Name, serj.Dtype}
+	}
 
-		for j := range df.columns {
-			fmt.Fprint(w, df.series[j].data[i], "\t")
-		}
-		fmt.Fprintln(w)
+	return DataFrame{newSeries, newIndex, append([]string{}, dfj.Columns...)}, nil
+}
+
+// maxRows and maxCols limit how much of a DataFrame object Print shows. 0 means no limit.
+var (
+	maxRows = 60
+	maxCols = 20
+)
+
+// SetMaxRows sets the number of rows above which Print only shows the first and last rows, with "..." in between.
+// Pass in 0 to always print every row. The default is 60.
+func SetMaxRows(n int) {
+	maxRows = n
+}
+
+// SetMaxCols sets the number of columns above which Print only shows the first and last columns, with "..." in between.
+// Index columns are always shown. Pass in 0 to always print every column. The default is 20.
+func SetMaxCols(n int) {
+	maxCols = n
+}
+
+// Print prints a DataFrame object.
+// Frames with more rows or columns than set by SetMaxRows and SetMaxCols are truncated,
+// showing the first and last rows and columns with "..." in between, followed by the shape of the frame.
+// Floats and missing values are written as set by SetFloatFormat and SetNaNRep.
+func (df *DataFrame) Print() {
+	df.fprint(os.Stdout)
+}
+
+// fprint writes a DataFrame object to out the way Print does.
+func (df *DataFrame) fprint(out io.Writer) {
+	rows := truncatedPositions(df.Len(), maxRows)
+	cols := truncatedPositions(len(df.columns), maxCols)
+	df.fprintRows(out, rows, cols)
+
+	if maxRows > 0 && df.Len() > maxRows || maxCols > 0 && len(df.columns) > maxCols {
+		fmt.Fprintf(out, "\n[%d rows x %d columns]\n", df.Len(), len(df.columns))
 	}
-	w.Flush()
 }
 
 // PrintRange prints data in a DataFrame object at a given range.
-// Index starts at 0.
+// Index starts at 0. The range is clamped to the rows that exist.
 func (df *DataFrame) PrintRange(start, end int) {
+	df.fprintRange(os.Stdout, start, end)
+}
+
+// fprintRange writes the rows from start up to but not including end to out, with every column.
+func (df *DataFrame) fprintRange(out io.Writer, start, end int) {
+	if start < 0 {
+		start = 0
+	}
+	if end > df.Len() {
+		end = df.Len()
+	}
+
+	rows := make([]int, 0)
+	for i := start; i < end; i++ {
+		rows = append(rows, i)
+	}
+	df.fprintRows(out, rows, truncatedPositions(len(df.columns), 0))
+}
+
+// fprintRows writes the given rows and columns, by position, to out as an aligned table.
+// A position of -1 writes "..." in place of the rows or columns that are left out.
+func (df *DataFrame) fprintRows(out io.Writer, rows, cols []int) {
 	w := new(tabwriter.Writer)
 
-	w.Init(os.Stdout, 5, 0, 4, ' ', 0)
+	w.Init(out, 5, 0, 4, ' ', 0)
 
 	for i := range df.index.names {
 		fmt.Fprint(w, df.index.names[i], "\t")
@@ -116,24 +298,40 @@ func (df *DataFrame) PrintRange(start, end int) {
 
 	fmt.Fprint(w, "|", "\t")
 
-	for i := range df.columns {
-		fmt.Fprint(w, df.columns[i], "\t")
+	for _, j := range cols {
+		if j < 0 {
+			fmt.Fprint(w, "...", "\t")
+		} else {
+			fmt.Fprint(w, df.columns[j], "\t")
+		}
 	}
 	fmt.Fprintln(w)
 
-	for i := start; i < end; i++ {
-		if len(df.index.index[i].value) > 1 {
-			for j := range df.index.index[i].value {
-				fmt.Fprint(w, df.index.index[i].value[j], "\t")
+	for _, i := range rows {
+		if i < 0 {
+			for range df.index.names {
+				fmt.Fprint(w, "...", "\t")
 			}
-		} else {
-			fmt.Fprint(w, df.index.index[i].value[0], "\t")
+			fmt.Fprint(w, "|", "\t")
+			for range cols {
+				fmt.Fprint(w, "...", "\t")
+			}
+			fmt.Fprintln(w)
+			continue
+		}
+
+		for _, value := range df.index.index[i].value {
+			fmt.Fprint(w, formatCell(value), "\t")
 		}
 
 		fmt.Fprint(w, "|", "\t")
 
-		for j := range df.columns {
-			fmt.Fprint(w, df.series[j].data[i], "\t")
+		for _, j := range cols {
+			if j < 0 {
+				fmt.Fprint(w, "...", "\t")
+			} else {
+				fmt.Fprint(w, formatCell(df.series[j].data[i]), "\t")
+			}
 		}
 		fmt.Fprintln(w)
 	}
@@ -146,8 +344,399 @@ func (df *DataFrame) Head(howMany int) {
 }
 
 // Tail prints the last howMany items in a DataFrame object.
+// If howMany is larger than the number of rows, all rows are printed.
 func (df *DataFrame) Tail(howMany int) {
-	df.PrintRange(len(df.series[0].data)-howMany, len(df.series[0].data))
+	start := df.Len() - howMany
+	if start < 0 {
+		start = 0
+	}
+	df.PrintRange(start, df.Len())
+}
+
+// HeadDf returns the first howMany rows in a DataFrame object as a new DataFrame.
+// Unlike Head, it does not print anything, so the result can be used in further operations.
+// If howMany is larger than the number of rows, all rows are returned.
+// If howMany is negative, a DataFrame with no rows is returned, like in Series.Head.
+func (df *DataFrame) HeadDf(howMany int) (DataFrame, error) {
+	end := howMany
+	if end < 0 {
+		end = 0
+	}
+	if end > len(df.index.index) {
+		end = len(df.index.index)
+	}
+
+	positions := make([]int, 0, end)
+	for i := 0; i < end; i++ {
+		positions = append(positions, i)
+	}
+	return selectRows(df, positions), nil
+}
+
+// TailDf returns the last howMany rows in a DataFrame object as a new DataFrame.
+// Unlike Tail, it does not print anything, so the result can be used in further operations.
+// If howMany is larger than the number of rows, all rows are returned.
+// If howMany is negative, a DataFrame with no rows is returned, like in Series.Tail.
+func (df *DataFrame) TailDf(howMany int) (DataFrame, error) {
+	start := len(df.index.index) - howMany
+	if start < 0 {
+		start = 0
+	}
+	if start > len(df.index.index) {
+		start = len(df.index.index)
+	}
+
+	positions := make([]int, 0, len(df.index.index)-start)
+	for i := start; i < len(df.index.index); i++ {
+		positions = append(positions, i)
+	}
+	return selectRows(df, positions), nil
+}
+
+// At returns the value at the given index tuple and column.
+// For multiindex, you need to pass in the whole index tuple.
+// If the index has duplicates, the value in the first matching row is returned.
+func (df *DataFrame) At(rowLabel []interface{}, col string) (interface{}, error) {
+	row, err := df.rowPosition(rowLabel)
+	if err != nil {
+		return nil, err
+	}
+	for _, series := range df.series {
+		if series.name == col {
+			return series.data[row], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrColumnNotFound, col)
+}
+
+// IAt returns the value at the given integer row and column positions.
+func (df *DataFrame) IAt(row, colIdx int) (interface{}, error) {
+	if row < 0 || row >= len(df.index.index) {
+		return nil, fmt.Errorf("row index out of bounds: %v", row)
+	}
+	if colIdx < 0 || colIdx >= len(df.series) {
+		return nil, fmt.Errorf("column index out of bounds: %v", colIdx)
+	}
+
+	return df.series[colIdx].data[row], nil
+}
+
+// SetAt writes value into the cell at the given index tuple and column, modifying the DataFrame in place.
+// The data type of the column is checked again after writing, so writing a float64 into an int column turns it into a float64 column.
+// value must be a bool, int, float64, or string. Index columns cannot be modified.
+func (df *DataFrame) SetAt(rowLabel []interface{}, col string, value interface{}) error {
+	switch value.(type) {
+	case bool, int, float64, string, naValue:
+	default:
+		return fmt.Errorf("value %v is not a bool, int, float64, or string", value)
+	}
+	if containsString(df.index.names, col) {
+		return fmt.Errorf("cannot set a value in index column '%v'", col)
+	}
+
+	row, err := df.rowPosition(rowLabel)
+	if err != nil {
+		return err
+	}
+	for i, series := range df.series {
+		if series.name == col {
+			data := make([]interface{}, len(series.data))
+			copy(data, series.data)
+			data[row] = value
+
+			newSeries, err := NewSeries(data, series.name, &series.index)
+			if err != nil {
+				return err
+			}
+			df.series[i] = newSeries
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %v", ErrColumnNotFound, col)
+}
+
+// rowPosition returns the position of the row whose index matches the whole rowLabel tuple.
+func (df *DataFrame) rowPosition(rowLabel []interface{}) (int, error) {
+	for i, index := range df.index.index {
+		if tuplesAreEqual(index.value, rowLabel) {
+			return i, nil
+		}
+	}
+
+	return -1, fmt.Errorf("%w: %v", ErrIndexNotFound, rowLabel)
+}
+
+// Reindex returns a copy of the DataFrame whose rows follow newIndex.
+// Rows are matched by their whole index tuple. Labels that do not exist in the DataFrame get a row of NaN,
+// except for index columns, which are filled with the label itself.
+// The index names of the DataFrame are kept.
+func (df *DataFrame) Reindex(newIndex IndexData) (DataFrame, error) {
+	levels := len(df.index.names)
+	lookup := newLabelLookup(df.index.index)
+	positions := make([]int, len(newIndex.index))
+	for i, index := range newIndex.index {
+		if len(index.value) != levels {
+			return DataFrame{}, fmt.Errorf("index %v has %d levels, expected %d", index.value, len(index.value), levels)
+		}
+		positions[i] = lookup.find(index.value)
+	}
+
+	newDf := DataFrame{}
+	newDf.index.index = append(newDf.index.index, newIndex.index...)
+	newDf.index.names = append(newDf.index.names, df.index.names...)
+	newDf.columns = append(newDf.columns, df.columns...)
+
+	for _, ser := range df.series {
+		level := -1
+		for j, name := range df.index.names {
+			if name == ser.name {
+				level = j
+				break
+			}
+		}
+
+		data := make([]interface{}, len(positions))
+		for i, row := range positions {
+			switch {
+			case row >= 0:
+				data[i] = ser.data[row]
+			case level >= 0:
+				data[i] = newIndex.index[i].value[level]
+			default:
+				data[i] = missingValue(ser.dtype)
+			}
+		}
+
+		newSer, err := NewSeries(data, ser.name, &newDf.index)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		newDf.series = append(newDf.series, newSer)
+	}
+
+	return newDf, nil
+}
+
+// Align returns both DataFrames reindexed to a common index, so that their rows line up.
+// how can be "outer" to use the union of both indexes, or "inner" to use the intersection.
+// Labels keep the order of the source DataFrame, followed by labels only found in other.
+func (df *DataFrame) Align(other DataFrame, how string) (DataFrame, DataFrame, error) {
+	if how != "outer" && how != "inner" {
+		return DataFrame{}, DataFrame{}, fmt.Errorf("how must be \"outer\" or \"inner\", got %q", how)
+	}
+	if len(df.index.names) != len(other.index.names) {
+		return DataFrame{}, DataFrame{}, fmt.Errorf("number of index levels (%d) and (%d) does not match", len(df.index.names), len(other.index.names))
+	}
+
+	labels := newLabelLookup(nil)
+	addLabel := func(label []interface{}) {
+		if labels.find(label) < 0 {
+			labels.add(label)
+		}
+	}
+	otherLabels := newLabelLookup(other.index.index)
+	for _, index := range df.index.index {
+		if how == "inner" && otherLabels.find(index.value) < 0 {
+			continue
+		}
+		addLabel(index.value)
+	}
+	if how == "outer" {
+		for _, index := range other.index.index {
+			addLabel(index.value)
+		}
+	}
+
+	newIndex, err := NewIndexData(labels.labels, df.index.names)
+	if err != nil {
+		return DataFrame{}, DataFrame{}, err
+	}
+	left, err := df.Reindex(newIndex)
+	if err != nil {
+		return DataFrame{}, DataFrame{}, err
+	}
+	right, err := other.Reindex(newIndex)
+	if err != nil {
+		return DataFrame{}, DataFrame{}, err
+	}
+
+	return left, right, nil
+}
+
+// Compare returns the cells that differ between the DataFrame and other, side by side.
+// Both DataFrame objects must have the same shape, columns, and index labels, and are aligned on them first.
+// The result has a pair of columns, colname + "_self" and colname + "_other", for each column with a difference,
+// and a row for each index label with a difference. Cells that are equal within such a row become NaN, or NA for int columns.
+// Index columns are kept as is.
+func (df *DataFrame) Compare(other DataFrame) (DataFrame, error) {
+	if df.Shape() != other.Shape() {
+		return DataFrame{}, fmt.Errorf("shape %v and %v does not match", df.Shape(), other.Shape())
+	}
+	for _, col := range df.columns {
+		if !containsString(other.columns, col) {
+			return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, col)
+		}
+	}
+	left, right, err := df.Align(other, "inner")
+	if err != nil {
+		return DataFrame{}, err
+	}
+	if left.Len() != df.Len() {
+		return DataFrame{}, fmt.Errorf("index of the DataFrame objects does not match")
+	}
+
+	// find the cells that differ, and the rows and columns they are in
+	diffCols := make([]string, 0)
+	cellDiffs := make(map[string][]bool)
+	rowDiffs := make([]bool, left.Len())
+	for _, col := range left.columns {
+		if containsString(left.index.names, col) {
+			continue
+		}
+		leftSer, err := left.LocCol(col)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		rightSer, err := right.LocCol(col)
+		if err != nil {
+			return DataFrame{}, err
+		}
+
+		diffs := make([]bool, left.Len())
+		hasDiff := false
+		for i := range diffs {
+			if compareValues(leftSer.data[i], rightSer.data[i]) != 0 {
+				diffs[i] = true
+				rowDiffs[i] = true
+				hasDiff = true
+			}
+		}
+		if hasDiff {
+			diffCols = append(diffCols, col)
+			cellDiffs[col] = diffs
+		}
+	}
+
+	positions := make([]int, 0)
+	for i, diff := range rowDiffs {
+		if diff {
+			positions = append(positions, i)
+		}
+	}
+	selected := selectRows(&left, positions)
+
+	newDfData := make([][]interface{}, 0)
+	newDfCols := make([]string, 0)
+	for _, ser := range selected.series {
+		if containsString(selected.index.names, ser.name) {
+			newDfData = append(newDfData, ser.data)
+			newDfCols = append(newDfCols, ser.name)
+		}
+	}
+	for _, col := range diffCols {
+		for _, src := range []DataFrame{left, right} {
+			ser, err := src.LocCol(col)
+			if err != nil {
+				return DataFrame{}, err
+			}
+			data := make([]interface{}, len(positions))
+			for i, pos := range positions {
+				if cellDiffs[col][pos] {
+					data[i] = ser.data[pos]
+				} else {
+					data[i] = missingValue(ser.dtype)
+				}
+			}
+			newDfData = append(newDfData, data)
+		}
+		newDfCols = append(newDfCols, col+"_self", col+"_other")
+	}
+
+	return NewDataFrameWithIndex(newDfData, newDfCols, selected.index)
+}
+
+// AddDf adds other to the DataFrame element-wise, after aligning both on their index and columns.
+// Cells that are missing on either side become NaN.
+func (df *DataFrame) AddDf(other DataFrame) (DataFrame, error) {
+	return df.arithmeticDf(other, func(a, b float64) float64 { return a + b })
+}
+
+// SubDf subtracts other from the DataFrame element-wise, after aligning both on their index and columns.
+// Cells that are missing on either side become NaN.
+func (df *DataFrame) SubDf(other DataFrame) (DataFrame, error) {
+	return df.arithmeticDf(other, func(a, b float64) float64 { return a - b })
+}
+
+// MulDf multiplies the DataFrame by other element-wise, after aligning both on their index and columns.
+// Cells that are missing on either side become NaN.
+func (df *DataFrame) MulDf(other DataFrame) (DataFrame, error) {
+	return df.arithmeticDf(other, func(a, b float64) float64 { return a * b })
+}
+
+// DivDf divides the DataFrame by other element-wise, after aligning both on their index and columns.
+// Cells that are missing on either side become NaN, and dividing by zero gives +Inf or -Inf.
+func (df *DataFrame) DivDf(other DataFrame) (DataFrame, error) {
+	return df.arithmeticDf(other, func(a, b float64) float64 { return a / b })
+}
+
+// arithmeticDf applies op to each pair of cells in the DataFrame and other, after aligning both with an outer join.
+// Index columns are kept as is, and every other column of the result is a float64 column.
+func (df *DataFrame) arithmeticDf(other DataFrame, op func(a, b float64) float64) (DataFrame, error) {
+	left, right, err := df.Align(other, "outer")
+	if err != nil {
+		return DataFrame{}, err
+	}
+
+	columns := append([]string{}, left.columns...)
+	for _, col := range right.columns {
+		if !containsString(columns, col) {
+			columns = append(columns, col)
+		}
+	}
+
+	newDf := DataFrame{}
+	newDf.index = left.index
+	newDf.columns = columns
+	for _, col := range columns {
+		leftSer, leftErr := left.LocCol(col)
+		rightSer, rightErr := right.LocCol(col)
+
+		if containsString(left.index.names, col) {
+			newDf.series = append(newDf.series, leftSer)
+			continue
+		}
+		if leftErr == nil && leftSer.dtype != "float64" && leftSer.dtype != "int" ||
+			rightErr == nil && rightSer.dtype != "float64" && rightSer.dtype != "int" {
+			return DataFrame{}, fmt.Errorf("cannot compute, column '%v' %w", col, ErrTypeMismatch)
+		}
+
+		data := make([]interface{}, len(left.index.index))
+		for i := range data {
+			data[i] = math.NaN()
+			if leftErr != nil || rightErr != nil || isNaN(leftSer.data[i]) || isNaN(rightSer.data[i]) {
+				continue
+			}
+			a, err := i2f(leftSer.data[i])
+			if err != nil {
+				return DataFrame{}, err
+			}
+			b, err := i2f(rightSer.data[i])
+			if err != nil {
+				return DataFrame{}, err
+			}
+			data[i] = op(a, b)
+		}
+
+		newSer, err := NewSeries(data, col, &newDf.index)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		newDf.series = append(newDf.series, newSer)
+	}
+
+	return newDf, nil
 }
 
 // LocRows returns a set of rows as a new DataFrame object, given a list of labels.
@@ -219,13 +808,18 @@ func (df *DataFrame) LocCol(col string) (Series, error) {
 		}
 	}
 
-	return Series{}, fmt.Errorf("column '%v' does not exist", col)
+	return Series{}, fmt.Errorf("%w: %v", ErrColumnNotFound, col)
 }
 
 // LocCols returns a set of columns as a new DataFrame object, given a list of labels.
+// If any of the labels is not a column, an error listing the missing labels is returned.
 func (df *DataFrame) LocCols(cols ...string) (DataFrame, error) {
-	filtered2D := make([][]interface{}, 0)
-	for _, column := range cols {
+	if err := df.checkColumnsExist(cols); err != nil {
+		return DataFrame{}, err
+	}
+
+	filtered2D := make([][]interface{}, 0)
+	for _, column := range cols {
 		for _, series := range df.series {
 			if series.name == column {
 				filtered2D = append(filtered2D, series.data)
@@ -252,10 +846,64 @@ func (df *DataFrame) LocCols(cols ...string) (DataFrame, error) {
 	return dataframe, nil
 }
 
+// FilterCols returns the columns whose names match the regular expression pattern, in their original order.
+// For example, "^price_" selects every column that starts with "price_".
+func (df *DataFrame) FilterCols(pattern string) (DataFrame, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return DataFrame{}, err
+	}
+
+	matched := make([]string, 0)
+	for _, col := range df.columns {
+		if re.MatchString(col) {
+			matched = append(matched, col)
+		}
+	}
+	if len(matched) == 0 {
+		return DataFrame{}, fmt.Errorf("no columns match pattern %q", pattern)
+	}
+
+	return df.LocCols(matched...)
+}
+
+// AlignColumns returns the columns named in template, in the order of template.
+// Columns that are not in template are dropped, so the result matches a fixed schema,
+// e.g. before stacking DataFrame objects vertically or writing them to the same file.
+func (df *DataFrame) AlignColumns(template []string) (DataFrame, error) {
+	for i, col := range template {
+		if containsString(template[:i], col) {
+			return DataFrame{}, fmt.Errorf("column '%v' is given more than once", col)
+		}
+	}
+
+	return df.LocCols(template...)
+}
+
+// checkColumnsExist returns an ErrColumnNotFound error listing every name in cols that is not a column of the DataFrame,
+// so that a typo in a column name is reported instead of silently giving fewer columns.
+func (df *DataFrame) checkColumnsExist(cols []string) error {
+	missing := make([]string, 0)
+	for _, col := range cols {
+		if !containsString(df.columns, col) && !containsString(missing, col) {
+			missing = append(missing, col)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %v", ErrColumnNotFound, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 // LocColsItems will return a slice of columns.
 // Use this over LocCols if you want to extract the items directly
 // instead of getting a DataFrame object.
+// If any of the labels is not a column, an error listing the missing labels is returned.
 func (df *DataFrame) LocColsItems(cols ...string) ([][]interface{}, error) {
+	if err := df.checkColumnsExist(cols); err != nil {
+		return nil, err
+	}
+
 	filtered2D := make([][]interface{}, 0)
 	for _, column := range cols {
 		for _, series := range df.series {
@@ -288,171 +936,313 @@ func (df *DataFrame) Loc(cols []string, rows ...[]interface{}) (DataFrame, error
 	return df2, nil
 }
 
+// Xs returns a cross-section of a multiindex DataFrame object.
+// It selects every row whose index tuple has value at the given level,
+// and drops that level from the index of the resulting DataFrame object.
+func (df *DataFrame) Xs(level int, value interface{}) (DataFrame, error) {
+	if len(df.index.index) == 0 {
+		return DataFrame{}, fmt.Errorf("DataFrame has no index")
+	}
+	arity := len(df.index.index[0].value)
+	if level < 0 || level >= arity {
+		return DataFrame{}, fmt.Errorf("level %d is out of range for an index with %d levels", level, arity)
+	}
+	if arity == 1 {
+		return DataFrame{}, fmt.Errorf("cannot drop the only index level, use LocRows instead")
+	}
+
+	filteredData := make([][]interface{}, len(df.series))
+	filteredIndex := IndexData{}
+	for i, index := range df.index.index {
+		if !labelsAreEqual(index.value[level], value) {
+			continue
+		}
+
+		newValue := make([]interface{}, 0, arity-1)
+		newValue = append(newValue, index.value[:level]...)
+		newValue = append(newValue, index.value[level+1:]...)
+		filteredIndex.index = append(filteredIndex.index, Index{index.id, newValue})
+
+		for j, ser := range df.series {
+			filteredData[j] = append(filteredData[j], ser.data[i])
+		}
+	}
+
+	if len(filteredIndex.index) == 0 {
+		return DataFrame{}, fmt.Errorf("no data found for %v at level %d", value, level)
+	}
+
+	filteredIndex.names = append(filteredIndex.names, df.index.names[:level]...)
+	filteredIndex.names = append(filteredIndex.names, df.index.names[level+1:]...)
+
+	filteredColname := make([]string, len(df.columns))
+	copy(filteredColname, df.columns)
+
+	dataframe, err := NewDataFrame(filteredData, filteredColname, nil)
+	if err != nil {
+		return DataFrame{}, err
+	}
+
+	dataframe.index = filteredIndex
+	for i := range dataframe.series {
+		dataframe.series[i].index = filteredIndex
+	}
+
+	return dataframe, nil
+}
+
 /* Basic arithmetic operations for columns. */
 
 // ColAdd adds the given value to each element in the specified column.
 func (df *DataFrame) ColAdd(colname string, value float64) (DataFrame, error) {
 	newDf := copyDf(df)
 
-	for _, series := range newDf.series {
+	for j, series := range newDf.series {
 		if series.name == colname {
+			if err := intSeriesToFloat64(&newDf.series[j]); err != nil {
+				return DataFrame{}, err
+			}
+			series = newDf.series[j]
 			for i, data := range series.data {
 				switch v := data.(type) {
 				case float64:
 					v += value
 					series.data[i] = v
 				default:
-					return DataFrame{}, fmt.Errorf("cannot add, column data type is not float64")
+					return DataFrame{}, fmt.Errorf("cannot add, column %w", ErrTypeMismatch)
 				}
 			}
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
 }
 
 // ColSub subtracts the given value from each element in the specified column.
 func (df *DataFrame) ColSub(colname string, value float64) (DataFrame, error) {
 	newDf := copyDf(df)
-	for _, series := range newDf.series {
+	for j, series := range newDf.series {
 		if series.name == colname {
+			if err := intSeriesToFloat64(&newDf.series[j]); err != nil {
+				return DataFrame{}, err
+			}
+			series = newDf.series[j]
 			for i, data := range series.data {
 				switch v := data.(type) {
 				case float64:
 					v -= value
 					series.data[i] = v
 				default:
-					return DataFrame{}, fmt.Errorf("cannot subtract, column data type is not float64")
+					return DataFrame{}, fmt.Errorf("cannot subtract, column %w", ErrTypeMismatch)
 				}
 			}
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
 }
 
 // ColMul multiplies each element in the specified column by the given value.
 func (df *DataFrame) ColMul(colname string, value float64) (DataFrame, error) {
 	newDf := copyDf(df)
-	for _, series := range newDf.series {
+	for j, series := range newDf.series {
 		if series.name == colname {
+			if err := intSeriesToFloat64(&newDf.series[j]); err != nil {
+				return DataFrame{}, err
+			}
+			series = newDf.series[j]
 			for i, data := range series.data {
 				switch v := data.(type) {
 				case float64:
 					v *= value
 					series.data[i] = v
 				default:
-					return DataFrame{}, fmt.Errorf("cannot multiply, column data type is not float64")
+					return DataFrame{}, fmt.Errorf("cannot multiply, column %w", ErrTypeMismatch)
 				}
 			}
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
 }
 
 // ColDiv divides each element in the specified column by the given value.
 func (df *DataFrame) ColDiv(colname string, value float64) (DataFrame, error) {
 	newDf := copyDf(df)
-	for _, series := range newDf.series {
+	for j, series := range newDf.series {
 		if series.name == colname {
+			if err := intSeriesToFloat64(&newDf.series[j]); err != nil {
+				return DataFrame{}, err
+			}
+			series = newDf.series[j]
 			for i, data := range series.data {
 				switch v := data.(type) {
 				case float64:
 					v /= value
 					series.data[i] = v
 				default:
-					return DataFrame{}, fmt.Errorf("cannot divide, column data type is not float64")
+					return DataFrame{}, fmt.Errorf("cannot divide, column %w", ErrTypeMismatch)
 				}
 			}
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
 }
 
 // ColMod applies modulus calculations on each element in the specified column, returning the remainder.
 func (df *DataFrame) ColMod(colname string, value float64) (DataFrame, error) {
 	newDf := copyDf(df)
-	for _, series := range newDf.series {
+	for j, series := range newDf.series {
 		if series.name == colname {
+			if err := intSeriesToFloat64(&newDf.series[j]); err != nil {
+				return DataFrame{}, err
+			}
+			series = newDf.series[j]
 			for i, data := range series.data {
 				switch v := data.(type) {
 				case float64:
 					series.data[i] = math.Mod(v, value)
 				default:
-					return DataFrame{}, fmt.Errorf("cannot use modulus, column data type is not float64")
+					return DataFrame{}, fmt.Errorf("cannot use modulus, column %w", ErrTypeMismatch)
 				}
 			}
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
 }
 
-// Basic boolean operators for columns.
-
-// ColGt checks if each element in the specified column is greater than the given value.
-func (df *DataFrame) ColGt(colname string, value float64) (DataFrame, error) {
+// Round rounds each element in the specified column to the given number of decimals.
+// Negative decimals round to the left of the decimal point, so -1 rounds to the nearest ten.
+func (df *DataFrame) Round(colname string, decimals int) (DataFrame, error) {
 	newDf := copyDf(df)
 	for i, series := range newDf.series {
 		if series.name == colname {
-			newDf.series[i].dtype = "bool"
-			for i, data := range series.data {
-				switch v := data.(type) {
-				case float64:
-					isGt := (v > value)
-					series.data[i] = isGt
-				default:
-					return DataFrame{}, fmt.Errorf("cannot compare, column data type is not float64")
-				}
+			if series.dtype != "float64" && series.dtype != "int" {
+				return DataFrame{}, fmt.Errorf("cannot round, column %w", ErrTypeMismatch)
 			}
+			newDf.series[i].data = roundData(series.data, decimals)
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
+
+// RoundAll rounds every float64 and int column to the given number of decimals.
+// Columns of other data types are left untouched.
+func (df *DataFrame) RoundAll(decimals int) (DataFrame, error) {
+	newDf := copyDf(df)
+	for i, series := range newDf.series {
+		if series.dtype == "float64" || series.dtype == "int" {
+			newDf.series[i].data = roundData(series.data, decimals)
+		}
+	}
+	return newDf, nil
+}
+
+// Basic boolean operators for columns.
+// NaN elements are false for every operator except ColNe, unless the given value is NaN itself.
+
+// ColGt checks if each element in the specified column is greater than the given value.
+func (df *DataFrame) ColGt(colname string, value float64) (DataFrame, error) {
+	return df.compareCol(colname, func(v float64) bool { return v > value })
 }
 
 // ColLt checks if each element in the specified column is less than the given value.
 func (df *DataFrame) ColLt(colname string, value float64) (DataFrame, error) {
+	return df.compareCol(colname, func(v float64) bool { return v < value })
+}
+
+// ColGe checks if each element in the specified column is greater than or equal to the given value.
+func (df *DataFrame) ColGe(colname string, value float64) (DataFrame, error) {
+	return df.compareCol(colname, func(v float64) bool { return v >= value })
+}
+
+// ColLe checks if each element in the specified column is less than or equal to the given value.
+func (df *DataFrame) ColLe(colname string, value float64) (DataFrame, error) {
+	return df.compareCol(colname, func(v float64) bool { return v <= value })
+}
+
+// ColEq checks if each element in the specified column is equal to the given value.
+// Pass in math.NaN() as value to check which elements are NaN.
+func (df *DataFrame) ColEq(colname string, value float64) (DataFrame, error) {
+	return df.compareCol(colname, func(v float64) bool { return floatsAreEqual(v, value) })
+}
+
+// ColNe checks if each element in the specified column is not equal to the given value.
+// Pass in math.NaN() as value to check which elements are not NaN.
+func (df *DataFrame) ColNe(colname string, value float64) (DataFrame, error) {
+	return df.compareCol(colname, func(v float64) bool { return !floatsAreEqual(v, value) })
+}
+
+// compareCol replaces each element in the specified column with the result of compare, turning it into a bool column.
+// int columns are compared as float64, and NaN elements are passed in as math.NaN().
+func (df *DataFrame) compareCol(colname string, compare func(float64) bool) (DataFrame, error) {
 	newDf := copyDf(df)
 	for i, series := range newDf.series {
 		if series.name == colname {
+			if err := intSeriesToFloat64(&newDf.series[i]); err != nil {
+				return DataFrame{}, err
+			}
+			series = newDf.series[i]
 			newDf.series[i].dtype = "bool"
 			for i, data := range series.data {
 				switch v := data.(type) {
 				case float64:
-					isLt := (v < value)
-					series.data[i] = isLt
+					series.data[i] = compare(v)
 				default:
-					return DataFrame{}, fmt.Errorf("cannot compare, column data type is not float64")
+					return DataFrame{}, fmt.Errorf("cannot compare, column %w", ErrTypeMismatch)
 				}
 			}
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
 }
 
-// ColEq checks if each element in the specified column is equal to the given value.
-func (df *DataFrame) ColEq(colname string, value float64) (DataFrame, error) {
+// Mask returns a copy of the DataFrame where each element in the specified column is replaced with replacement wherever cond returns true.
+// The column must be of float64 or int dtype. int elements are passed to cond as float64, and NaN elements as math.NaN().
+// The dtype of the column is checked again after replacing, so replacing with a string turns the column into a string column.
+// Index columns cannot be masked, since they would no longer match the index.
+func (df *DataFrame) Mask(colname string, cond func(float64) bool, replacement interface{}) (DataFrame, error) {
+	if containsString(df.index.names, colname) {
+		return DataFrame{}, fmt.Errorf("cannot mask index column '%v'", colname)
+	}
+
 	newDf := copyDf(df)
 	for i, series := range newDf.series {
 		if series.name == colname {
-			newDf.series[i].dtype = "bool"
-			for i, data := range series.data {
-				switch v := data.(type) {
+			if series.dtype != "float64" && series.dtype != "int" {
+				return DataFrame{}, fmt.Errorf("cannot mask, column %w", ErrTypeMismatch)
+			}
+
+			masked := make([]interface{}, len(series.data))
+			for j, data := range series.data {
+				v := math.NaN()
+				switch d := data.(type) {
 				case float64:
-					isEq := (v == value)
-					series.data[i] = isEq
-				default:
-					return DataFrame{}, fmt.Errorf("cannot compare, column data type is not float64")
+					v = d
+				case int:
+					v = float64(d)
+				}
+
+				if cond(v) {
+					masked[j] = replacement
+				} else {
+					masked[j] = data
 				}
 			}
+
+			newSeries, err := NewSeries(masked, series.name, &series.index)
+			if err != nil {
+				return DataFrame{}, err
+			}
+			newDf.series[i] = newSeries
 			return newDf, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("colname does not match any of the existing column names")
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
 }
 
 /* Editing Properties */
@@ -462,7 +1252,7 @@ func (df *DataFrame) ColEq(colname string, value float64) (DataFrame, error) {
 func (df *DataFrame) NewCol(colname string, data []interface{}) (DataFrame, error) {
 	newDf := copyDf(df)
 	if data == nil {
-		for i := 0; i < len(df.series[0].data); i++ {
+		for i := 0; i < df.Len(); i++ {
 			data = append(data, math.NaN())
 		}
 	}
@@ -477,6 +1267,96 @@ func (df *DataFrame) NewCol(colname string, data []interface{}) (DataFrame, erro
 	return newDf, nil
 }
 
+// Assign creates a new column named colname from the result of fn, and returns a copy of the DataFrame with the column added.
+// fn is called with the DataFrame and should return one value for each row, in row order.
+// Because the original DataFrame is left unchanged, calls can be chained to derive several columns.
+func (df *DataFrame) Assign(colname string, fn func(DataFrame) ([]interface{}, error)) (DataFrame, error) {
+	data, err := fn(*df)
+	if err != nil {
+		return DataFrame{}, err
+	}
+	if len(data) != df.Len() {
+		return DataFrame{}, fmt.Errorf("length of data (%d) and rows (%d) does not match", len(data), df.Len())
+	}
+
+	return df.NewCol(colname, data)
+}
+
+// AppendRow appends a single row to the end of a DataFrame object in place.
+// indexValue is the index tuple of the new row, and may be nil if the DataFrame has a RangeIndex.
+// row maps column names to values. Columns absent in row are filled with NaN, or NA for int columns,
+// except index columns, which take their value from indexValue.
+// The dtype of a column is widened if needed, so an int column becomes float64 when a float64 is appended,
+// and a column becomes string when a value of a different type is appended.
+// The new row gets the next unused index id.
+func (df *DataFrame) AppendRow(indexValue []interface{}, row map[string]interface{}) error {
+	for col := range row {
+		if !containsString(df.columns, col) {
+			return fmt.Errorf("%w: %v", ErrColumnNotFound, col)
+		}
+	}
+
+	nextId := 0
+	for _, index := range df.index.index {
+		if index.id >= nextId {
+			nextId = index.id + 1
+		}
+	}
+	indexNames := df.index.names
+	if indexValue == nil && (len(indexNames) == 0 || (len(indexNames) == 1 && indexNames[0] == "")) {
+		indexNames = []string{""}
+		indexValue = []interface{}{nextId}
+	}
+	if len(indexValue) != len(indexNames) {
+		return fmt.Errorf("length of index %v (%d) and names (%d) does not match", indexValue, len(indexValue), len(indexNames))
+	}
+
+	// build every column first, so that the DataFrame is left untouched if one of them fails
+	newSeries := make([]Series, len(df.series))
+	for i, ser := range df.series {
+		value, ok := row[ser.name]
+		if !ok {
+			value = nil
+			for level, name := range indexNames {
+				if name == ser.name {
+					value = indexValue[level]
+				}
+			}
+		}
+
+		dtype, err := appendedDtype(ser.dtype, value)
+		if err != nil {
+			return fmt.Errorf("cannot append to column '%v': %w", ser.name, err)
+		}
+		if isNaN(value) {
+			value = missingValue(dtype)
+		}
+		data := append(append(make([]interface{}, 0, len(ser.data)+1), ser.data...), value)
+		switch dtype {
+		case "float64":
+			data, err = consolidateToFloat64(data)
+			if err != nil {
+				return err
+			}
+		case "string":
+			data = consolidateToString(data)
+		}
+		newSeries[i] = Series{data, IndexData{}, ser.name, dtype}
+	}
+
+	df.index.names = indexNames
+	df.index.index = append(df.index.index, Index{nextId, append([]interface{}{}, indexValue...)})
+	for i := range newSeries {
+		newSeries[i].index.index = make([]Index, len(df.index.index))
+		newSeries[i].index.names = make([]string, len(df.index.names))
+		copy(newSeries[i].index.index, df.index.index)
+		copy(newSeries[i].index.names, df.index.names)
+	}
+	df.series = newSeries
+
+	return nil
+}
+
 // NewDerivedCol creates a new column derived from an existing column.
 // It copies over the data from srcCol into a new column.
 func (df *DataFrame) NewDerivedCol(colname, srcCol string) (DataFrame, error) {
@@ -490,112 +1370,544 @@ func (df *DataFrame) NewDerivedCol(colname, srcCol string) (DataFrame, error) {
 			return dataframe, nil
 		}
 	}
-	return DataFrame{}, fmt.Errorf("the column doesn't exist: %s", srcCol)
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, srcCol)
 }
 
-// RenameCol renames columns in a DataFrame.
-func (df *DataFrame) RenameCol(colnames map[string]string) error {
-	for oldName, newName := range colnames {
-		exists := false
-		for i, col := range df.columns {
-			if col == oldName {
-				df.columns[i] = newName
-				exists = true
+// Cut bins the values of a numeric column into intervals and returns a copy with a new string column
+// named colname + "_bin" that holds the label of each row's bin.
+// Bins are right-inclusive, so bins []float64{0, 18, 65} creates the intervals (0, 18] and (18, 65].
+// Values outside all bins and NaN values become NaN.
+// bins must be strictly increasing, and labels must have one fewer element than bins.
+func (df *DataFrame) Cut(colname string, bins []float64, labels []string) (DataFrame, error) {
+	if len(bins) < 2 {
+		return DataFrame{}, fmt.Errorf("bins must have at least 2 edges, got %d", len(bins))
+	}
+	if len(labels) != len(bins)-1 {
+		return DataFrame{}, fmt.Errorf("length of labels (%d) must be one less than length of bins (%d)", len(labels), len(bins))
+	}
+	for i := 1; i < len(bins); i++ {
+		if bins[i] <= bins[i-1] {
+			return DataFrame{}, fmt.Errorf("bins must be strictly increasing")
+		}
+	}
+
+	for _, ser := range df.series {
+		if ser.name == colname {
+			if ser.dtype != "float64" && ser.dtype != "int" {
+				return DataFrame{}, fmt.Errorf("column %w", ErrTypeMismatch)
+			}
+
+			binned := make([]interface{}, len(ser.data))
+			for i, data := range ser.data {
+				binned[i] = math.NaN()
+				if isNaN(data) {
+					continue
+				}
+				value, err := i2f(data)
+				if err != nil {
+					return DataFrame{}, err
+				}
+				for j := 1; j < len(bins); j++ {
+					if value > bins[j-1] && value <= bins[j] {
+						binned[i] = labels[j-1]
+						break
+					}
+				}
+			}
+
+			newDf, err := df.NewCol(colname+"_bin", binned)
+			if err != nil {
+				return DataFrame{}, err
 			}
+			// if no value falls into a bin, the column only holds NaN, which NewCol infers as float64
+			newDf.series[len(newDf.series)-1].dtype = "string"
+			return newDf, nil
 		}
-		if !exists {
-			return fmt.Errorf("column does not exist: %v", oldName)
+	}
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
+
+// CumCount returns a copy with a new int column named colname + "_cumcount".
+// For each row, it holds the number of non-missing values in colname up to and including that row,
+// which is useful for tracking how complete a column is over time.
+func (df *DataFrame) CumCount(colname string) (DataFrame, error) {
+	for _, ser := range df.series {
+		if ser.name == colname {
+			counts := make([]interface{}, len(ser.data))
+			count := 0
+			for i, data := range ser.data {
+				if !isNaN(data) {
+					count++
+				}
+				counts[i] = count
+			}
+
+			return df.NewCol(colname+"_cumcount", counts)
 		}
+	}
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
+
+// PercentRank returns a copy with a new float64 column named colname + "_pctrank".
+// For each row, it holds the fraction of values in colname that are less than or equal to the value of that row,
+// so the largest value gets 1.0. The column must be of int or float64 dtype.
+// NaN values are left out of the calculation and get NaN as their rank.
+func (df *DataFrame) PercentRank(colname string) (DataFrame, error) {
+	for _, ser := range df.series {
+		if ser.name == colname {
+			if ser.dtype != "int" && ser.dtype != "float64" {
+				return DataFrame{}, fmt.Errorf("cannot rank, column %w", ErrTypeMismatch)
+			}
 
-		for i, name := range df.index.names {
-			if name == oldName {
-				df.index.names[i] = newName
+			values := make([]float64, len(ser.data))
+			sorted := make([]float64, 0, len(ser.data))
+			for i, data := range ser.data {
+				if isNaN(data) {
+					values[i] = math.NaN()
+					continue
+				}
+				v, err := i2f(data)
+				if err != nil {
+					return DataFrame{}, err
+				}
+				values[i] = v
+				sorted = append(sorted, v)
 			}
+			sort.Float64s(sorted)
+
+			ranks := make([]interface{}, len(values))
+			for i, v := range values {
+				if math.IsNaN(v) {
+					ranks[i] = math.NaN()
+					continue
+				}
+				// the number of values less than or equal to v is the position of the first value greater than v
+				count := sort.Search(len(sorted), func(j int) bool { return sorted[j] > v })
+				ranks[i] = float64(count) / float64(len(sorted))
+			}
+
+			return df.NewCol(colname+"_pctrank", ranks)
 		}
+	}
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
+
+// RollingApply returns a copy with a new float64 column named colname + "_rolling".
+// For each row, fn is called with the values of colname in a window of the given size that ends at that row,
+// ordered from the oldest, and its result is stored in the new column. The first window-1 rows are NaN.
+// The column must be of int or float64 dtype, and missing values are passed to fn as math.NaN().
+func (df *DataFrame) RollingApply(colname string, window int, fn func([]float64) float64) (DataFrame, error) {
+	if window < 1 {
+		return DataFrame{}, fmt.Errorf("window should be at least 1, got %d", window)
+	}
 
-		for i, series := range df.series {
-			if series.name == oldName {
-				df.series[i].RenameCol(newName)
+	for _, ser := range df.series {
+		if ser.name == colname {
+			if ser.dtype != "int" && ser.dtype != "float64" {
+				return DataFrame{}, fmt.Errorf("cannot apply rolling function, column %w", ErrTypeMismatch)
 			}
-			err := df.series[i].RenameIndex(colnames)
-			if err != nil {
-				return err
+
+			values := make([]float64, len(ser.data))
+			for i, data := range ser.data {
+				if isNaN(data) {
+					values[i] = math.NaN()
+					continue
+				}
+				v, err := i2f(data)
+				if err != nil {
+					return DataFrame{}, err
+				}
+				values[i] = v
+			}
+
+			results := make([]interface{}, len(values))
+			for i := range values {
+				if i < window-1 {
+					results[i] = math.NaN()
+					continue
+				}
+				windowValues := make([]float64, window)
+				copy(windowValues, values[i-window+1:i+1])
+				results[i] = fn(windowValues)
 			}
+
+			return df.NewCol(colname+"_rolling", results)
 		}
 	}
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
 
+// RenameCol renames columns in a DataFrame.
+// Index names that refer to a renamed column are renamed as well.
+// If any of the columns does not exist, nothing is renamed.
+func (df *DataFrame) RenameCol(colnames map[string]string) error {
+	for oldName := range colnames {
+		if !containsString(df.columns, oldName) {
+			return fmt.Errorf("%w: %v", ErrColumnNotFound, oldName)
+		}
+	}
+
+	df.renameColumns(func(name string) string {
+		if newName, ok := colnames[name]; ok {
+			return newName
+		}
+		return name
+	})
 	return nil
 }
 
+// Rename renames columns and index labels in a DataFrame in one call.
+// columns maps old column names to new ones, the same as in RenameCol, and may be nil.
+// index maps old index labels to new ones, and may be nil. Only string labels are renamed,
+// and the values of index columns are renamed along with them, so the index stays aligned with its columns.
+// If any of the columns does not exist, nothing is renamed.
+func (df *DataFrame) Rename(columns map[string]string, index map[string]string) error {
+	if err := df.RenameCol(columns); err != nil {
+		return err
+	}
+	if len(index) == 0 {
+		return nil
+	}
+
+	rename := func(data []interface{}) []interface{} {
+		renamed := make([]interface{}, len(data))
+		for i, d := range data {
+			renamed[i] = d
+			if label, ok := d.(string); ok {
+				if newLabel, ok := index[label]; ok {
+					renamed[i] = newLabel
+				}
+			}
+		}
+		return renamed
+	}
+
+	newIndex := make([]Index, len(df.index.index))
+	for i, idx := range df.index.index {
+		newIndex[i] = Index{idx.id, rename(idx.value)}
+	}
+	df.index.index = newIndex
+
+	for i, ser := range df.series {
+		if containsString(df.index.names, ser.name) {
+			df.series[i].data = rename(ser.data)
+		}
+		df.series[i].index.index = make([]Index, len(newIndex))
+		copy(df.series[i].index.index, newIndex)
+	}
+	return nil
+}
+
+// SetColumns replaces the names of all columns in a DataFrame, in column order.
+// The number of names should match the number of columns.
+// Index names that refer to a renamed column are renamed as well.
+// This is useful for naming the columns of a file read without a header.
+func (df *DataFrame) SetColumns(names []string) error {
+	if len(names) != len(df.columns) {
+		return fmt.Errorf("length of names (%d) and columns (%d) does not match", len(names), len(df.columns))
+	}
+
+	colnames := make(map[string]string, len(names))
+	for i, col := range df.columns {
+		if _, ok := colnames[col]; !ok {
+			colnames[col] = names[i]
+		}
+	}
+	df.renameColumns(func(name string) string {
+		return colnames[name]
+	})
+
+	// set the names by position as well, in case the old names were not unique
+	for i := range df.series {
+		df.columns[i] = names[i]
+		df.series[i].name = names[i]
+	}
+	return nil
+}
+
+// DedupColumns returns a copy of the DataFrame where repeated column names are made unique.
+// The first column with a name keeps it, and the following ones get suffix and a number appended,
+// so two "Score" columns become "Score" and "Score_1" with the suffix "_".
+// Numbers already taken by another column are skipped.
+func (df *DataFrame) DedupColumns(suffix string) DataFrame {
+	newDf := copyDf(df)
+	seen := make(map[string]bool, len(newDf.columns))
+	for i, col := range newDf.columns {
+		if !seen[col] {
+			seen[col] = true
+			continue
+		}
+
+		newName := col
+		for n := 1; containsString(newDf.columns, newName); n++ {
+			newName = fmt.Sprintf("%v%v%d", col, suffix, n)
+		}
+		newDf.columns[i] = newName
+		newDf.series[i].name = newName
+	}
+
+	return newDf
+}
+
+// AddPrefix renames every column in a DataFrame by putting prefix in front of its name.
+// Index names that refer to a column are renamed as well, so the index stays aligned with its columns.
+// This is useful before merging DataFrame objects that share column names.
+func (df *DataFrame) AddPrefix(prefix string) {
+	df.renameColumns(func(name string) string {
+		return prefix + name
+	})
+}
+
+// AddSuffix renames every column in a DataFrame by putting suffix after its name.
+// Index names that refer to a column are renamed as well, so the index stays aligned with its columns.
+func (df *DataFrame) AddSuffix(suffix string) {
+	df.renameColumns(func(name string) string {
+		return name + suffix
+	})
+}
+
+// renameColumns renames every column, series and column-backed index name using rename.
+// All names are renamed at once, so a new name can safely be the old name of another column.
+// The names slices are replaced rather than modified, since a Series may share them with the DataFrame.
+func (df *DataFrame) renameColumns(rename func(string) string) {
+	isColumn := make(map[string]bool)
+	for _, col := range df.columns {
+		isColumn[col] = true
+	}
+	renameIndexNames := func(names []string) []string {
+		newNames := make([]string, len(names))
+		for i, name := range names {
+			if isColumn[name] {
+				newNames[i] = rename(name)
+			} else {
+				newNames[i] = name
+			}
+		}
+		return newNames
+	}
+
+	newColumns := make([]string, len(df.columns))
+	for i, col := range df.columns {
+		newColumns[i] = rename(col)
+	}
+	df.columns = newColumns
+	df.index.names = renameIndexNames(df.index.names)
+
+	for i, series := range df.series {
+		df.series[i].name = rename(series.name)
+		df.series[i].index.names = renameIndexNames(series.index.names)
+	}
+}
+
+// RenameIndex replaces the names of each index level in a DataFrame.
+// The number of names should match the number of index levels.
+func (df *DataFrame) RenameIndex(names []string) error {
+	levels := len(df.index.names)
+	if len(df.index.index) > 0 {
+		levels = len(df.index.index[0].value)
+	}
+	if len(names) != levels {
+		return fmt.Errorf("length of names (%d) and index levels (%d) does not match", len(names), levels)
+	}
+
+	df.index.names = make([]string, len(names))
+	copy(df.index.names, names)
+
+	for i := range df.series {
+		df.series[i].index.names = make([]string, len(names))
+		copy(df.series[i].index.names, names)
+	}
+
+	return nil
+}
+
+// DropColAt returns a copy of the DataFrame without the column at position pos.
+// Unlike dropping by name, this works when column names are repeated.
+// Index columns cannot be dropped.
+func (df *DataFrame) DropColAt(pos int) (DataFrame, error) {
+	if pos < 0 || pos >= len(df.series) {
+		return DataFrame{}, fmt.Errorf("column index out of bounds: %v", pos)
+	}
+	if containsString(df.index.names, df.series[pos].name) {
+		return DataFrame{}, fmt.Errorf("cannot drop index column '%v'", df.series[pos].name)
+	}
+
+	newDf := copyDf(df)
+	newDf.series = append(newDf.series[:pos], newDf.series[pos+1:]...)
+	newDf.columns = append(newDf.columns[:pos], newDf.columns[pos+1:]...)
+
+	return newDf, nil
+}
+
 // DropNaN drops rows or columns with NaN values.
 // Specify axis to choose whether to remove rows with NaN or columns with NaN.
 // axis=0 is row, axis=1 is column.
 func (df *DataFrame) DropNaN(axis int) (DataFrame, error) {
 	if axis > 1 || axis < 0 {
-		return DataFrame{}, fmt.Errorf("axis can only be either 0 or 1")
+		return DataFrame{}, fmt.Errorf("%w, got %d", ErrAxisOutOfRange, axis)
 	}
 
-	newDf := df
-
 	// for each series, iterate through the series until NaN is found
-	// if NaN, save NaN index
-	// sort the NaNindex slice
+	// if NaN, mark the row as having NaN and the series as having NaN
 
-	indexSlice := make([]int, 0)
-	seriesHasNaNSlice := make([]bool, len(newDf.series))
-	for i, ser := range newDf.series {
+	rowHasNaN := make(map[int]bool)
+	seriesHasNaNSlice := make([]bool, len(df.series))
+	for i, ser := range df.series {
 		for j, data := range ser.data {
-			switch v := data.(type) {
-			case string:
-				if v == "NaN" {
-					indexSlice = append(indexSlice, j)
-					seriesHasNaNSlice[i] = true
-				}
-			case float64:
-				if math.IsNaN(v) {
-					indexSlice = append(indexSlice, j)
-					seriesHasNaNSlice[i] = true
-				}
+			if isNaN(data) {
+				rowHasNaN[j] = true
+				seriesHasNaNSlice[i] = true
 			}
 		}
 	}
 
-	sort.Ints(indexSlice)
-
 	// deleting rows containing NaN
-	// for each series, remove data at the index. length of newDf.series.data will decrease by 1.
-	// subtract 1 from each index so that it matches the new length newDf.series.data.
+	// keep the positions of the rows without NaN, so that a row with NaN in several columns is only dropped once.
 	if axis == 0 {
-		for i := range newDf.series {
-			iSlice := make([]int, len(indexSlice))
-			copy(iSlice, indexSlice)
+		kept := make([]int, 0, df.Len())
+		for i := 0; i < df.Len(); i++ {
+			if !rowHasNaN[i] {
+				kept = append(kept, i)
+			}
+		}
+		return selectRows(df, kept), nil
+	}
+
+	// deleting columns containing NaN
+	// keep the columns without NaN in fresh slices, so that deleting one column does not shift the others.
+	keptSeries := make([]Series, 0, len(df.series))
+	keptColumns := make([]string, 0, len(df.columns))
+	for i, hasNaN := range seriesHasNaNSlice {
+		if !hasNaN {
+			keptSeries = append(keptSeries, df.series[i])
+			keptColumns = append(keptColumns, df.columns[i])
+		}
+	}
+	return DataFrame{keptSeries, df.index, keptColumns}, nil
+}
+
+// Replace replaces every occurrence of oldValue with newValue across all columns and returns a copy.
+// Numbers are compared by value, so oldValue=-999 matches both -999 and -999.0.
+// Pass in math.NaN() as oldValue to replace missing values, or as newValue to mark the matches as missing.
+// newValue must be a bool, int, float64, or string. The data type of each column is checked again after replacing.
+// Index columns are left unchanged, so that they keep matching the index.
+func (df *DataFrame) Replace(oldValue, newValue interface{}) (DataFrame, error) {
+	if err := checkReplaceValue(newValue); err != nil {
+		return DataFrame{}, err
+	}
 
-			for j, index := range iSlice {
-				newDf.series[i].index.index = append(newDf.series[i].index.index[:index], newDf.series[i].index.index[index+1:]...)
-				newDf.series[i].data = append(newDf.series[i].data[:index], newDf.series[i].data[index+1:]...)
+	newDf := copyDf(df)
+	for i, ser := range newDf.series {
+		if containsString(newDf.index.names, ser.name) {
+			continue
+		}
+		newSer, err := ser.Replace(oldValue, newValue)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		newDf.series[i] = newSer
+	}
 
-				for k := j + 1; k < len(iSlice); k++ {
-					iSlice[k] -= 1
+	return newDf, nil
+}
+
+// RemoveOutliers returns a copy of the DataFrame without the rows whose value in colname
+// falls outside [Q1 - k*IQR, Q3 + k*IQR]. k is typically 1.5.
+// Rows keep their original index.
+// Rows with NaN in colname cannot be compared with the bounds, so they are always kept.
+func (df *DataFrame) RemoveOutliers(colname string, k float64) (DataFrame, error) {
+	if k < 0 {
+		return DataFrame{}, fmt.Errorf("k cannot be negative, got %v", k)
+	}
+
+	for _, ser := range df.series {
+		if ser.name == colname {
+			if ser.dtype != "float64" && ser.dtype != "int" {
+				return DataFrame{}, fmt.Errorf("cannot remove outliers from column '%v', %w", colname, ErrTypeMismatch)
+			}
+
+			q1Result := Q1(ser.data)
+			if q1Result.Err != nil {
+				return DataFrame{}, q1Result.Err
+			}
+			q3Result := Q3(ser.data)
+			if q3Result.Err != nil {
+				return DataFrame{}, q3Result.Err
+			}
+			iqr := q3Result.Result - q1Result.Result
+			lower := q1Result.Result - k*iqr
+			upper := q3Result.Result + k*iqr
+
+			positions := make([]int, 0)
+			for i, data := range ser.data {
+				if isNaN(data) {
+					positions = append(positions, i)
+					continue
+				}
+				var value float64
+				switch v := data.(type) {
+				case float64:
+					value = v
+				case int:
+					value = float64(v)
+				default:
+					return DataFrame{}, fmt.Errorf("cannot remove outliers from column '%v', %v is not a number", colname, data)
+				}
+				if value >= lower && value <= upper {
+					positions = append(positions, i)
 				}
 			}
+
+			return selectRows(df, positions), nil
 		}
-		newDf.index.index = newDf.series[0].index.index
 	}
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
 
-	// deleting columns containing NaN
-	// for each series, remove data at the index. length of newDf.series.data will decrease by 1.
-	// subtract 1 from each index so that it matches the new length newDf.series.data.
-	if axis == 1 {
-		for i, hasNaN := range seriesHasNaNSlice {
-			if hasNaN {
-				newDf.columns = append(newDf.columns[:i], newDf.columns[i+1:]...)
-				newDf.series = append(newDf.series[:i], newDf.series[i+1:]...)
-				seriesHasNaNSlice = append(seriesHasNaNSlice[:i], seriesHasNaNSlice[i+1:]...)
+// Duplicated returns a bool Series that marks the rows whose values in subset repeat an earlier or later row.
+// Pass in nil as subset to compare every column.
+// keep decides which occurrence is not marked as a duplicate:
+// "first" keeps the first occurrence, "last" keeps the last occurrence, and "none" marks every occurrence.
+func (df *DataFrame) Duplicated(subset []string, keep string) (Series, error) {
+	if keep != "first" && keep != "last" && keep != "none" {
+		return Series{}, fmt.Errorf("keep must be \"first\", \"last\", or \"none\", got %q", keep)
+	}
+
+	subsetSeries := make([]Series, 0)
+	if subset == nil {
+		subsetSeries = append(subsetSeries, df.series...)
+	} else {
+		for _, col := range subset {
+			ser, err := df.LocCol(col)
+			if err != nil {
+				return Series{}, err
 			}
+			subsetSeries = append(subsetSeries, ser)
 		}
 	}
 
-	return *newDf, nil
+	keys := make([]string, len(df.index.index))
+	counts := make(map[string]int)
+	for i := range keys {
+		keys[i] = rowKey(subsetSeries, i)
+		counts[keys[i]]++
+	}
+
+	duplicated := make([]interface{}, len(keys))
+	seen := make(map[string]int)
+	for i, key := range keys {
+		seen[key]++
+		switch keep {
+		case "first":
+			duplicated[i] = seen[key] > 1
+		case "last":
+			duplicated[i] = seen[key] < counts[key]
+		case "none":
+			duplicated[i] = counts[key] > 1
+		}
+	}
+
+	return NewSeries(duplicated, "Duplicated", &df.index)
 }
 
 /* Merging */
@@ -603,36 +1915,34 @@ func (df *DataFrame) DropNaN(axis int) (DataFrame, error) {
 // MergeDfsHorizontally merges two DataFrame objects side by side.
 // The target DataFrame will always be appended to the right of the source DataFrame.
 // Index will reset and become a RangeIndex.
-func (df *DataFrame) MergeDfsHorizontally(target DataFrame) (DataFrame, error) {
+// Column names that exist in both DataFrame objects are kept as is, unless a dedupSuffix is given,
+// in which case the repeated names are renamed using DedupColumns.
+func (df *DataFrame) MergeDfsHorizontally(target DataFrame, dedupSuffix ...string) (DataFrame, error) {
+	if len(dedupSuffix) > 1 {
+		return DataFrame{}, fmt.Errorf("only one dedupSuffix can be given")
+	}
+
 	newDf := copyDf(df)
-	if len(newDf.series[0].data) >= len(target.series[0].data) {
-		newDf.index = CreateRangeIndex(len(newDf.series[0].data))
-		lenDiff := len(newDf.series[0].data) - len(target.series[0].data)
+	// copy target as well, so that filling it below does not write into the caller's data
+	target = copyDf(&target)
+	if newDf.Len() >= target.Len() {
+		newDf.index = CreateRangeIndex(newDf.Len())
+		lenDiff := newDf.Len() - target.Len()
 
-		// fill missing data in target with NaN
+		// fill missing data in target with NaN, or NA for int columns
 		for i, ser := range target.series {
 			for j := 0; j < lenDiff; j++ {
-				target.series[i].data = append(target.series[i].data, math.NaN())
-			}
-
-			if ser.dtype == "int" {
-				target.series[i].data = consolidateToFloat64(target.series[i].data)
-				target.series[i].dtype = "float64"
+				target.series[i].data = append(target.series[i].data, missingValue(ser.dtype))
 			}
 		}
 	} else {
-		newDf.index = CreateRangeIndex(len(target.series[0].data))
-		lenDiff := len(target.series[0].data) - len(newDf.series[0].data)
+		lenDiff := target.Len() - newDf.Len()
+		newDf.index = CreateRangeIndex(target.Len())
 
-		// fill missing data in source with NaN
+		// fill missing data in source with NaN, or NA for int columns
 		for i, ser := range newDf.series {
 			for j := 0; j < lenDiff; j++ {
-				newDf.series[i].data = append(newDf.series[i].data, math.NaN())
-			}
-
-			if ser.dtype == "int" {
-				newDf.series[i].data = consolidateToFloat64(newDf.series[i].data)
-				newDf.series[i].dtype = "float64"
+				newDf.series[i].data = append(newDf.series[i].data, missingValue(ser.dtype))
 			}
 		}
 	}
@@ -644,11 +1954,51 @@ func (df *DataFrame) MergeDfsHorizontally(target DataFrame) (DataFrame, error) {
 		newDf.series[i].index = CreateRangeIndex(len(ser.data))
 	}
 
+	if len(dedupSuffix) == 1 {
+		return newDf.DedupColumns(dedupSuffix[0]), nil
+	}
 	return newDf, nil
 }
 
 // MergeDfsVertically stacks two DataFrame objects vertically.
-func (df *DataFrame) MergeDfsVertically(target DataFrame) (DataFrame, error) {
+// By default, both DataFrame objects must have the exact same columns and dtypes.
+// Optionally pass in a join to merge DataFrame objects with different columns.
+// "outer" keeps the union of the columns and fills columns absent in one DataFrame with NaN, or NA for int columns.
+// "inner" keeps only the columns that exist in both DataFrame objects.
+// When a join is given, the index ids are renumbered like in Concat.
+func (df *DataFrame) MergeDfsVertically(target DataFrame, join ...string) (DataFrame, error) {
+	if len(join) > 1 {
+		return DataFrame{}, fmt.Errorf("only one join can be given")
+	}
+	if len(join) == 1 {
+		switch join[0] {
+		case "outer":
+			return concatVertically([]DataFrame{*df, target})
+		case "inner":
+			commonCols := make([]string, 0)
+			for _, col := range df.columns {
+				if containsString(target.columns, col) {
+					commonCols = append(commonCols, col)
+				}
+			}
+			if len(commonCols) == 0 {
+				return DataFrame{}, fmt.Errorf("no common columns to merge")
+			}
+
+			src, err := df.LocCols(commonCols...)
+			if err != nil {
+				return DataFrame{}, err
+			}
+			tgt, err := target.LocCols(commonCols...)
+			if err != nil {
+				return DataFrame{}, err
+			}
+			return concatVertically([]DataFrame{src, tgt})
+		default:
+			return DataFrame{}, fmt.Errorf("join can only be either \"inner\" or \"outer\"")
+		}
+	}
+
 	if len(target.columns) != len(df.columns) {
 		return DataFrame{}, fmt.Errorf("number of columns is different")
 	}
@@ -678,17 +2028,161 @@ func (df *DataFrame) MergeDfsVertically(target DataFrame) (DataFrame, error) {
 		}
 	}
 
-	return newDf, nil
+	return newDf, nil
+}
+
+// Concat concatenates several DataFrame objects in one call.
+// axis=0 stacks the DataFrame objects vertically. The result has the union of all columns,
+// and columns missing in one of the DataFrame objects are filled with NaN, or NA for int columns.
+// The index of each DataFrame object is kept, but the index ids are renumbered.
+// axis=1 places the DataFrame objects side by side. Shorter DataFrame objects are filled the same way,
+// and the index will reset and become a RangeIndex.
+func Concat(dfs []DataFrame, axis int) (DataFrame, error) {
+	if axis > 1 || axis < 0 {
+		return DataFrame{}, fmt.Errorf("%w, got %d", ErrAxisOutOfRange, axis)
+	}
+	if len(dfs) == 0 {
+		return DataFrame{}, fmt.Errorf("no DataFrame to concatenate")
+	}
+	for _, df := range dfs {
+		if len(df.columns) == 0 {
+			return DataFrame{}, fmt.Errorf("cannot concatenate a DataFrame with no columns")
+		}
+	}
+
+	if axis == 1 {
+		return concatHorizontally(dfs)
+	}
+	return concatVertically(dfs)
+}
+
+// ConcatWithKeys stacks DataFrame objects vertically like Concat with axis=0,
+// and adds a "_source" column that holds keys[i] for the rows that came from dfs[i].
+// This keeps track of where each row came from after combining datasets.
+func ConcatWithKeys(dfs []DataFrame, keys []string) (DataFrame, error) {
+	if len(keys) != len(dfs) {
+		return DataFrame{}, fmt.Errorf("length of keys (%d) and DataFrame objects (%d) does not match", len(keys), len(dfs))
+	}
+
+	labeled, err := addSourceColumn(dfs, keys, "_source")
+	if err != nil {
+		return DataFrame{}, err
+	}
+	return Concat(labeled, 0)
+}
+
+// addSourceColumn returns copies of dfs, each with a new column named sourceCol filled with the matching key.
+func addSourceColumn(dfs []DataFrame, keys []string, sourceCol string) ([]DataFrame, error) {
+	labeled := make([]DataFrame, len(dfs))
+	for i, df := range dfs {
+		if containsString(df.columns, sourceCol) {
+			return nil, fmt.Errorf("column '%v' already exists in %v", sourceCol, keys[i])
+		}
+
+		source := make([]interface{}, df.Len())
+		for j := range source {
+			source[j] = keys[i]
+		}
+		newDf, err := df.NewCol(sourceCol, source)
+		if err != nil {
+			return nil, err
+		}
+		labeled[i] = newDf
+	}
+
+	return labeled, nil
+}
+
+// concatVertically stacks DataFrame objects on top of each other for Concat.
+func concatVertically(dfs []DataFrame) (DataFrame, error) {
+	newDfColumns := make([]string, 0)
+	for _, df := range dfs {
+		for _, col := range df.columns {
+			if !containsString(newDfColumns, col) {
+				newDfColumns = append(newDfColumns, col)
+			}
+		}
+	}
+
+	levels := len(dfs[0].index.names)
+	newDfIndex := IndexData{}
+	newDfIndex.names = append(newDfIndex.names, dfs[0].index.names...)
+	newDfData := make([][]interface{}, len(newDfColumns))
+	for _, df := range dfs {
+		if len(df.index.names) != levels {
+			return DataFrame{}, fmt.Errorf("number of index levels is different")
+		}
+
+		for _, index := range df.index.index {
+			newDfIndex.index = append(newDfIndex.index, Index{len(newDfIndex.index), index.value})
+		}
+
+		for i, col := range newDfColumns {
+			found := false
+			for _, ser := range df.series {
+				if ser.name == col {
+					newDfData[i] = append(newDfData[i], ser.data...)
+					found = true
+					break
+				}
+			}
+			if !found {
+				// NewDataFrame turns NA into NaN unless the column is int
+				for j := 0; j < len(df.index.index); j++ {
+					newDfData[i] = append(newDfData[i], NA)
+				}
+			}
+		}
+	}
+
+	newDf, err := NewDataFrame(newDfData, newDfColumns, nil)
+	if err != nil {
+		return DataFrame{}, err
+	}
+
+	newDf.index = newDfIndex
+	for i := range newDf.series {
+		newDf.series[i].index = newDfIndex
+	}
+
+	return newDf, nil
+}
+
+// concatHorizontally places DataFrame objects side by side for Concat.
+func concatHorizontally(dfs []DataFrame) (DataFrame, error) {
+	maxLength := 0
+	for _, df := range dfs {
+		if df.index.Len() > maxLength {
+			maxLength = df.index.Len()
+		}
+	}
+
+	newDfData := make([][]interface{}, 0)
+	newDfColumns := make([]string, 0)
+	for _, df := range dfs {
+		for _, ser := range df.series {
+			data := make([]interface{}, 0, maxLength)
+			data = append(data, ser.data...)
+			for len(data) < maxLength {
+				data = append(data, NA)
+			}
+			newDfData = append(newDfData, data)
+			newDfColumns = append(newDfColumns, ser.name)
+		}
+	}
+
+	return NewDataFrame(newDfData, newDfColumns, nil)
 }
 
 /* Sorting Functions */
 
 // SortByIndex sorts the items by index.
 func (df *DataFrame) SortByIndex(ascending bool) error {
-	if len(df.series) > 0 {
-		for i := range df.series {
-			df.series[i].SortByIndex(ascending)
-		}
+	if len(df.series) == 0 {
+		return nil
+	}
+	for i := range df.series {
+		df.series[i].SortByIndex(ascending)
 	}
 	df.index = df.series[0].index
 	return nil
@@ -713,6 +2207,57 @@ func (df *DataFrame) SortByValues(by string, ascending bool) error {
 	return nil
 }
 
+// SortByValuesStable sorts the rows by the values in a selected column using a stable sort.
+// Rows with equal values keep their relative order, so sorting by a secondary column first
+// and then by the primary column sorts by both. NaN values are always placed last.
+func (df *DataFrame) SortByValuesStable(by string, ascending bool) error {
+	return df.sortRowsStable([]string{by}, []bool{ascending})
+}
+
+// SortByColumnsValues sorts the rows by multiple columns, using each later column to break ties in the earlier ones.
+// ascending sets the direction of each column in by, so both slices need to be of the same length.
+// NaN values are always placed last.
+func (df *DataFrame) SortByColumnsValues(by []string, ascending []bool) error {
+	if len(by) != len(ascending) {
+		return fmt.Errorf("length of by (%d) and ascending (%d) does not match", len(by), len(ascending))
+	}
+
+	return df.sortRowsStable(by, ascending)
+}
+
+// sortRowsStable reorders the rows of the DataFrame by the given columns, using each later column to break ties.
+func (df *DataFrame) sortRowsStable(by []string, ascending []bool) error {
+	keys := make([]Series, len(by))
+	for i, col := range by {
+		ser, err := df.LocCol(col)
+		if err != nil {
+			return err
+		}
+		keys[i] = ser
+	}
+
+	positions := make([]int, len(df.index.index))
+	for i := range positions {
+		positions[i] = i
+	}
+	sort.SliceStable(positions, func(i, j int) bool {
+		for k, ser := range keys {
+			order := compareValues(ser.data[positions[i]], ser.data[positions[j]])
+			if order == 0 {
+				continue
+			}
+			if isNaN(ser.data[positions[i]]) || isNaN(ser.data[positions[j]]) || ascending[k] {
+				return order < 0
+			}
+			return order > 0
+		}
+		return false
+	})
+
+	*df = selectRows(df, positions)
+	return nil
+}
+
 // SortByColumns sorts the columns of the DataFrame object.
 func (df *DataFrame) SortByColumns() {
 	sort.Slice(df.series, func(i, j int) bool {
@@ -735,13 +2280,172 @@ func (df *DataFrame) SortIndexColFirst() {
 	}
 }
 
+// Nlargest returns the n rows with the largest values in the given column, ordered from the largest.
+// Rows keep their original index. Ties are broken by row order, and NaN values are skipped.
+func (df *DataFrame) Nlargest(n int, by string) (DataFrame, error) {
+	return df.nExtremeRows(n, by, true)
+}
+
+// Nsmallest returns the n rows with the smallest values in the given column, ordered from the smallest.
+// Rows keep their original index. Ties are broken by row order, and NaN values are skipped.
+func (df *DataFrame) Nsmallest(n int, by string) (DataFrame, error) {
+	return df.nExtremeRows(n, by, false)
+}
+
+// nExtremeRows is the shared implementation of Nlargest and Nsmallest.
+func (df *DataFrame) nExtremeRows(n int, by string, largest bool) (DataFrame, error) {
+	for _, ser := range df.series {
+		if ser.name == by {
+			if ser.dtype != "float64" && ser.dtype != "int" {
+				return DataFrame{}, fmt.Errorf("column %w", ErrTypeMismatch)
+			}
+
+			positions, err := nExtremePositions(ser.data, n, largest)
+			if err != nil {
+				return DataFrame{}, err
+			}
+
+			return selectRows(df, positions), nil
+		}
+	}
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, by)
+}
+
+// Idxmax returns the index value of the row holding the largest value in the given column.
+// NaN values are skipped, and if the largest value appears more than once, the first row is returned.
+func (df *DataFrame) Idxmax(colname string) ([]interface{}, error) {
+	return df.idxExtreme(colname, true)
+}
+
+// Idxmin returns the index value of the row holding the smallest value in the given column.
+// NaN values are skipped, and if the smallest value appears more than once, the first row is returned.
+func (df *DataFrame) Idxmin(colname string) ([]interface{}, error) {
+	return df.idxExtreme(colname, false)
+}
+
+// idxExtreme is the shared implementation of Idxmax and Idxmin.
+func (df *DataFrame) idxExtreme(colname string, largest bool) ([]interface{}, error) {
+	for _, ser := range df.series {
+		if ser.name == colname {
+			if ser.dtype != "float64" && ser.dtype != "int" {
+				return nil, fmt.Errorf("column %w", ErrTypeMismatch)
+			}
+
+			positions, err := nExtremePositions(ser.data, 1, largest)
+			if err != nil {
+				return nil, err
+			}
+			if len(positions) == 0 {
+				return nil, fmt.Errorf("column '%v' has no values other than NaN", colname)
+			}
+
+			value := make([]interface{}, len(df.index.index[positions[0]].value))
+			copy(value, df.index.index[positions[0]].value)
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
+
 /* Reshaping Fuctions */
 
+// SampleWeighted draws n rows at random, with replacement, and returns them as a new DataFrame object.
+// Each row is drawn with a probability proportional to its value in weightCol, which must be a numeric column
+// with non-negative values. Missing weights count as 0.
+// The same seed always draws the same rows, so results can be reproduced.
+func (df *DataFrame) SampleWeighted(n int, weightCol string, seed int64) (DataFrame, error) {
+	if n < 0 {
+		return DataFrame{}, fmt.Errorf("n cannot be negative, got %d", n)
+	}
+	weightSer, err := df.LocCol(weightCol)
+	if err != nil {
+		return DataFrame{}, err
+	}
+	if weightSer.dtype != "float64" && weightSer.dtype != "int" {
+		return DataFrame{}, fmt.Errorf("cannot sample, weight column %w", ErrTypeMismatch)
+	}
+
+	// cumulative[i] is the total weight of the rows up to and including i
+	cumulative := make([]float64, len(weightSer.data))
+	total := 0.0
+	for i, data := range weightSer.data {
+		if !isNaN(data) {
+			weight, err := i2f(data)
+			if err != nil {
+				return DataFrame{}, err
+			}
+			if weight < 0 {
+				return DataFrame{}, fmt.Errorf("weights cannot be negative, got %v at position %d", weight, i)
+			}
+			total += weight
+		}
+		cumulative[i] = total
+	}
+	if n > 0 && total == 0 {
+		return DataFrame{}, fmt.Errorf("weights must not all be zero")
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	positions := make([]int, n)
+	for i := range positions {
+		// find the first row whose cumulative weight exceeds the target, which skips rows of weight 0
+		target := r.Float64() * total
+		positions[i] = sort.Search(len(cumulative), func(j int) bool { return cumulative[j] > target })
+	}
+
+	return selectRows(df, positions), nil
+}
+
+// TrainTestSplit splits the rows of a DataFrame at random into a train set and a test set.
+// testFrac is the fraction of rows that go into the test set, and must be between 0 and 1.
+// If stratifyCol is not empty, each group of equal values in stratifyCol is split separately,
+// so that both sets keep the proportions of the groups. Pass in "" to split without stratification.
+// Both sets keep the original order of the rows, and the same seed always gives the same split.
+func (df *DataFrame) TrainTestSplit(testFrac float64, stratifyCol string, seed int64) (train, test DataFrame, err error) {
+	if testFrac <= 0 || testFrac >= 1 {
+		return DataFrame{}, DataFrame{}, fmt.Errorf("testFrac must be between 0 and 1, got %v", testFrac)
+	}
+
+	groups := [][]int{make([]int, df.Len())}
+	for i := range groups[0] {
+		groups[0][i] = i
+	}
+	if stratifyCol != "" {
+		if !containsString(df.columns, stratifyCol) {
+			return DataFrame{}, DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, stratifyCol)
+		}
+		gb, err := df.GroupBy(stratifyCol)
+		if err != nil {
+			return DataFrame{}, DataFrame{}, err
+		}
+		groups, err = gb.groupPositions()
+		if err != nil {
+			return DataFrame{}, DataFrame{}, err
+		}
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	trainPositions := make([]int, 0)
+	testPositions := make([]int, 0)
+	for _, group := range groups {
+		shuffled := append([]int{}, group...)
+		r.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		testLen := int(math.Round(testFrac * float64(len(shuffled))))
+		testPositions = append(testPositions, shuffled[:testLen]...)
+		trainPositions = append(trainPositions, shuffled[testLen:]...)
+	}
+	sort.Ints(trainPositions)
+	sort.Ints(testPositions)
+
+	return selectRows(df, trainPositions), selectRows(df, testPositions), nil
+}
+
 // Pivot returns an organized Dataframe that has values corresponding to the index and the given column.
+// Pivot returns an error if the same index and column pair has more than one value.
+// Use PivotTable to aggregate duplicate entries instead.
 func (df *DataFrame) Pivot(column, value string) (DataFrame, error) {
-	// check if index contains duplicate entires.
-	// for the same index, if column has a value that is repeated, then raise an error.
-
 	// loc each individual values, then concat them.
 	filteredDf, err := df.LocCols(column, value)
 	if err != nil {
@@ -780,6 +2484,9 @@ func (df *DataFrame) Pivot(column, value string) (DataFrame, error) {
 					return DataFrame{}, err
 				}
 
+				if _, exists := dm.indexValueMap[*innerKey]; exists {
+					return DataFrame{}, fmt.Errorf("index %v has more than one value for column '%v', use PivotTable with an aggregation function instead", index.value, colname)
+				}
 				dm.indexValueMap[*innerKey] = filteredDf.series[1].data[i]
 			}
 		}
@@ -798,14 +2505,13 @@ func (df *DataFrame) Pivot(column, value string) (DataFrame, error) {
 
 					val, exists := dm.indexValueMap[*innerKey]
 					if !exists {
-						switch filteredDf.series[1].data[0].(type) {
-						case string:
+						switch filteredDf.series[1].dtype {
+						case "string":
 							eachColData = append(eachColData, "")
-						case float64:
-							eachColData = append(eachColData, math.NaN())
-						case int:
-							// should make a null for integer later
+						case "float64":
 							eachColData = append(eachColData, math.NaN())
+						case "int":
+							eachColData = append(eachColData, NA)
 						}
 					} else {
 						eachColData = append(eachColData, val)
@@ -836,6 +2542,22 @@ func (df *DataFrame) Pivot(column, value string) (DataFrame, error) {
 // Pick three columns from the DataFrame, each to serve as the index, column, and value.
 // PivotTable ignores NaN values.
 func (df *DataFrame) PivotTable(index, column, value string, aggFunc StatsFunc) (DataFrame, error) {
+	return df.PivotTableContext(context.Background(), index, column, value, aggFunc)
+}
+
+// PivotTableContext is like PivotTable, but stops and returns ctx.Err() once ctx is cancelled.
+func (df *DataFrame) PivotTableContext(ctx context.Context, index, column, value string, aggFunc StatsFunc) (DataFrame, error) {
+	return df.pivotTable(ctx, index, column, value, aggFunc)
+}
+
+// PivotTableWith is like PivotTable, but accepts any Aggregator.
+// Pass an AggFunc to aggregate values that are not float64, such as the most common string in each cell.
+func (df *DataFrame) PivotTableWith(index, column, value string, agg Aggregator) (DataFrame, error) {
+	return df.pivotTable(context.Background(), index, column, value, agg)
+}
+
+// pivotTable implements PivotTable, PivotTableContext and PivotTableWith.
+func (df *DataFrame) pivotTable(ctx context.Context, index, column, value string, agg Aggregator) (DataFrame, error) {
 	filteredData, err := df.LocColsItems(index, column, value)
 	if err != nil {
 		return DataFrame{}, err
@@ -857,6 +2579,9 @@ func (df *DataFrame) PivotTable(index, column, value string, aggFunc StatsFunc)
 	uniqueIndexSlice := make([]string, 0)
 	uniqueColSlice := make([]string, 0)
 	for i, col := range filteredData[1] {
+		if i%contextCheckInterval == 0 && ctx.Err() != nil {
+			return DataFrame{}, ctx.Err()
+		}
 		idx := filteredData[0][i]
 		val := filteredData[2][i]
 
@@ -884,6 +2609,9 @@ func (df *DataFrame) PivotTable(index, column, value string, aggFunc StatsFunc)
 
 	valSlice := make([][]interface{}, 0)
 	for i, col := range uniqueColSlice {
+		if ctx.Err() != nil {
+			return DataFrame{}, ctx.Err()
+		}
 		val := make([]interface{}, 0)
 		for _, idx := range uniqueIndexSlice {
 			index := Index{i, []interface{}{idx, col}}
@@ -892,16 +2620,13 @@ func (df *DataFrame) PivotTable(index, column, value string, aggFunc StatsFunc)
 				return DataFrame{}, err
 			}
 
-			result := aggFunc(dataMap[*key])
-			if result.Err != nil {
-				if math.IsNaN(result.Result) {
-
-				} else {
-					return DataFrame{}, result.Err
-				}
+			// errors that come with a NaN result, such as from an empty cell, leave the cell as NaN
+			result, err := agg.Aggregate(dataMap[*key])
+			if err != nil && !isNaN(result) {
+				return DataFrame{}, err
 			}
 
-			val = append(val, result.Result)
+			val = append(val, result)
 		}
 		valSlice = append(valSlice, val)
 	}
@@ -926,24 +2651,65 @@ func (df *DataFrame) PivotTable(index, column, value string, aggFunc StatsFunc)
 
 // Melt returns the table from wide to long format.
 // Use Melt to revert to pre-Pivot format.
-func (df *DataFrame) Melt(colName, valueName string) (DataFrame, error) {
-	newDfIndexSlice := make([]interface{}, 0)
+// idVars are columns kept as identifiers. Their values are repeated for every melted row.
+// valueVars are the columns to unpivot into colName and valueName. If valueVars is nil, every column not in idVars is used.
+// The index of the DataFrame is kept as the leading columns and the index of the result.
+func (df *DataFrame) Melt(colName, valueName string, idVars, valueVars []string) (DataFrame, error) {
+	idSeries := make([]Series, 0, len(idVars))
+	for _, idVar := range idVars {
+		ser, err := df.LocCol(idVar)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		idSeries = append(idSeries, ser)
+	}
+
+	valueSeries := make([]Series, 0)
+	if valueVars == nil {
+		for _, ser := range df.series {
+			if !containsString(idVars, ser.name) {
+				valueSeries = append(valueSeries, ser)
+			}
+		}
+	} else {
+		for _, valueVar := range valueVars {
+			if containsString(idVars, valueVar) {
+				return DataFrame{}, fmt.Errorf("column '%v' cannot be both an id and a value variable", valueVar)
+			}
+			ser, err := df.LocCol(valueVar)
+			if err != nil {
+				return DataFrame{}, err
+			}
+			valueSeries = append(valueSeries, ser)
+		}
+	}
+
+	newDfIndexSlices := make([][]interface{}, len(df.index.names))
+	newDfIdSlices := make([][]interface{}, len(idSeries))
 	newDfColumnSlice := make([]interface{}, 0)
 	newDfValueSlice := make([]interface{}, 0)
 
 	for i, idx := range df.index.index {
-		for _, col := range df.series {
-			newDfIndexSlice = append(newDfIndexSlice, idx.value...)
+		for _, col := range valueSeries {
+			for level, value := range idx.value {
+				newDfIndexSlices[level] = append(newDfIndexSlices[level], value)
+			}
+			for j, ser := range idSeries {
+				newDfIdSlices[j] = append(newDfIdSlices[j], ser.data[i])
+			}
 			newDfColumnSlice = append(newDfColumnSlice, col.name)
 			newDfValueSlice = append(newDfValueSlice, col.data[i])
 		}
 	}
 
 	newDfSlice := make([][]interface{}, 0)
-	newDfSlice = append(newDfSlice, newDfIndexSlice, newDfColumnSlice, newDfValueSlice)
+	newDfSlice = append(newDfSlice, newDfIndexSlices...)
+	newDfSlice = append(newDfSlice, newDfIdSlices...)
+	newDfSlice = append(newDfSlice, newDfColumnSlice, newDfValueSlice)
 
 	colNameSlice := make([]string, 0)
 	colNameSlice = append(colNameSlice, df.index.names...)
+	colNameSlice = append(colNameSlice, idVars...)
 	colNameSlice = append(colNameSlice, colName, valueName)
 	newDf, err := NewDataFrame(newDfSlice, colNameSlice, df.index.names)
 	if err != nil {
@@ -953,8 +2719,65 @@ func (df *DataFrame) Melt(colName, valueName string) (DataFrame, error) {
 	return newDf, nil
 }
 
+// Explode expands each list cell in a column into one row per element, and returns the result as a new DataFrame object.
+// List cells are []interface{} values, such as nested JSON arrays, which are held in columns of dtype "object".
+// The other columns and the index labels of a row are repeated for each of its elements.
+// Cells that are not lists produce a single row, and empty lists produce a single row of NaN.
+// The exploded column gets a new dtype from its elements, and the index ids are renumbered from 0.
+func (df *DataFrame) Explode(colname string) (DataFrame, error) {
+	if containsString(df.index.names, colname) {
+		return DataFrame{}, fmt.Errorf("cannot explode index column '%v'", colname)
+	}
+	for k, ser := range df.series {
+		if ser.name != colname {
+			continue
+		}
+
+		positions := make([]int, 0, len(ser.data))
+		exploded := make([]interface{}, 0, len(ser.data))
+		for i, data := range ser.data {
+			list, ok := data.([]interface{})
+			switch {
+			case !ok:
+				positions = append(positions, i)
+				exploded = append(exploded, data)
+			case len(list) == 0:
+				positions = append(positions, i)
+				exploded = append(exploded, math.NaN())
+			default:
+				for _, element := range list {
+					positions = append(positions, i)
+					exploded = append(exploded, element)
+				}
+			}
+		}
+
+		newDf := selectRows(df, positions)
+		for i := range newDf.index.index {
+			newDf.index.index[i].id = i
+		}
+		for i := range newDf.series {
+			copy(newDf.series[i].index.index, newDf.index.index)
+		}
+
+		newSer, err := NewSeries(exploded, colname, &newDf.index)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		newDf.series[k] = newSer
+
+		return newDf, nil
+	}
+	return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, colname)
+}
+
 // GroupBy groups selected columns in a DataFrame object and returns a GroupBy object.
 func (df *DataFrame) GroupBy(by ...string) (GroupBy, error) {
+	return df.GroupByContext(context.Background(), by...)
+}
+
+// GroupByContext is like GroupBy, but stops and returns ctx.Err() once ctx is cancelled.
+func (df *DataFrame) GroupByContext(ctx context.Context, by ...string) (GroupBy, error) {
 	filtered, err := df.LocCols(by...)
 	if err != nil {
 		return GroupBy{}, err
@@ -964,6 +2787,9 @@ func (df *DataFrame) GroupBy(by ...string) (GroupBy, error) {
 	colTuples := make([][]interface{}, 0)
 
 	for i, row := range filtered.index.index {
+		if i%contextCheckInterval == 0 && ctx.Err() != nil {
+			return GroupBy{}, ctx.Err()
+		}
 		colTuple := make([]interface{}, 0)
 		for _, ser := range filtered.series {
 			colTuple = append(colTuple, ser.data[i])
@@ -990,6 +2816,215 @@ func (df *DataFrame) GroupBy(by ...string) (GroupBy, error) {
 	return *gb, nil
 }
 
+// GroupByFunc groups the rows of a DataFrame object by a key derived from each row, and returns a GroupBy object.
+// keyFn is called with a map from column names to the values of a row, and returns the key of the group the row belongs to.
+// For example, a date column can be bucketed into weekdays. The keys become a column named "group" in aggregated results.
+func (df *DataFrame) GroupByFunc(keyFn func(row map[string]interface{}) string) (GroupBy, error) {
+	colIndMap := make(map[string][]interface{})
+	colTuples := make([][]interface{}, 0)
+
+	for i, row := range df.index.index {
+		rowMap := make(map[string]interface{}, len(df.series))
+		for _, ser := range df.series {
+			rowMap[ser.name] = ser.data[i]
+		}
+
+		colTuple := []interface{}{keyFn(rowMap)}
+		index := Index{i, colTuple}
+		key, err := index.hashKeyValueOnly()
+		if err != nil {
+			return GroupBy{}, err
+		}
+
+		if _, ok := colIndMap[*key]; !ok {
+			colTuples = append(colTuples, colTuple)
+		}
+		colIndMap[*key] = append(colIndMap[*key], row.id)
+	}
+
+	gb := new(GroupBy)
+	gb.dataFrame = df
+	gb.colIndMap = colIndMap
+	gb.colTuples = colTuples
+	gb.colTuplesLabels = []string{"group"}
+
+	return *gb, nil
+}
+
+// Info returns a summary of the DataFrame object, similar to pandas' df.info().
+// It lists each column's dtype, the number of non-NaN values, and its approximate size in bytes.
+func (df *DataFrame) Info() string {
+	var sb strings.Builder
+	usage := df.columnSizes()
+
+	fmt.Fprintf(&sb, "DataFrame: %d entries, index %v\n", df.index.Len(), df.index.names)
+	fmt.Fprintf(&sb, "Data columns (total %d columns):\n", len(df.columns))
+
+	w := new(tabwriter.Writer)
+	w.Init(&sb, 5, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "#\tColumn\tNon-Null Count\tDtype\tBytes\t")
+
+	total := 0
+	for i, ser := range df.series {
+		nonNull := 0
+		for _, data := range ser.data {
+			if !isNaN(data) {
+				nonNull++
+			}
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d non-null\t%s\t%d\t\n", i, ser.name, nonNull, ser.dtype, usage[i])
+		total += usage[i]
+	}
+	w.Flush()
+
+	fmt.Fprintf(&sb, "memory usage: %d bytes\n", total)
+	return sb.String()
+}
+
+// MemoryUsage returns the approximate number of bytes used by the data in each column.
+// The size of each element includes the interface{} that holds it.
+// Columns that share a name are summed under that name.
+func (df *DataFrame) MemoryUsage() map[string]int {
+	usage := make(map[string]int)
+	for i, size := range df.columnSizes() {
+		usage[df.series[i].name] += size
+	}
+
+	return usage
+}
+
+// columnSizes returns the approximate number of bytes used by the data in each column, in the order of the columns.
+func (df *DataFrame) columnSizes() []int {
+	sizes := make([]int, len(df.series))
+	for i, ser := range df.series {
+		for _, data := range ser.data {
+			sizes[i] += approxSize(data)
+		}
+	}
+
+	return sizes
+}
+
+// MemOptimize returns a copy of the DataFrame that uses less memory.
+// float64 columns whose values are all whole numbers within the range of int become int columns, with NaN becoming NA,
+// which undoes the float64 promotion of int columns that have blanks in them.
+// Index columns are left unchanged.
+func (df *DataFrame) MemOptimize() DataFrame {
+	newDf := copyDf(df)
+	for i, ser := range newDf.series {
+		if ser.dtype != "float64" || containsString(newDf.index.names, ser.name) {
+			continue
+		}
+		if data, ok := wholeFloatsToInt(ser.data); ok {
+			newDf.series[i].data = data
+			newDf.series[i].dtype = "int"
+		}
+	}
+
+	return newDf
+}
+
+// Dtypes returns the data type of each column.
+func (df *DataFrame) Dtypes() map[string]string {
+	dtypes := make(map[string]string, len(df.series))
+	for _, ser := range df.series {
+		dtypes[ser.name] = ser.dtype
+	}
+
+	return dtypes
+}
+
+// ColumnSchema describes a single column of a DataFrame.
+// Nullable is true if the column holds at least one NaN value.
+type ColumnSchema struct {
+	Name     string
+	Dtype    string
+	Nullable bool
+}
+
+// Schema returns the name, data type, and nullability of each column, in column order.
+func (df *DataFrame) Schema() []ColumnSchema {
+	schema := make([]ColumnSchema, len(df.series))
+	for i, ser := range df.series {
+		nullable := false
+		for _, data := range ser.data {
+			if isNaN(data) {
+				nullable = true
+				break
+			}
+		}
+		schema[i] = ColumnSchema{ser.name, ser.dtype, nullable}
+	}
+
+	return schema
+}
+
+// Hash returns a SHA-256 fingerprint of the DataFrame as a hex string.
+// The column names, dtypes, index names, index values, and cell values are hashed in order,
+// so two DataFrame objects with the same contents always have the same hash, wherever they are stored in memory.
+// Index ids are not hashed, and all missing values, whether NaN or NA, hash the same.
+func (df *DataFrame) Hash() (string, error) {
+	h := sha256.New()
+	writeValue := func(v interface{}) {
+		if isNaN(v) {
+			fmt.Fprint(h, "NaN\n")
+			return
+		}
+		// the type and quoted value keep 1 and "1", or "a,b" and "a" "b", from hashing the same
+		fmt.Fprintf(h, "%T %q\n", v, fmt.Sprint(v))
+	}
+
+	fmt.Fprintf(h, "columns %d\n", len(df.series))
+	for _, ser := range df.series {
+		writeValue(ser.name)
+		writeValue(ser.dtype)
+	}
+
+	fmt.Fprintf(h, "index %d %d\n", len(df.index.names), len(df.index.index))
+	for _, name := range df.index.names {
+		writeValue(name)
+	}
+	for _, index := range df.index.index {
+		for _, value := range index.value {
+			writeValue(value)
+		}
+	}
+
+	for _, ser := range df.series {
+		fmt.Fprintf(h, "data %d\n", len(ser.data))
+		for _, data := range ser.data {
+			writeValue(data)
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Pipe applies each function to the DataFrame in the given order, passing the result of one function to the next.
+// It stops at the first function that returns an error.
+func (df *DataFrame) Pipe(fns ...func(DataFrame) (DataFrame, error)) (DataFrame, error) {
+	newDf := copyDf(df)
+	for i, fn := range fns {
+		result, err := fn(newDf)
+		if err != nil {
+			return DataFrame{}, fmt.Errorf("function %d in pipe failed: %w", i, err)
+		}
+		newDf = result
+	}
+
+	return newDf, nil
+}
+
+// Len returns the number of rows in a DataFrame object.
+func (df *DataFrame) Len() int {
+	return df.index.Len()
+}
+
+// Empty returns true if a DataFrame object has no rows or no columns.
+func (df *DataFrame) Empty() bool {
+	return df.Len() == 0 || len(df.series) == 0
+}
+
 func (df DataFrame) Shape() (shape [2]int) {
 	shape[0] = df.index.Len()
 	shape[1] = len(df.columns)
@@ -997,9 +3032,7 @@ func (df DataFrame) Shape() (shape [2]int) {
 }
 
 func (df DataFrame) GetRecords() (resMapList []map[string]interface{}) {
-	df.Print()
-	fmt.Println(df.Shape())
-	resMapList = make([]map[string]interface{}, df.Shape()[0])
+	resMapList = make([]map[string]interface{}, df.Len())
 	for cindex, col := range df.columns {
 		for sindex := range resMapList {
 			resMap := resMapList[sindex]
@@ -1013,3 +3046,58 @@ func (df DataFrame) GetRecords() (resMapList []map[string]interface{}) {
 	}
 	return
 }
+
+// Values returns a copy of the cell values of the DataFrame as a 2D slice, index columns included.
+// If rowMajor is false, there is one slice per column, in the same layout that NewDataFrame takes as data.
+// If rowMajor is true, there is one slice per row instead.
+func (df *DataFrame) Values(rowMajor bool) [][]interface{} {
+	if !rowMajor {
+		values := make([][]interface{}, len(df.series))
+		for i, ser := range df.series {
+			values[i] = make([]interface{}, len(ser.data))
+			copy(values[i], ser.data)
+		}
+		return values
+	}
+
+	values := make([][]interface{}, df.Len())
+	for i := range values {
+		values[i] = make([]interface{}, len(df.series))
+		for j, ser := range df.series {
+			values[i][j] = ser.data[i]
+		}
+	}
+	return values
+}
+
+// Row holds a single row of a DataFrame, as sent by IterRows.
+// Index is the index tuple of the row, and Values maps each column name to its value.
+type Row struct {
+	Index  []interface{}
+	Values map[string]interface{}
+}
+
+// IterRows returns a channel that yields each row of the DataFrame in order.
+// The channel is closed after the last row is sent.
+// Close done to stop early; the iterating goroutine then exits without sending the remaining rows.
+func (df *DataFrame) IterRows(done <-chan struct{}) <-chan Row {
+	rows := make(chan Row)
+	go func() {
+		defer close(rows)
+		for i, idx := range df.index.index {
+			values := make(map[string]interface{}, len(df.series))
+			for _, ser := range df.series {
+				values[ser.name] = ser.data[i]
+			}
+			row := Row{append([]interface{}{}, idx.value...), values}
+
+			select {
+			case rows <- row:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return rows
+}
@@ -1,9 +1,17 @@
 package gambas
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/rand"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -90,6 +98,172 @@ func TestDataFramePrintRange(t *testing.T) {
 	}
 }
 
+func TestDataFrameFloatFormat(t *testing.T) {
+	defer func(format, rep string) {
+		SetFloatFormat(format)
+		SetNaNRep(rep)
+	}(floatFormat, nanRep)
+
+	testDf, err := NewDataFrame(
+		[][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.0 / 3.0, math.NaN(), 1e-7}},
+		[]string{"Name", "Age", "Score"},
+		[]string{"Name"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type floatFormatTest struct {
+		floatFormat   string
+		nanRep        string
+		expected      string
+		expectedError error
+	}
+	floatFormatTests := []floatFormatTest{
+		{
+			"",
+			"NaN",
+			"Name       |    Name       Age    Score                 \n" +
+				"Avery      |    Avery      19     0.3333333333333333    \n" +
+				"Bradley    |    Bradley    NaN    NaN                   \n" +
+				"Candice    |    Candice    22     1e-07                 \n",
+			nil,
+		},
+		{
+			"%.4f",
+			"",
+			"Name       |    Name       Age    Score     \n" +
+				"Avery      |    Avery      19     0.3333    \n" +
+				"Bradley    |    Bradley                     \n" +
+				"Candice    |    Candice    22     0.0000    \n",
+			nil,
+		},
+		{
+			"%.2e",
+			"-",
+			"Name       |    Name       Age    Score       \n" +
+				"Avery      |    Avery      19     3.33e-01    \n" +
+				"Bradley    |    Bradley    -      -           \n" +
+				"Candice    |    Candice    22     1.00e-07    \n",
+			nil,
+		},
+		{
+			"%d",
+			"NaN",
+			"Name       |    Name       Age    Score       \n" +
+				"Avery      |    Avery      19     3.33e-01    \n" +
+				"Bradley    |    Bradley    NaN    NaN         \n" +
+				"Candice    |    Candice    22     1.00e-07    \n",
+			fmt.Errorf("invalid float format %q", "%d"),
+		},
+	}
+
+	for _, test := range floatFormatTests {
+		err := SetFloatFormat(test.floatFormat)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+		SetNaNRep(test.nanRep)
+		var buf bytes.Buffer
+		testDf.fprintRange(&buf, 0, testDf.Len())
+		if buf.String() != test.expected {
+			t.Fatalf("expected %q, got %q", test.expected, buf.String())
+		}
+	}
+}
+
+func TestDataFramePrintTruncated(t *testing.T) {
+	defer func(rows, cols int) {
+		SetMaxRows(rows)
+		SetMaxCols(cols)
+	}(maxRows, maxCols)
+
+	newTestDf := func(rows, cols int) DataFrame {
+		data := make([][]interface{}, cols)
+		columns := make([]string, cols)
+		for j := range data {
+			data[j] = make([]interface{}, rows)
+			for i := range data[j] {
+				data[j][i] = i*cols + j
+			}
+			columns[j] = fmt.Sprintf("c%d", j)
+		}
+		newDf, err := NewDataFrame(data, columns, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return newDf
+	}
+
+	type printTruncatedTest struct {
+		arg1     DataFrame
+		maxRows  int
+		maxCols  int
+		expected string
+	}
+	printTruncatedTests := []printTruncatedTest{
+		{
+			newTestDf(10, 6),
+			4,
+			4,
+			"       |    c0     c1     ...    c4     c5     \n" +
+				"0      |    0      1      ...    4      5      \n" +
+				"1      |    6      7      ...    10     11     \n" +
+				"...    |    ...    ...    ...    ...    ...    \n" +
+				"8      |    48     49     ...    52     53     \n" +
+				"9      |    54     55     ...    58     59     \n" +
+				"\n" +
+				"[10 rows x 6 columns]\n",
+		},
+		{
+			newTestDf(3, 2),
+			4,
+			4,
+			"     |    c0    c1    \n" +
+				"0    |    0     1     \n" +
+				"1    |    2     3     \n" +
+				"2    |    4     5     \n",
+		},
+		{
+			newTestDf(10, 6),
+			0,
+			0,
+			"     |    c0    c1    c2    c3    c4    c5    \n" +
+				"0    |    0     1     2     3     4     5     \n" +
+				"1    |    6     7     8     9     10    11    \n" +
+				"2    |    12    13    14    15    16    17    \n" +
+				"3    |    18    19    20    21    22    23    \n" +
+				"4    |    24    25    26    27    28    29    \n" +
+				"5    |    30    31    32    33    34    35    \n" +
+				"6    |    36    37    38    39    40    41    \n" +
+				"7    |    42    43    44    45    46    47    \n" +
+				"8    |    48    49    50    51    52    53    \n" +
+				"9    |    54    55    56    57    58    59    \n",
+		},
+	}
+
+	for _, test := range printTruncatedTests {
+		SetMaxRows(test.maxRows)
+		SetMaxCols(test.maxCols)
+		var buf bytes.Buffer
+		test.arg1.fprint(&buf)
+		if buf.String() != test.expected {
+			t.Fatalf("expected %q, got %q", test.expected, buf.String())
+		}
+	}
+
+	// a large frame is truncated with the default limits
+	SetMaxRows(60)
+	SetMaxCols(20)
+	var buf bytes.Buffer
+	largeDf := newTestDf(1000, 30)
+	largeDf.fprint(&buf)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 64 || !strings.HasPrefix(lines[31], "...") || !strings.Contains(lines[0], "...") || lines[63] != "[1000 rows x 30 columns]" {
+		t.Fatalf("expected 60 rows and 20 columns with ellipses, got %q", buf.String())
+	}
+}
+
 func TestDataFrameHead(t *testing.T) {
 	type headTest struct {
 		arg1 DataFrame
@@ -132,46 +306,26 @@ func TestDataFrameTail(t *testing.T) {
 	}
 }
 
-func BenchmarkDataFrameLocRows(b *testing.B) {
-	nbaDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	neoDf, err := ReadCsv("testfiles/neo_v2.csv", []string{"id"})
-	if err != nil {
-		b.Error(err)
-	}
-	nbaCol, err := readCsvColIntoData("testfiles/nba.csv", "Name")
-	if err != nil {
-		b.Error(err)
-	}
-	neoCol, err := readCsvColIntoData("testfiles/neo_v2.csv", "id")
+func BenchmarkDataFrameHeadDf(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
 	}
-	dfList := []DataFrame{nbaDf, neoDf}
-	colList := [][][]interface{}{nbaCol, neoCol}
+	b.ResetTimer()
 
-	for i := 0; i < 2; i++ {
-		b.Run(fmt.Sprintf("case_%d", i), func(b *testing.B) {
-			df := dfList[i]
-			col := colList[i]
-			b.ResetTimer()
-			for i := 0; i < b.N; i++ {
-				df.LocRows(col[rand.Intn(len(col))])
-			}
-		})
+	for i := 0; i < b.N; i++ {
+		testDf.HeadDf(10)
 	}
-
 }
 
-func TestDataFrameLocRows(t *testing.T) {
-	type dataframeLocRowsTest struct {
-		arg1     DataFrame
-		arg2     [][]interface{}
-		expected DataFrame
-	}
-	dataframeLocRowsTests := []dataframeLocRowsTest{
+func TestDataFrameHeadDf(t *testing.T) {
+	type headDfTest struct {
+		arg1          DataFrame
+		arg2          int
+		expected      DataFrame
+		expectedError error
+	}
+	headDfTests := []headDfTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -180,31 +334,31 @@ func TestDataFrameLocRows(t *testing.T) {
 				}
 				return newDf
 			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			[][]interface{}{{"Avery"}},
+			2,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery"},
+						[]interface{}{"Avery", "Bradley"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{19},
+						[]interface{}{19, 27},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 							[]string{"Name"},
 						},
 						"Age",
 						"int",
 					},
 					{
-						[]interface{}{"Male"},
+						[]interface{}{"Male", "Male"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 							[]string{"Name"},
 						},
 						"Sex",
@@ -212,405 +366,2222 @@ func TestDataFrameLocRows(t *testing.T) {
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}},
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 					[]string{"Name"},
 				},
 				[]string{"Name", "Age", "Sex"},
 			},
+			nil,
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			[][]interface{}{{"Jae Crowder"}},
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			5,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			0,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Jae Crowder"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{"Boston Celtics"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Team",
-						"string",
+						"Age",
+						"int",
 					},
 					{
-						[]interface{}{99.0},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Number",
-						"float64",
+						"Sex",
+						"string",
 					},
+				},
+				IndexData{
+					[]Index{},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			-1,
+			DataFrame{
+				[]Series{
 					{
-						[]interface{}{"SF"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Position",
+						"Name",
 						"string",
 					},
 					{
-						[]interface{}{25.0},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
 						"Age",
-						"float64",
+						"int",
 					},
 					{
-						[]interface{}{"6-6"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Height",
+						"Sex",
 						"string",
 					},
+				},
+				IndexData{
+					[]Index{},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+			nil,
+		},
+	}
+
+	for _, test := range headDfTests {
+		output, err := test.arg1.HeadDf(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateEmpty()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameTailDf(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.TailDf(10)
+	}
+}
+
+func TestDataFrameTailDf(t *testing.T) {
+	type tailDfTest struct {
+		arg1          DataFrame
+		arg2          int
+		expected      DataFrame
+		expectedError error
+	}
+	tailDfTests := []tailDfTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			2,
+			DataFrame{
+				[]Series{
 					{
-						[]interface{}{235.0},
+						[]interface{}{"Bradley", "Candice"},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"Weight",
-						"float64",
+						"Name",
+						"string",
 					},
 					{
-						[]interface{}{"Marquette"},
+						[]interface{}{27, 22},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"College",
-						"string",
+						"Age",
+						"int",
 					},
 					{
-						[]interface{}{6796117.0},
+						[]interface{}{"Male", "Female"},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"Salary",
-						"float64",
+						"Sex",
+						"string",
 					},
 				},
 				IndexData{
-					[]Index{{1, []interface{}{"Jae Crowder"}}},
+					[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
+				[]string{"Name", "Age", "Sex"},
 			},
+			nil,
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			[][]interface{}{{"Jae Crowder"}, {"Avery Bradley"}},
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			5,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			-1,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Jae Crowder", "Avery Bradley"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{"Boston Celtics", "Boston Celtics"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Team",
-						"string",
+						"Age",
+						"int",
 					},
 					{
-						[]interface{}{99.0, 0.0},
+						[]interface{}{},
 						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Number",
-						"float64",
-					},
-					{
-						[]interface{}{"SF", "PG"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
-							[]string{"Name"},
-						},
-						"Position",
-						"string",
-					},
-					{
-						[]interface{}{25.0, 25.0},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
-							[]string{"Name"},
-						},
-						"Age",
-						"float64",
-					},
-					{
-						[]interface{}{"6-6", "6-2"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
-							[]string{"Name"},
-						},
-						"Height",
-						"string",
-					},
-					{
-						[]interface{}{235.0, 180.0},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
-							[]string{"Name"},
-						},
-						"Weight",
-						"float64",
-					},
-					{
-						[]interface{}{"Marquette", "Texas"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
-							[]string{"Name"},
-						},
-						"College",
-						"string",
-					},
-					{
-						[]interface{}{6796117.0, 7730337.0},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
-							[]string{"Name"},
-						},
-						"Salary",
-						"float64",
+						"Sex",
+						"string",
 					},
 				},
 				IndexData{
-					[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+					[]Index{},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
+				[]string{"Name", "Age", "Sex"},
 			},
+			nil,
 		},
+	}
+
+	for _, test := range tailDfTests {
+		output, err := test.arg1.TailDf(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateEmpty()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+// captureStdout runs f and returns everything it printed to os.Stdout.
+func captureStdout(t *testing.T, f func()) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	f()
+	os.Stdout = stdout
+	w.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestDataFrameIndexValues(t *testing.T) {
+	type indexValuesTest struct {
+		arg1     DataFrame
+		expected [][]interface{}
+	}
+	indexValuesTests := []indexValuesTest{
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			[][]interface{}{{"Jae Crowder", 25.0}, {"Avery Bradley", 25.0}},
-			DataFrame{
-				[]Series{
-					{
-						[]interface{}{"Jae Crowder", "Avery Bradley"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Name",
-						"string",
-					},
-					{
-						[]interface{}{"Boston Celtics", "Boston Celtics"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Team",
-						"string",
-					},
-					{
-						[]interface{}{99.0, 0.0},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Number",
-						"float64",
-					},
-					{
-						[]interface{}{"SF", "PG"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Position",
-						"string",
-					},
-					{
-						[]interface{}{25.0, 25.0},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Age",
-						"float64",
-					},
-					{
-						[]interface{}{"6-6", "6-2"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Height",
-						"string",
-					},
-					{
-						[]interface{}{235.0, 180.0},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Weight",
-						"float64",
-					},
-					{
-						[]interface{}{"Marquette", "Texas"},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"College",
-						"string",
-					},
-					{
-						[]interface{}{6796117.0, 7730337.0},
-						IndexData{
-							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-							[]string{"Name", "Age"},
-						},
-						"Salary",
-						"float64",
-					},
-				},
-				IndexData{
-					[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
-					[]string{"Name", "Age"},
-				},
-				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
-			},
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {"Male", "Male", "Female"}, {19, 27, 22}}, []string{"Name", "Sex", "Age"}, []string{"Name", "Sex"}),
+			[][]interface{}{{"Avery", "Male"}, {"Bradley", "Male"}, {"Candice", "Female"}},
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			[][]interface{}{{"Jae Crowder", 22.0}, {"Avery Bradley", 25.0}},
-			DataFrame{},
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}}, []string{"Name", "Age"}, nil),
+			[][]interface{}{{0}, {1}},
 		},
 	}
 
-	for _, test := range dataframeLocRowsTests {
-		output, err := test.arg1.LocRows(test.arg2...)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+	for _, test := range indexValuesTests {
+		output := test.arg1.IndexValues()
+		if !cmp.Equal(output, test.expected) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+
+		// modifying the output should not change the DataFrame.
+		output[0][0] = "Changed"
+		if cmp.Equal(test.arg1.index.index[0].value[0], "Changed") {
+			t.Fatalf("IndexValues returned a slice that shares memory with the DataFrame")
 		}
 	}
 }
 
-func BenchmarkDataFrameLocRowsItems(b *testing.B) {
+func BenchmarkDataFrameMarshalJSON(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	names := [][]interface{}{
-		{"Avery Bradley"},
-		{"Jae Crowder"},
-		{"John Holland"},
-		{"R.J. Hunter"},
-		{"Jonas Jerebko"},
-		{"Amir Johnson"},
-		{"Jordan Mickey"},
-		{"Kelly Olynyk"},
-		{"Terry Rozier"},
-		{"Marcus Smart"},
-		{"Jared Sullinger"},
-		{"Isaiah Thomas"},
-		{"Evan Turner"},
-		{"James Young"},
-		{"Tyler Zeller"},
-		{"Bojan Bogdanovic"},
-		{"Markel Brown"},
-		{"Wayne Ellington"},
-		{"Rondae Hollis-Jefferson"},
-		{"Jarrett Jack"},
-		{"Sergey Karasev"},
-		{"Sean Kilpatrick"},
-		{"Shane Larkin"},
-		{"Brook Lopez"},
-		{"Chris McCullough"},
-		{"Willie Reed"},
-		{"Thomas Robinson"},
-		{"Henry Sims"},
-		{"Donald Sloan"},
-		{"Thaddeus Young"},
-		{"Arron Afflalo"},
-		{"Lou Amundson"},
-		{"Thanasis Antetokounmpo"},
-		{"Carmelo Anthony"},
-		{"Jose Calderon"},
-		{"Cleanthony Early"},
-		{"Langston Galloway"},
-		{"Jerian Grant"},
-		{"Robin Lopez"},
-		{"Kyle O'Quinn"},
-		{"Kristaps Porzingis"},
-		{"Kevin Seraphin"},
-		{"Lance Thomas"},
-		{"Sasha Vujacic"},
-		{"Derrick Williams"},
-		{"Tony Wroten"},
-		{"Elton Brand"},
-		{"Isaiah Canaan"},
-		{"Robert Covington"},
-		{"Joel Embiid"},
-		{"Jerami Grant"},
-		{"Richaun Holmes"},
-		{"Carl Landry"},
-		{"Kendall Marshall"},
-		{"T.J. McConnell"},
-		{"Nerlens Noel"},
-		{"Jahlil Okafor"},
-		{"Ish Smith"},
-		{"Nik Stauskas"},
-		{"Hollis Thompson"},
-		{"Christian Wood"},
-		{"Bismack Biyombo"},
-		{"Bruno Caboclo"},
-		{"DeMarre Carroll"},
-		{"DeMar DeRozan"},
-		{"James Johnson"},
-		{"Cory Joseph"},
-		{"Kyle Lowry"},
-		{"Lucas Nogueira"},
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		json.Marshal(&testDf)
+	}
+}
+
+func TestDataFrameMarshalJSON(t *testing.T) {
+	type marshalJSONTest struct {
+		arg1 DataFrame
+	}
+	marshalJSONTests := []marshalJSONTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				if err := newDf.SortByIndex(false); err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {"Male", "Male", "Female"}, {19, NA, 22}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Sex", "Age", "Score"}, []string{"Name", "Sex"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {true, false}, {170.2, 182.5}}, []string{"Name", "Member", "Height"}, nil),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {[]interface{}{1, 2.0, "x"}, math.NaN(), []interface{}{}}}, []string{"Name", "Scores"}, []string{"Name"}),
+		},
+	}
+
+	for _, test := range marshalJSONTests {
+		b, err := json.Marshal(&test.arg1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var output DataFrame
+		if err := json.Unmarshal(b, &output); err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(output, test.arg1, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.arg1, output)
+		}
+	}
+}
+
+func TestDataFrameUnmarshalJSON(t *testing.T) {
+	type unmarshalJSONTest struct {
+		arg1          string
+		expectedError error
+	}
+	unmarshalJSONTests := []unmarshalJSONTest{
+		{
+			`{"series":[{"data":[1,2],"name":"A","dtype":"int"}],"index":{"index":[{"id":0,"value":[0]},{"id":1,"value":[1]}],"names":[""]},"columns":["A","B"]}`,
+			fmt.Errorf("length of series (1) and columns (2) does not match"),
+		},
+		{
+			`{"series":[{"data":[1,2,3],"name":"A","dtype":"int"}],"index":{"index":[{"id":0,"value":[0]},{"id":1,"value":[1]}],"names":[""]},"columns":["A"]}`,
+			fmt.Errorf("length of series 'A' (3) and index (2) does not match"),
+		},
+		{
+			`{"series":[{"data":[1],"name":"A","dtype":"int"}],"index":{"index":[{"id":0,"value":[0, 1]}],"names":[""]},"columns":["A"]}`,
+			fmt.Errorf("index [0 1] does not match index names []"),
+		},
+	}
+
+	for _, test := range unmarshalJSONTests {
+		var output DataFrame
+		err := json.Unmarshal([]byte(test.arg1), &output)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+	}
+}
+
+func TestDataFrameUnmarshalJSONLegacy(t *testing.T) {
+	type unmarshalJSONLegacyTest struct {
+		arg1     string
+		expected DataFrame
+	}
+	unmarshalJSONLegacyTests := []unmarshalJSONLegacyTest{
+		{
+			`{"series":[{"data":["Avery","Bradley","Candice"],"name":"Name","dtype":"string"},{"data":[19,null,22],"name":"Age","dtype":"int"},{"data":[1.5,null,3.5],"name":"Score","dtype":"float64"}],"columns":["Name","Age","Score"]}`,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Age", "Score"}, nil),
+		},
+		{
+			`{"series":[],"columns":[]}`,
+			DataFrame{[]Series{}, IndexData{[]Index{}, []string{""}}, []string{}},
+		},
+	}
+
+	for _, test := range unmarshalJSONLegacyTests {
+		var output DataFrame
+		if err := json.Unmarshal([]byte(test.arg1), &output); err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func TestDataFrameJSONField(t *testing.T) {
+	type report struct {
+		Title string    `json:"title"`
+		Data  DataFrame `json:"data"`
+	}
+
+	testDf := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Age", "Score"}, nil)
+
+	// marshal by value, so that the DataFrame field is not addressable.
+	b, err := json.Marshal(report{"Scores", testDf})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output report
+	if err := json.Unmarshal(b, &output); err != nil {
+		t.Fatal(err)
+	}
+	if output.Title != "Scores" || !cmp.Equal(output.Data, testDf, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+		t.Fatalf("expected %v, got %v", testDf, output.Data)
+	}
+}
+
+func BenchmarkDataFrameGob(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var buf bytes.Buffer
+		gob.NewEncoder(&buf).Encode(testDf)
+	}
+}
+
+func TestDataFrameGob(t *testing.T) {
+	type gobTest struct {
+		arg1 DataFrame
+	}
+	gobTests := []gobTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				if err := newDf.SortByIndex(false); err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {"Male", "Male", "Female"}, {19, NA, 22}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Sex", "Age", "Score"}, []string{"Name", "Sex"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {true, false}, {170.2, 182.5}}, []string{"Name", "Member", "Height"}, nil),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {[]interface{}{1, 2.0, "x"}, math.NaN(), []interface{}{}}}, []string{"Name", "Scores"}, []string{"Name"}),
+		},
+	}
+
+	for _, test := range gobTests {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(test.arg1); err != nil {
+			t.Fatal(err)
+		}
+
+		var output DataFrame
+		if err := gob.NewDecoder(&buf).Decode(&output); err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(output, test.arg1, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.arg1, output)
+		}
+	}
+}
+
+func BenchmarkDataFrameAt(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.At([]interface{}{"Thomas Robinson"}, "Salary")
+	}
+}
+
+func TestDataFrameAt(t *testing.T) {
+	type atTest struct {
+		arg1          DataFrame
+		arg2          []interface{}
+		arg3          string
+		expected      interface{}
+		expectedError error
+	}
+	atTests := []atTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Bradley"},
+			"Age",
+			27,
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Candice"},
+			"Score",
+			3.0,
+			nil,
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("testfiles/airquality.csv", []string{"city", "date.utc"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[]interface{}{"London", "2019-06-17 11:00:00+00:00"},
+			"value",
+			6.0,
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Diana"},
+			"Age",
+			nil,
+			fmt.Errorf("index does not exist: [Diana]"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Avery"},
+			"Height",
+			nil,
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+
+	for _, test := range atTests {
+		output, err := test.arg1.At(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameIAt(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.IAt(100, 8)
+	}
+}
+
+func TestDataFrameIAt(t *testing.T) {
+	type iatTest struct {
+		arg1          DataFrame
+		arg2          int
+		arg3          int
+		expected      interface{}
+		expectedError error
+	}
+	iatTests := []iatTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			1,
+			1,
+			27,
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			0,
+			0,
+			"Avery",
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			3,
+			1,
+			nil,
+			fmt.Errorf("row index out of bounds: 3"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			0,
+			-1,
+			nil,
+			fmt.Errorf("column index out of bounds: -1"),
+		},
+	}
+
+	for _, test := range iatTests {
+		output, err := test.arg1.IAt(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestDataFrameSetAt(t *testing.T) {
+	type setAtTest struct {
+		arg1          DataFrame
+		arg2          []interface{}
+		arg3          string
+		arg4          interface{}
+		expected      interface{}
+		expectedDtype string
+		expectedError error
+	}
+	setAtTests := []setAtTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Bradley"},
+			"Age",
+			28,
+			28,
+			"int",
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Bradley"},
+			"Score",
+			2.0,
+			2.0,
+			"float64",
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Candice"},
+			"Age",
+			22.5,
+			22.5,
+			"float64",
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Avery"},
+			"Age",
+			NA,
+			NA,
+			"int",
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Diana"},
+			"Age",
+			30,
+			nil,
+			"",
+			fmt.Errorf("index does not exist: [Diana]"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Avery"},
+			"Height",
+			170,
+			nil,
+			"",
+			fmt.Errorf("column does not exist: Height"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Avery"},
+			"Name",
+			"Ava",
+			nil,
+			"",
+			fmt.Errorf("cannot set a value in index column 'Name'"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			[]interface{}{"Avery"},
+			"Age",
+			[]int{1},
+			nil,
+			"",
+			fmt.Errorf("value [1] is not a bool, int, float64, or string"),
+		},
+	}
+
+	for _, test := range setAtTests {
+		err := test.arg1.SetAt(test.arg2, test.arg3, test.arg4)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+		if err != nil {
+			continue
+		}
+
+		output, err := test.arg1.At(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+		ser, err := test.arg1.LocCol(test.arg3)
+		if ser.dtype != test.expectedDtype || err != nil {
+			t.Fatalf("expected dtype %v, got %v, error %v", test.expectedDtype, ser.dtype, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameReindex(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	newIndex, err := NewIndexData([][]interface{}{{"Thomas Robinson"}, {"Avery Bradley"}, {"Nobody"}}, []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Reindex(newIndex)
+	}
+}
+
+func TestDataFrameReindex(t *testing.T) {
+	type reindexTest struct {
+		arg1          DataFrame
+		arg2          IndexData
+		expected      DataFrame
+		expectedError error
+	}
+	reindexTests := []reindexTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, 2.5, 3.5}, {"a", "b", "c"}}, []string{"Name", "Age", "Score", "Grade"}, []string{"Name"}),
+			func() IndexData {
+				newIndex, err := NewIndexData([][]interface{}{{"Bradley"}, {"Diana"}, {"Avery"}, {"Candice"}}, []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newIndex
+			}(),
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Bradley", "Diana", "Avery", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Bradley"}}, {1, []interface{}{"Diana"}}, {2, []interface{}{"Avery"}}, {3, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{27, NA, 19, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Bradley"}}, {1, []interface{}{"Diana"}}, {2, []interface{}{"Avery"}}, {3, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{2.5, math.NaN(), 1.5, 3.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Bradley"}}, {1, []interface{}{"Diana"}}, {2, []interface{}{"Avery"}}, {3, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{"b", math.NaN(), "a", "c"},
+						IndexData{
+							[]Index{{0, []interface{}{"Bradley"}}, {1, []interface{}{"Diana"}}, {2, []interface{}{"Avery"}}, {3, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Grade",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Bradley"}}, {1, []interface{}{"Diana"}}, {2, []interface{}{"Avery"}}, {3, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score", "Grade"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, 2.5, 3.5}, {"a", "b", "c"}}, []string{"Name", "Age", "Score", "Grade"}, []string{"Name"}),
+			func() IndexData {
+				newIndex, err := NewIndexData([][]interface{}{{"Candice"}, {"Avery"}}, []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newIndex
+			}(),
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Candice", "Avery"},
+						IndexData{
+							[]Index{{0, []interface{}{"Candice"}}, {1, []interface{}{"Avery"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{22, 19},
+						IndexData{
+							[]Index{{0, []interface{}{"Candice"}}, {1, []interface{}{"Avery"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{3.5, 1.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Candice"}}, {1, []interface{}{"Avery"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{"c", "a"},
+						IndexData{
+							[]Index{{0, []interface{}{"Candice"}}, {1, []interface{}{"Avery"}}},
+							[]string{"Name"},
+						},
+						"Grade",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Candice"}}, {1, []interface{}{"Avery"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score", "Grade"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, 2.5, 3.5}, {"a", "b", "c"}}, []string{"Name", "Age", "Score", "Grade"}, []string{"Name"}),
+			func() IndexData {
+				newIndex, err := NewIndexData([][]interface{}{{"Avery", "Male"}}, []string{"Name", "Sex"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newIndex
+			}(),
+			DataFrame{},
+			fmt.Errorf("index [Avery Male] has 2 levels, expected 1"),
+		},
+	}
+
+	for _, test := range reindexTests {
+		output, err := test.arg1.Reindex(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameAlign(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	otherDf, err := testDf.LocCols("Name", "Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Align(otherDf, "outer")
+	}
+}
+
+func TestDataFrameAlign(t *testing.T) {
+	type alignTest struct {
+		arg1          DataFrame
+		arg2          DataFrame
+		arg3          string
+		expected1     DataFrame
+		expected2     DataFrame
+		expectedError error
+	}
+	alignTests := []alignTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana", "Avery"}, {1.5, 2.5, 3.5}}, []string{"Name", "Score"}, []string{"Name"}),
+			"outer",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 27, 22, NA},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3.5, math.NaN(), 1.5, 2.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana", "Avery"}, {1.5, 2.5, 3.5}}, []string{"Name", "Score"}, []string{"Name"}),
+			"inner",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3.5, 1.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana", "Avery"}, {1.5, 2.5, 3.5}}, []string{"Name", "Score"}, []string{"Name"}),
+			"left",
+			DataFrame{},
+			DataFrame{},
+			fmt.Errorf("how must be \"outer\" or \"inner\", got \"left\""),
+		},
+	}
+
+	for _, test := range alignTests {
+		output1, output2, err := test.arg1.Align(test.arg2, test.arg3)
+		if !cmp.Equal(output1, test.expected1, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) ||
+			!cmp.Equal(output2, test.expected2, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) ||
+			fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v and %v, got %v and %v, error %v", test.expected1, test.expected2, output1, output2, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameCompare(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Compare(testDf)
+	}
+}
+
+func TestDataFrameCompare(t *testing.T) {
+	type compareTest struct {
+		arg1          DataFrame
+		arg2          DataFrame
+		expected      DataFrame
+		expectedError error
+	}
+	compareTests := []compareTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}, {"Boston Celtics", "Utah Jazz", "Utah"}}, []string{"Name", "Age", "Team"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Avery", "Bradley"}, {22, 19, 27}, {"Utah Jazz", "Boston Celtics", "Utah Jazz"}}, []string{"Name", "Age", "Team"}, []string{"Name"}),
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Bradley", "Candice"},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{26, NA},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age_self",
+						"int",
+					},
+					{
+						[]interface{}{27, NA},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age_other",
+						"int",
+					},
+					{
+						[]interface{}{math.NaN(), "Utah"},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Team_self",
+						"string",
+					},
+					{
+						[]interface{}{math.NaN(), "Utah Jazz"},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Team_other",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age_self", "Age_other", "Team_self", "Team_other"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}, {"Boston Celtics", "Utah Jazz", "Utah"}}, []string{"Name", "Age", "Team"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			DataFrame{},
+			fmt.Errorf("shape [3 3] and [3 2] does not match"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}, {"Boston Celtics", "Utah Jazz", "Utah"}}, []string{"Name", "Age", "Team"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}, {"Boston Celtics", "Utah Jazz", "Utah"}}, []string{"Name", "Age", "Club"}, []string{"Name"}),
+			DataFrame{},
+			fmt.Errorf("%w: Team", ErrColumnNotFound),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}, {"Boston Celtics", "Utah Jazz", "Utah"}}, []string{"Name", "Age", "Team"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Diana"}, {19, 26, 22}, {"Boston Celtics", "Utah Jazz", "Utah"}}, []string{"Name", "Age", "Team"}, []string{"Name"}),
+			DataFrame{},
+			fmt.Errorf("index of the DataFrame objects does not match"),
+		},
+	}
+
+	for _, test := range compareTests {
+		output, err := test.arg1.Compare(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameAddDf(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	numericDf, err := testDf.LocCols("Name", "Age", "Weight", "Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		numericDf.AddDf(numericDf)
+	}
+}
+
+func TestDataFrameArithmeticDf(t *testing.T) {
+	type arithmeticDfTest struct {
+		arg1          DataFrame
+		arg2          DataFrame
+		arg3          string
+		expected      DataFrame
+		expectedError error
+	}
+	arithmeticDfTests := []arithmeticDfTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {10, 20, 30}, {1.5, 2.5, math.NaN()}}, []string{"Name", "Math", "Science"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana", "Avery"}, {1, 2, 3}, {0.5, 0.5, 0.5}, {7, 8, 9}}, []string{"Name", "Math", "Science", "Art"}, []string{"Name"}),
+			"add",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{13.0, math.NaN(), 31.0, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Math",
+						"float64",
+					},
+					{
+						[]interface{}{2.0, math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Science",
+						"float64",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Art",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Math", "Science", "Art"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {10, 20, 30}, {1.5, 2.5, math.NaN()}}, []string{"Name", "Math", "Science"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana", "Avery"}, {1, 2, 3}, {0.5, 0.5, 0.5}, {7, 8, 9}}, []string{"Name", "Math", "Science", "Art"}, []string{"Name"}),
+			"sub",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{7.0, math.NaN(), 29.0, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Math",
+						"float64",
+					},
+					{
+						[]interface{}{1.0, math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Science",
+						"float64",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Art",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Math", "Science", "Art"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {10, 20, 30}, {1.5, 2.5, math.NaN()}}, []string{"Name", "Math", "Science"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana", "Avery"}, {1, 2, 3}, {0.5, 0.5, 0.5}, {7, 8, 9}}, []string{"Name", "Math", "Science", "Art"}, []string{"Name"}),
+			"mul",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{30.0, math.NaN(), 30.0, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Math",
+						"float64",
+					},
+					{
+						[]interface{}{0.75, math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Science",
+						"float64",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Art",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Math", "Science", "Art"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {10, 20, 30}, {1.5, 2.5, math.NaN()}}, []string{"Name", "Math", "Science"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana", "Avery"}, {1, 2, 3}, {0.5, 0.5, 0.5}, {7, 8, 9}}, []string{"Name", "Math", "Science", "Art"}, []string{"Name"}),
+			"div",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3.3333333333333335, math.NaN(), 30.0, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Math",
+						"float64",
+					},
+					{
+						[]interface{}{3.0, math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Science",
+						"float64",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Art",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Math", "Science", "Art"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery"}, {"A"}}, []string{"Name", "Grade"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery"}, {"A"}}, []string{"Name", "Grade"}, []string{"Name"}),
+			"add",
+			DataFrame{},
+			fmt.Errorf("cannot compute, column 'Grade' data type is not float64 or int"),
+		},
+	}
+
+	for _, test := range arithmeticDfTests {
+		var output DataFrame
+		var err error
+		switch test.arg3 {
+		case "add":
+			output, err = test.arg1.AddDf(test.arg2)
+		case "sub":
+			output, err = test.arg1.SubDf(test.arg2)
+		case "mul":
+			output, err = test.arg1.MulDf(test.arg2)
+		case "div":
+			output, err = test.arg1.DivDf(test.arg2)
+		}
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameLocRows(b *testing.B) {
+	nbaDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	neoDf, err := ReadCsv("testfiles/neo_v2.csv", []string{"id"})
+	if err != nil {
+		b.Error(err)
+	}
+	nbaCol, err := readCsvColIntoData("testfiles/nba.csv", "Name")
+	if err != nil {
+		b.Error(err)
+	}
+	neoCol, err := readCsvColIntoData("testfiles/neo_v2.csv", "id")
+	if err != nil {
+		b.Error(err)
+	}
+	dfList := []DataFrame{nbaDf, neoDf}
+	colList := [][][]interface{}{nbaCol, neoCol}
+
+	for i := 0; i < 2; i++ {
+		b.Run(fmt.Sprintf("case_%d", i), func(b *testing.B) {
+			df := dfList[i]
+			col := colList[i]
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				df.LocRows(col[rand.Intn(len(col))])
+			}
+		})
+	}
+
+}
+
+func TestDataFrameLocRows(t *testing.T) {
+	type dataframeLocRowsTest struct {
+		arg1     DataFrame
+		arg2     [][]interface{}
+		expected DataFrame
+	}
+	dataframeLocRowsTests := []dataframeLocRowsTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			[][]interface{}{{"Avery"}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{"Male"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder"}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Jae Crowder"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Boston Celtics"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{99.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Number",
+						"float64",
+					},
+					{
+						[]interface{}{"SF"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Position",
+						"string",
+					},
+					{
+						[]interface{}{25.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"6-6"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Height",
+						"string",
+					},
+					{
+						[]interface{}{235.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Weight",
+						"float64",
+					},
+					{
+						[]interface{}{"Marquette"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"College",
+						"string",
+					},
+					{
+						[]interface{}{6796117.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}},
+							[]string{"Name"},
+						},
+						"Salary",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"Jae Crowder"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder"}, {"Avery Bradley"}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Jae Crowder", "Avery Bradley"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Boston Celtics", "Boston Celtics"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{99.0, 0.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Number",
+						"float64",
+					},
+					{
+						[]interface{}{"SF", "PG"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Position",
+						"string",
+					},
+					{
+						[]interface{}{25.0, 25.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"6-6", "6-2"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Height",
+						"string",
+					},
+					{
+						[]interface{}{235.0, 180.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Weight",
+						"float64",
+					},
+					{
+						[]interface{}{"Marquette", "Texas"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"College",
+						"string",
+					},
+					{
+						[]interface{}{6796117.0, 7730337.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+							[]string{"Name"},
+						},
+						"Salary",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"Jae Crowder"}}, {0, []interface{}{"Avery Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder", 25.0}, {"Avery Bradley", 25.0}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Jae Crowder", "Avery Bradley"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Boston Celtics", "Boston Celtics"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{99.0, 0.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Number",
+						"float64",
+					},
+					{
+						[]interface{}{"SF", "PG"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Position",
+						"string",
+					},
+					{
+						[]interface{}{25.0, 25.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"6-6", "6-2"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Height",
+						"string",
+					},
+					{
+						[]interface{}{235.0, 180.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Weight",
+						"float64",
+					},
+					{
+						[]interface{}{"Marquette", "Texas"},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"College",
+						"string",
+					},
+					{
+						[]interface{}{6796117.0, 7730337.0},
+						IndexData{
+							[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+							[]string{"Name", "Age"},
+						},
+						"Salary",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"Jae Crowder", 25.0}}, {0, []interface{}{"Avery Bradley", 25.0}}},
+					[]string{"Name", "Age"},
+				},
+				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder", 22.0}, {"Avery Bradley", 25.0}},
+			DataFrame{},
+		},
+	}
+
+	for _, test := range dataframeLocRowsTests {
+		output, err := test.arg1.LocRows(test.arg2...)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameLocRowsItems(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	names := [][]interface{}{
+		{"Avery Bradley"},
+		{"Jae Crowder"},
+		{"John Holland"},
+		{"R.J. Hunter"},
+		{"Jonas Jerebko"},
+		{"Amir Johnson"},
+		{"Jordan Mickey"},
+		{"Kelly Olynyk"},
+		{"Terry Rozier"},
+		{"Marcus Smart"},
+		{"Jared Sullinger"},
+		{"Isaiah Thomas"},
+		{"Evan Turner"},
+		{"James Young"},
+		{"Tyler Zeller"},
+		{"Bojan Bogdanovic"},
+		{"Markel Brown"},
+		{"Wayne Ellington"},
+		{"Rondae Hollis-Jefferson"},
+		{"Jarrett Jack"},
+		{"Sergey Karasev"},
+		{"Sean Kilpatrick"},
+		{"Shane Larkin"},
+		{"Brook Lopez"},
+		{"Chris McCullough"},
+		{"Willie Reed"},
+		{"Thomas Robinson"},
+		{"Henry Sims"},
+		{"Donald Sloan"},
+		{"Thaddeus Young"},
+		{"Arron Afflalo"},
+		{"Lou Amundson"},
+		{"Thanasis Antetokounmpo"},
+		{"Carmelo Anthony"},
+		{"Jose Calderon"},
+		{"Cleanthony Early"},
+		{"Langston Galloway"},
+		{"Jerian Grant"},
+		{"Robin Lopez"},
+		{"Kyle O'Quinn"},
+		{"Kristaps Porzingis"},
+		{"Kevin Seraphin"},
+		{"Lance Thomas"},
+		{"Sasha Vujacic"},
+		{"Derrick Williams"},
+		{"Tony Wroten"},
+		{"Elton Brand"},
+		{"Isaiah Canaan"},
+		{"Robert Covington"},
+		{"Joel Embiid"},
+		{"Jerami Grant"},
+		{"Richaun Holmes"},
+		{"Carl Landry"},
+		{"Kendall Marshall"},
+		{"T.J. McConnell"},
+		{"Nerlens Noel"},
+		{"Jahlil Okafor"},
+		{"Ish Smith"},
+		{"Nik Stauskas"},
+		{"Hollis Thompson"},
+		{"Christian Wood"},
+		{"Bismack Biyombo"},
+		{"Bruno Caboclo"},
+		{"DeMarre Carroll"},
+		{"DeMar DeRozan"},
+		{"James Johnson"},
+		{"Cory Joseph"},
+		{"Kyle Lowry"},
+		{"Lucas Nogueira"},
 		{"Patrick Patterson"},
 		{"Norman Powell"},
 		{"Terrence Ross"},
@@ -1006,17 +2977,164 @@ func BenchmarkDataFrameLocRowsItems(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.LocRowsItems(names[rand.Intn(len(names))])
+		testDf.LocRowsItems(names[rand.Intn(len(names))])
+	}
+}
+
+func TestDataFrameLocRowsItems(t *testing.T) {
+	type dataframeLocRowsItemsTest struct {
+		arg1     DataFrame
+		arg2     [][]interface{}
+		expected [][]interface{}
+	}
+	dataframeLocRowsItemsTests := []dataframeLocRowsItemsTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			[][]interface{}{{"Avery"}},
+			[][]interface{}{{"Avery", 19, "Male"}},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder"}},
+			[][]interface{}{{"Jae Crowder", "Boston Celtics", 99.0, "SF", 25.0, "6-6", 235.0, "Marquette", 6796117.0}},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder"}, {"Avery Bradley"}},
+			[][]interface{}{
+				{"Jae Crowder", "Boston Celtics", 99.0, "SF", 25.0, "6-6", 235.0, "Marquette", 6796117.0},
+				{"Avery Bradley", "Boston Celtics", 0.0, "PG", 25.0, "6-2", 180.0, "Texas", 7730337.0},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder", 25.0}, {"Avery Bradley", 25.0}},
+			[][]interface{}{
+				{"Jae Crowder", "Boston Celtics", 99.0, "SF", 25.0, "6-6", 235.0, "Marquette", 6796117.0},
+				{"Avery Bradley", "Boston Celtics", 0.0, "PG", 25.0, "6-2", 180.0, "Texas", 7730337.0},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[][]interface{}{{"Jae Crowder", 22.0}, {"Avery Bradley", 25.0}},
+			nil,
+		},
+	}
+
+	for _, test := range dataframeLocRowsItemsTests {
+		output, err := test.arg1.LocRowsItems(test.arg2...)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (output != nil && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameLocCol(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/neo_v2.csv", []string{"id"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.LocCol("id")
+	}
+}
+
+func TestDataFrameLocCol(t *testing.T) {
+	type dataframeLocColTest struct {
+		arg1     DataFrame
+		arg2     string
+		expected Series
+	}
+	dataframeLocColTests := []dataframeLocColTest{
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			"Name",
+			Series{
+				[]interface{}{
+					"Avery Bradley",
+					"Jae Crowder",
+					"John Holland",
+					"R.J. Hunter",
+				},
+				IndexData{
+					[]Index{
+						{0, []interface{}{"Avery Bradley"}},
+						{1, []interface{}{"Jae Crowder"}},
+						{2, []interface{}{"John Holland"}},
+						{3, []interface{}{"R.J. Hunter"}},
+					},
+					[]string{"Name"},
+				},
+				"Name",
+				"string",
+			},
+		},
+	}
+	for _, test := range dataframeLocColTests {
+		output, err := test.arg1.LocCol(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameLocCols(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.LocCols("Name")
 	}
 }
 
-func TestDataFrameLocRowsItems(t *testing.T) {
-	type dataframeLocRowsItemsTest struct {
+func TestDataFrameLocCols(t *testing.T) {
+	type dataframeLocColsTest struct {
 		arg1     DataFrame
-		arg2     [][]interface{}
-		expected [][]interface{}
+		arg2     []string
+		expected DataFrame
 	}
-	dataframeLocRowsItemsTests := []dataframeLocRowsItemsTest{
+	dataframeLocColsTests := []dataframeLocColsTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -1025,127 +3143,328 @@ func TestDataFrameLocRowsItems(t *testing.T) {
 				}
 				return newDf
 			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			[][]interface{}{{"Avery"}},
-			[][]interface{}{{"Avery", 19, "Male"}},
-		},
-		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}(),
-			[][]interface{}{{"Jae Crowder"}},
-			[][]interface{}{{"Jae Crowder", "Boston Celtics", 99.0, "SF", 25.0, "6-6", 235.0, "Marquette", 6796117.0}},
+			[]string{"Age"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{19, 27, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Age"},
+			},
 		},
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name"})
+				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
-			[][]interface{}{{"Jae Crowder"}, {"Avery Bradley"}},
-			[][]interface{}{
-				{"Jae Crowder", "Boston Celtics", 99.0, "SF", 25.0, "6-6", 235.0, "Marquette", 6796117.0},
-				{"Avery Bradley", "Boston Celtics", 0.0, "PG", 25.0, "6-2", 180.0, "Texas", 7730337.0},
+			[]string{"Position"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"PG", "SF", "SG", "SG"},
+						IndexData{
+							[]Index{
+								{0, []interface{}{"Avery Bradley"}},
+								{1, []interface{}{"Jae Crowder"}},
+								{2, []interface{}{"John Holland"}},
+								{3, []interface{}{"R.J. Hunter"}},
+							},
+							[]string{"Name"},
+						},
+						"Position",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{
+						{0, []interface{}{"Avery Bradley"}},
+						{1, []interface{}{"Jae Crowder"}},
+						{2, []interface{}{"John Holland"}},
+						{3, []interface{}{"R.J. Hunter"}},
+					},
+					[]string{"Name"},
+				},
+				[]string{"Position"},
 			},
 		},
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
-			[][]interface{}{{"Jae Crowder", 25.0}, {"Avery Bradley", 25.0}},
-			[][]interface{}{
-				{"Jae Crowder", "Boston Celtics", 99.0, "SF", 25.0, "6-6", 235.0, "Marquette", 6796117.0},
-				{"Avery Bradley", "Boston Celtics", 0.0, "PG", 25.0, "6-2", 180.0, "Texas", 7730337.0},
+			[]string{"Age", "College", "Name"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{25.0, 25.0, 27.0, 22.0},
+						IndexData{
+							[]Index{
+								{0, []interface{}{"Avery Bradley"}},
+								{1, []interface{}{"Jae Crowder"}},
+								{2, []interface{}{"John Holland"}},
+								{3, []interface{}{"R.J. Hunter"}},
+							},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Texas", "Marquette", "Boston University", "Georgia State"},
+						IndexData{
+							[]Index{
+								{0, []interface{}{"Avery Bradley"}},
+								{1, []interface{}{"Jae Crowder"}},
+								{2, []interface{}{"John Holland"}},
+								{3, []interface{}{"R.J. Hunter"}},
+							},
+							[]string{"Name"},
+						},
+						"College",
+						"string",
+					},
+					{
+						[]interface{}{"Avery Bradley", "Jae Crowder", "John Holland", "R.J. Hunter"},
+						IndexData{
+							[]Index{
+								{0, []interface{}{"Avery Bradley"}},
+								{1, []interface{}{"Jae Crowder"}},
+								{2, []interface{}{"John Holland"}},
+								{3, []interface{}{"R.J. Hunter"}},
+							},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{
+						{0, []interface{}{"Avery Bradley"}},
+						{1, []interface{}{"Jae Crowder"}},
+						{2, []interface{}{"John Holland"}},
+						{3, []interface{}{"R.J. Hunter"}},
+					},
+					[]string{"Name"},
+				},
+				[]string{"Age", "College", "Name"},
 			},
 		},
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdflocrows1.csv", []string{"Name", "Age"})
+				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name", "Age"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
-			[][]interface{}{{"Jae Crowder", 22.0}, {"Avery Bradley", 25.0}},
-			nil,
+			[]string{"Avery Bradley"},
+			DataFrame{},
 		},
 	}
-
-	for _, test := range dataframeLocRowsItemsTests {
-		output, err := test.arg1.LocRowsItems(test.arg2...)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (output != nil && err != nil) {
+	for _, test := range dataframeLocColsTests {
+		output, err := test.arg1.LocCols(test.arg2...)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameLocCol(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/neo_v2.csv", []string{"id"})
+func BenchmarkDataFrameFilterCols(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
 	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.LocCol("id")
+		testDf.FilterCols("^(Age|Salary)$")
 	}
 }
 
-func TestDataFrameLocCol(t *testing.T) {
-	type dataframeLocColTest struct {
-		arg1     DataFrame
-		arg2     string
-		expected Series
-	}
-	dataframeLocColTests := []dataframeLocColTest{
+func TestDataFrameFilterCols(t *testing.T) {
+	type filterColsTest struct {
+		arg1          DataFrame
+		arg2          string
+		expected      DataFrame
+		expectedError error
+	}
+	filterColsTests := []filterColsTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"a1", "a2", "a3"}, {1.5, 2.5, 3.5}, {10.0, 20.0, 30.0}, {3, 1, 2}, {2.0, 4.0, 6.0}}, []string{"id", "price_a", "price_b", "qty", "unit_price"}, []string{"id"}),
+			"^price_",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{1.5, 2.5, 3.5},
+						IndexData{
+							[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+							[]string{"id"},
+						},
+						"price_a",
+						"float64",
+					},
+					{
+						[]interface{}{10.0, 20.0, 30.0},
+						IndexData{
+							[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+							[]string{"id"},
+						},
+						"price_b",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+					[]string{"id"},
+				},
+				[]string{"price_a", "price_b"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"a1", "a2", "a3"}, {1.5, 2.5, 3.5}, {10.0, 20.0, 30.0}, {3, 1, 2}, {2.0, 4.0, 6.0}}, []string{"id", "price_a", "price_b", "qty", "unit_price"}, []string{"id"}),
+			"price",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{1.5, 2.5, 3.5},
+						IndexData{
+							[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+							[]string{"id"},
+						},
+						"price_a",
+						"float64",
+					},
+					{
+						[]interface{}{10.0, 20.0, 30.0},
+						IndexData{
+							[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+							[]string{"id"},
+						},
+						"price_b",
+						"float64",
+					},
+					{
+						[]interface{}{2.0, 4.0, 6.0},
+						IndexData{
+							[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+							[]string{"id"},
+						},
+						"unit_price",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+					[]string{"id"},
+				},
+				[]string{"price_a", "price_b", "unit_price"},
+			},
+			nil,
+		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			"Name",
-			Series{
-				[]interface{}{
-					"Avery Bradley",
-					"Jae Crowder",
-					"John Holland",
-					"R.J. Hunter",
+			}([][]interface{}{{"a1", "a2", "a3"}, {1.5, 2.5, 3.5}, {10.0, 20.0, 30.0}, {3, 1, 2}, {2.0, 4.0, 6.0}}, []string{"id", "price_a", "price_b", "qty", "unit_price"}, []string{"id"}),
+			"^id$|qty",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"a1", "a2", "a3"},
+						IndexData{
+							[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+							[]string{"id"},
+						},
+						"id",
+						"string",
+					},
+					{
+						[]interface{}{3, 1, 2},
+						IndexData{
+							[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+							[]string{"id"},
+						},
+						"qty",
+						"int",
+					},
 				},
 				IndexData{
-					[]Index{
-						{0, []interface{}{"Avery Bradley"}},
-						{1, []interface{}{"Jae Crowder"}},
-						{2, []interface{}{"John Holland"}},
-						{3, []interface{}{"R.J. Hunter"}},
-					},
-					[]string{"Name"},
+					[]Index{{0, []interface{}{"a1"}}, {1, []interface{}{"a2"}}, {2, []interface{}{"a3"}}},
+					[]string{"id"},
 				},
-				"Name",
-				"string",
+				[]string{"id", "qty"},
 			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"a1", "a2", "a3"}, {1.5, 2.5, 3.5}, {10.0, 20.0, 30.0}, {3, 1, 2}, {2.0, 4.0, 6.0}}, []string{"id", "price_a", "price_b", "qty", "unit_price"}, []string{"id"}),
+			"^cost_",
+			DataFrame{},
+			fmt.Errorf("no columns match pattern \"^cost_\""),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"a1", "a2", "a3"}, {1.5, 2.5, 3.5}, {10.0, 20.0, 30.0}, {3, 1, 2}, {2.0, 4.0, 6.0}}, []string{"id", "price_a", "price_b", "qty", "unit_price"}, []string{"id"}),
+			"price_(",
+			DataFrame{},
+			fmt.Errorf("error parsing regexp: missing closing ): `price_(`"),
 		},
 	}
-	for _, test := range dataframeLocColTests {
-		output, err := test.arg1.LocCol(test.arg2)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+
+	for _, test := range filterColsTests {
+		output, err := test.arg1.FilterCols(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameLocCols(b *testing.B) {
+func BenchmarkDataFrameAlignColumns(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -1153,17 +3472,18 @@ func BenchmarkDataFrameLocCols(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.LocCols("Name")
+		testDf.AlignColumns([]string{"Name", "Team", "Salary", "Age"})
 	}
 }
 
-func TestDataFrameLocCols(t *testing.T) {
-	type dataframeLocColsTest struct {
-		arg1     DataFrame
-		arg2     []string
-		expected DataFrame
-	}
-	dataframeLocColsTests := []dataframeLocColsTest{
+func TestDataFrameAlignColumns(t *testing.T) {
+	type alignColumnsTest struct {
+		arg1          DataFrame
+		arg2          []string
+		expected      DataFrame
+		expectedError error
+	}
+	alignColumnsTests := []alignColumnsTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -1171,146 +3491,122 @@ func TestDataFrameLocCols(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			[]string{"Age"},
+			}([][]interface{}{{19, 27}, {"Avery", "Bradley"}, {"Celtics", "Lakers"}, {1.5, 2.5}}, []string{"Age", "Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Name", "Team", "Age", "Score"},
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{19, 27, 22},
+						[]interface{}{"Avery", "Bradley"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Celtics", "Lakers"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{19, 27},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 							[]string{"Name"},
 						},
 						"Age",
 						"int",
 					},
-				},
-				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-					[]string{"Name"},
-				},
-				[]string{"Age"},
-			},
-		},
-		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name"})
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}(),
-			[]string{"Position"},
-			DataFrame{
-				[]Series{
 					{
-						[]interface{}{"PG", "SF", "SG", "SG"},
+						[]interface{}{1.5, 2.5},
 						IndexData{
-							[]Index{
-								{0, []interface{}{"Avery Bradley"}},
-								{1, []interface{}{"Jae Crowder"}},
-								{2, []interface{}{"John Holland"}},
-								{3, []interface{}{"R.J. Hunter"}},
-							},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 							[]string{"Name"},
 						},
-						"Position",
-						"string",
+						"Score",
+						"float64",
 					},
 				},
 				IndexData{
-					[]Index{
-						{0, []interface{}{"Avery Bradley"}},
-						{1, []interface{}{"Jae Crowder"}},
-						{2, []interface{}{"John Holland"}},
-						{3, []interface{}{"R.J. Hunter"}},
-					},
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 					[]string{"Name"},
 				},
-				[]string{"Position"},
+				[]string{"Name", "Team", "Age", "Score"},
 			},
+			nil,
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			[]string{"Age", "College", "Name"},
+			}([][]interface{}{{19, 27}, {"Avery", "Bradley"}, {"Celtics", "Lakers"}, {1.5, 2.5}}, []string{"Age", "Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Name", "Score"},
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{25.0, 25.0, 27.0, 22.0},
-						IndexData{
-							[]Index{
-								{0, []interface{}{"Avery Bradley"}},
-								{1, []interface{}{"Jae Crowder"}},
-								{2, []interface{}{"John Holland"}},
-								{3, []interface{}{"R.J. Hunter"}},
-							},
-							[]string{"Name"},
-						},
-						"Age",
-						"float64",
-					},
-					{
-						[]interface{}{"Texas", "Marquette", "Boston University", "Georgia State"},
+						[]interface{}{"Avery", "Bradley"},
 						IndexData{
-							[]Index{
-								{0, []interface{}{"Avery Bradley"}},
-								{1, []interface{}{"Jae Crowder"}},
-								{2, []interface{}{"John Holland"}},
-								{3, []interface{}{"R.J. Hunter"}},
-							},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 							[]string{"Name"},
 						},
-						"College",
+						"Name",
 						"string",
 					},
 					{
-						[]interface{}{"Avery Bradley", "Jae Crowder", "John Holland", "R.J. Hunter"},
+						[]interface{}{1.5, 2.5},
 						IndexData{
-							[]Index{
-								{0, []interface{}{"Avery Bradley"}},
-								{1, []interface{}{"Jae Crowder"}},
-								{2, []interface{}{"John Holland"}},
-								{3, []interface{}{"R.J. Hunter"}},
-							},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 							[]string{"Name"},
 						},
-						"Name",
-						"string",
+						"Score",
+						"float64",
 					},
 				},
 				IndexData{
-					[]Index{
-						{0, []interface{}{"Avery Bradley"}},
-						{1, []interface{}{"Jae Crowder"}},
-						{2, []interface{}{"John Holland"}},
-						{3, []interface{}{"R.J. Hunter"}},
-					},
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
 					[]string{"Name"},
 				},
-				[]string{"Age", "College", "Name"},
+				[]string{"Name", "Score"},
 			},
+			nil,
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfloccols1.csv", []string{"Name", "Age"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			[]string{"Avery Bradley"},
+			}([][]interface{}{{19, 27}, {"Avery", "Bradley"}, {"Celtics", "Lakers"}, {1.5, 2.5}}, []string{"Age", "Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Name", "Salary"},
+			DataFrame{},
+			fmt.Errorf("%w: Salary", ErrColumnNotFound),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{19, 27}, {"Avery", "Bradley"}, {"Celtics", "Lakers"}, {1.5, 2.5}}, []string{"Age", "Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Name", "Age", "Name"},
 			DataFrame{},
+			fmt.Errorf("column 'Name' is given more than once"),
 		},
 	}
-	for _, test := range dataframeLocColsTests {
-		output, err := test.arg1.LocCols(test.arg2...)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+
+	for _, test := range alignColumnsTests {
+		output, err := test.arg1.AlignColumns(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
@@ -1392,6 +3688,73 @@ func TestDataFrameLocColsItems(t *testing.T) {
 	}
 }
 
+func TestDataFrameLocMissingLabels(t *testing.T) {
+	testDf, err := NewDataFrame(
+		[][]interface{}{{"Avery", "Bradley"}, {19, 27}, {1.5, 2.5}},
+		[]string{"Name", "Age", "Score"},
+		[]string{"Name"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type locMissingLabelsTest struct {
+		loc           func() error
+		expectedError error
+	}
+	locMissingLabelsTests := []locMissingLabelsTest{
+		{
+			func() error {
+				_, err := testDf.LocCols("Age", "Agee")
+				return err
+			},
+			fmt.Errorf("%w: Agee", ErrColumnNotFound),
+		},
+		{
+			func() error {
+				_, err := testDf.LocCols("Salary", "Age", "Team", "Salary")
+				return err
+			},
+			fmt.Errorf("%w: Salary, Team", ErrColumnNotFound),
+		},
+		{
+			func() error {
+				_, err := testDf.LocColsItems("Score", "Scor")
+				return err
+			},
+			fmt.Errorf("%w: Scor", ErrColumnNotFound),
+		},
+		{
+			func() error {
+				_, err := testDf.Loc([]string{"Team"}, []interface{}{"Avery"})
+				return err
+			},
+			fmt.Errorf("%w: Team", ErrColumnNotFound),
+		},
+		{
+			func() error {
+				_, err := testDf.LocCol("Team")
+				return err
+			},
+			fmt.Errorf("%w: Team", ErrColumnNotFound),
+		},
+		{
+			func() error {
+				_, err := testDf.LocRows([]interface{}{"Avery"}, []interface{}{"Candice"})
+				return err
+			},
+			fmt.Errorf("no data found for index [Candice]"),
+		},
+	}
+
+	for _, test := range locMissingLabelsTests {
+		err := test.loc()
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) || !errors.Is(err, ErrColumnNotFound) && errors.Is(test.expectedError, ErrColumnNotFound) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+	}
+}
+
 func BenchmarkDataFrameLoc(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	names := [][]interface{}{
@@ -1859,18 +4222,5349 @@ func BenchmarkDataFrameLoc(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.Loc([]string{"Name"}, names[rand.Intn(len(names))])
+		testDf.Loc([]string{"Name"}, names[rand.Intn(len(names))])
+	}
+}
+
+func TestDataFrameLoc(t *testing.T) {
+	type dataframeLocTest struct {
+		arg1     DataFrame
+		arg2     []string
+		arg3     [][]interface{}
+		expected DataFrame
+	}
+	dataframeLocTests := []dataframeLocTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			[]string{"Age"},
+			[][]interface{}{{"Bradley"}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{27},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Age"},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdfloc1.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			[]string{"Age", "Name"},
+			[][]interface{}{{"John Holland"}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{27.0},
+						IndexData{
+							[]Index{{2, []interface{}{"John Holland"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"John Holland"},
+						IndexData{
+							[]Index{{2, []interface{}{"John Holland"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{2, []interface{}{"John Holland"}}},
+					[]string{"Name"},
+				},
+				[]string{"Age", "Name"},
+			},
+		},
+	}
+	for _, test := range dataframeLocTests {
+		output, err := test.arg1.Loc(test.arg2, test.arg3...)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameXs(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Team", "Position"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Xs(1, "PG")
+	}
+}
+
+func TestDataFrameXs(t *testing.T) {
+	type xsTest struct {
+		arg1          DataFrame
+		arg2          int
+		arg3          interface{}
+		expected      DataFrame
+		expectedError error
+	}
+	xsTests := []xsTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"female", "male", "male", "female"}, {"basketball", "volleyball", "basketball", "volleyball"}, {"clara", "brian", "dorian", "anna"}, {24, 20, 31, 27}}, []string{"sex", "sport", "name", "age"}, []string{"sex", "sport"}),
+			0,
+			"male",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"male", "male"},
+						IndexData{
+							[]Index{{1, []interface{}{"volleyball"}}, {2, []interface{}{"basketball"}}},
+							[]string{"sport"},
+						},
+						"sex",
+						"string",
+					},
+					{
+						[]interface{}{"volleyball", "basketball"},
+						IndexData{
+							[]Index{{1, []interface{}{"volleyball"}}, {2, []interface{}{"basketball"}}},
+							[]string{"sport"},
+						},
+						"sport",
+						"string",
+					},
+					{
+						[]interface{}{"brian", "dorian"},
+						IndexData{
+							[]Index{{1, []interface{}{"volleyball"}}, {2, []interface{}{"basketball"}}},
+							[]string{"sport"},
+						},
+						"name",
+						"string",
+					},
+					{
+						[]interface{}{20, 31},
+						IndexData{
+							[]Index{{1, []interface{}{"volleyball"}}, {2, []interface{}{"basketball"}}},
+							[]string{"sport"},
+						},
+						"age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"volleyball"}}, {2, []interface{}{"basketball"}}},
+					[]string{"sport"},
+				},
+				[]string{"sex", "sport", "name", "age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"female", "male", "male", "female"}, {"basketball", "volleyball", "basketball", "volleyball"}, {"clara", "brian", "dorian", "anna"}, {24, 20, 31, 27}}, []string{"sex", "sport", "name", "age"}, []string{"sex", "sport"}),
+			1,
+			"volleyball",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"male", "female"},
+						IndexData{
+							[]Index{{1, []interface{}{"male"}}, {3, []interface{}{"female"}}},
+							[]string{"sex"},
+						},
+						"sex",
+						"string",
+					},
+					{
+						[]interface{}{"volleyball", "volleyball"},
+						IndexData{
+							[]Index{{1, []interface{}{"male"}}, {3, []interface{}{"female"}}},
+							[]string{"sex"},
+						},
+						"sport",
+						"string",
+					},
+					{
+						[]interface{}{"brian", "anna"},
+						IndexData{
+							[]Index{{1, []interface{}{"male"}}, {3, []interface{}{"female"}}},
+							[]string{"sex"},
+						},
+						"name",
+						"string",
+					},
+					{
+						[]interface{}{20, 27},
+						IndexData{
+							[]Index{{1, []interface{}{"male"}}, {3, []interface{}{"female"}}},
+							[]string{"sex"},
+						},
+						"age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"male"}}, {3, []interface{}{"female"}}},
+					[]string{"sex"},
+				},
+				[]string{"sex", "sport", "name", "age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"female", "male", "male", "female"}, {"basketball", "volleyball", "basketball", "volleyball"}, {"clara", "brian", "dorian", "anna"}, {24, 20, 31, 27}}, []string{"sex", "sport", "name", "age"}, []string{"sex", "sport"}),
+			2,
+			"clara",
+			DataFrame{},
+			fmt.Errorf("level 2 is out of range for an index with 2 levels"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"female", "male", "male", "female"}, {"basketball", "volleyball", "basketball", "volleyball"}, {"clara", "brian", "dorian", "anna"}, {24, 20, 31, 27}}, []string{"sex", "sport", "name", "age"}, []string{"sex", "sport"}),
+			1,
+			"swimming",
+			DataFrame{},
+			fmt.Errorf("no data found for swimming at level 1"),
+		},
+	}
+
+	for _, test := range xsTests {
+		output, err := test.arg1.Xs(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (fmt.Sprint(err) != fmt.Sprint(test.expectedError)) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColAdd(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColAdd("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColAdd(t *testing.T) {
+	type colAddTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colAddTests := []colAddTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			5.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{24.0, 32.0, 27.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			5.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{24.0, 32.0, 27.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+		},
+	}
+	for _, test := range colAddTests {
+		output, err := test.arg1.ColAdd(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColSub(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColSub("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColSub(t *testing.T) {
+	type colSubTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colSubTests := []colSubTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			5.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{14.0, 22.0, 17.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+	}
+	for _, test := range colSubTests {
+		output, err := test.arg1.ColSub(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColMul(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColMul("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColMul(t *testing.T) {
+	type colMulTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colMulTests := []colMulTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			2.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{38.0, 54.0, 44.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+	}
+	for _, test := range colMulTests {
+		output, err := test.arg1.ColMul(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColDiv(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColDiv("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColDiv(t *testing.T) {
+	type colDivTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colDivTests := []colDivTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			5.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3.8, 5.4, 4.4},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+	}
+	for _, test := range colDivTests {
+		output, err := test.arg1.ColDiv(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColMod(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColMod("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColMod(t *testing.T) {
+	type colModTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colModTests := []colModTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			5.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{4.0, 2.0, 2.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+	}
+	for _, test := range colModTests {
+		output, err := test.arg1.ColMod(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameRound(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Round("Salary", 2)
+	}
+}
+
+func TestDataFrameRound(t *testing.T) {
+	type roundTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     int
+		expected DataFrame
+	}
+
+	roundTests := []roundTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.456, 27.5, 22.049}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Score",
+			0,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 28.0, 22.0}}, []string{"Name", "Score"}, []string{"Name"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.456, 27.5, math.NaN()}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Score",
+			2,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.46, 27.5, math.NaN()}}, []string{"Name", "Score"}, []string{"Name"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {1234.5, 27.5, 1250.0}}, []string{"Name", "Salary"}, []string{"Name"}),
+			"Salary",
+			-2,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {1200.0, 0.0, 1300.0}}, []string{"Name", "Salary"}, []string{"Name"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {1234, 27, 1250}}, []string{"Name", "Salary"}, []string{"Name"}),
+			"Salary",
+			-1,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {1230, 30, 1250}}, []string{"Name", "Salary"}, []string{"Name"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.456, 27.5, 22.049}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Name",
+			2,
+			DataFrame{},
+		},
+	}
+	for _, test := range roundTests {
+		output, err := test.arg1.Round(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestDataFrameRoundAll(t *testing.T) {
+	type roundAllTest struct {
+		arg1     DataFrame
+		arg2     int
+		expected DataFrame
+	}
+
+	roundAllTests := []roundAllTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.456, 27.5, 22.049}, {0.125, 0.333, 0.5}}, []string{"Name", "Score", "Ratio"}, []string{"Name"}),
+			1,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.5, 27.5, 22.0}, {0.1, 0.3, 0.5}}, []string{"Name", "Score", "Ratio"}, []string{"Name"}),
+		},
+	}
+	for _, test := range roundAllTests {
+		output, err := test.arg1.RoundAll(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColGt(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColGt("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColGt(t *testing.T) {
+	type colGtTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colGtTests := []colGtTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			25.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{false, true, false},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, ""}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			20.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{false, true, false},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+		},
+	}
+	for _, test := range colGtTests {
+		output, err := test.arg1.ColGt(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColLt(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColLt("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColLt(t *testing.T) {
+	type colLtTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colLtTests := []colLtTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			22.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{true, false, false},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+	}
+	for _, test := range colLtTests {
+		output, err := test.arg1.ColLt(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColEq(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColEq("Salary", rand.Float64())
+	}
+}
+
+func TestDataFrameColEq(t *testing.T) {
+	type colEqTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     float64
+		expected DataFrame
+	}
+
+	colEqTests := []colEqTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			19.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{true, false, false},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+	}
+	for _, test := range colEqTests {
+		output, err := test.arg1.ColEq(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestDataFrameColEqNaN(t *testing.T) {
+	type colEqNaNTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          float64
+		expected      DataFrame
+		expectedError error
+	}
+
+	colEqNaNTests := []colEqNaNTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			22.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{false, false, false, true},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			math.NaN(),
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{false, false, true, false},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("cannot compare, column data type is not float64 or int"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Height",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+	for _, test := range colEqNaNTests {
+		output, err := test.arg1.ColEq(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColNe(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColNe("Age", 25.0)
+	}
+}
+
+func TestDataFrameColNe(t *testing.T) {
+	type colNeTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          float64
+		expected      DataFrame
+		expectedError error
+	}
+
+	colNeTests := []colNeTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			22.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{true, true, true, false},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			math.NaN(),
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{true, true, false, true},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("cannot compare, column data type is not float64 or int"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Height",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+	for _, test := range colNeTests {
+		output, err := test.arg1.ColNe(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColGe(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColGe("Age", 25.0)
+	}
+}
+
+func TestDataFrameColGe(t *testing.T) {
+	type colGeTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          float64
+		expected      DataFrame
+		expectedError error
+	}
+
+	colGeTests := []colGeTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			22.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{false, true, false, true},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("cannot compare, column data type is not float64 or int"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Height",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+	for _, test := range colGeTests {
+		output, err := test.arg1.ColGe(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameColLe(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.ColLe("Age", 25.0)
+	}
+}
+
+func TestDataFrameColLe(t *testing.T) {
+	type colLeTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          float64
+		expected      DataFrame
+		expectedError error
+	}
+
+	colLeTests := []colLeTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			22.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{true, false, false, true},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Name",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("cannot compare, column data type is not float64 or int"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {19, 27, NA, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Height",
+			5.0,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+	for _, test := range colLeTests {
+		output, err := test.arg1.ColLe(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameMask(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Mask("Salary", func(v float64) bool { return v < 1000000 }, 0.0)
+	}
+}
+
+func TestDataFrameMask(t *testing.T) {
+	type maskTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          func(float64) bool
+		arg4          interface{}
+		expected      DataFrame
+		expectedError error
+	}
+	maskTests := []maskTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {3, -1, NA, 5}, {1.5, -2.0, math.NaN(), -0.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"Score",
+			func(v float64) bool { return v < 0 },
+			0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3, -1, NA, 5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{1.5, 0.0, math.NaN(), 0.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {3, -1, NA, 5}, {1.5, -2.0, math.NaN(), -0.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"Age",
+			func(v float64) bool { return v < 0 },
+			0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3, 0, NA, 5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{1.5, -2.0, math.NaN(), -0.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {3, -1, NA, 5}, {1.5, -2.0, math.NaN(), -0.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"Score",
+			math.IsNaN,
+			0.0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3, -1, NA, 5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{1.5, -2.0, 0.0, -0.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {3, -1, NA, 5}, {1.5, -2.0, math.NaN(), -0.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"Name",
+			func(v float64) bool { return true },
+			"Unknown",
+			DataFrame{},
+			fmt.Errorf("cannot mask index column 'Name'"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {3, -1, NA, 5}, {1.5, -2.0, math.NaN(), -0.5}}, []string{"Name", "Age", "Score"}, nil),
+			"Name",
+			func(v float64) bool { return true },
+			"Unknown",
+			DataFrame{},
+			fmt.Errorf("cannot mask, column data type is not float64 or int"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {3, -1, NA, 5}, {1.5, -2.0, math.NaN(), -0.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"Height",
+			func(v float64) bool { return true },
+			0,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+
+	for _, test := range maskTests {
+		output, err := test.arg1.Mask(test.arg2, test.arg3, test.arg4)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameNewCol(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	data := make([]interface{}, len(testDf.series[0].data))
+	for i := 0; i < len(testDf.series[0].data); i++ {
+		data[i] = rand.Float64()
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.NewCol("New Column", data)
+	}
+}
+
+func TestDataFrameNewCol(t *testing.T) {
+	type newColTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     []interface{}
+		expected DataFrame
+	}
+	newColTests := []newColTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Nationality",
+			[]interface{}{"USA", "UK", "Canada"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19.0, 27.0, 22.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+					{
+						[]interface{}{"USA", "UK", "Canada"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Nationality",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex", "Nationality"},
+			},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age+5",
+			[]interface{}{"", "", ""},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19.0, 27.0, 22.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age+5",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex", "Age+5"},
+			},
+		},
+	}
+	for _, test := range newColTests {
+		output, err := test.arg1.NewCol(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameAssign(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	fn := func(df DataFrame) ([]interface{}, error) {
+		return df.series[2].data, nil
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Assign("New Column", fn)
+	}
+}
+
+func TestDataFrameAssign(t *testing.T) {
+	product := func(df DataFrame) ([]interface{}, error) {
+		price, err := df.LocCol("Price")
+		if err != nil {
+			return nil, err
+		}
+		quantity, err := df.LocCol("Quantity")
+		if err != nil {
+			return nil, err
+		}
+		data := make([]interface{}, len(price.data))
+		for i := range data {
+			data[i] = price.data[i].(int) * quantity.data[i].(int)
+		}
+		return data, nil
+	}
+
+	type assignTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          func(DataFrame) ([]interface{}, error)
+		expected      DataFrame
+		expectedError error
+	}
+	assignTests := []assignTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {3, 5, 2}, {4, 1, 10}}, []string{"Name", "Price", "Quantity"}, []string{"Name"}),
+			"Total",
+			product,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3, 5, 2},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Price",
+						"int",
+					},
+					{
+						[]interface{}{4, 1, 10},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Quantity",
+						"int",
+					},
+					{
+						[]interface{}{12, 5, 20},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Total",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Price", "Quantity", "Total"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {3, 5, 2}}, []string{"Name", "Price"}, []string{"Name"}),
+			"Total",
+			product,
+			DataFrame{},
+			fmt.Errorf("%w: Quantity", ErrColumnNotFound),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {3, 5, 2}, {4, 1, 10}}, []string{"Name", "Price", "Quantity"}, []string{"Name"}),
+			"Total",
+			func(df DataFrame) ([]interface{}, error) {
+				return []interface{}{1, 2}, nil
+			},
+			DataFrame{},
+			fmt.Errorf("length of data (2) and rows (3) does not match"),
+		},
+	}
+
+	for _, test := range assignTests {
+		output, err := test.arg1.Assign(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameAppendRow(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		newDf := copyDf(&testDf)
+		newDf.AppendRow([]interface{}{"New Player"}, map[string]interface{}{"Team": "Boston Celtics", "Age": 25.0})
+	}
+}
+
+func TestDataFrameAppendRow(t *testing.T) {
+	type appendRowTest struct {
+		arg1          DataFrame
+		arg2          [][]interface{}
+		arg3          []map[string]interface{}
+		expected      DataFrame
+		expectedError error
+	}
+	appendRowTests := []appendRowTest{
+		{
+			func(columns []string, dtypes []string) DataFrame {
+				newDf, err := NewEmptyDataFrame(columns, dtypes)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([]string{"Name", "Age", "Score"}, []string{"string", "int", "float64"}),
+			[][]interface{}{nil, nil, nil},
+			[]map[string]interface{}{
+				{"Name": "Avery", "Age": 19, "Score": 1.5},
+				{"Name": "Bradley", "Age": 26},
+				{"Name": "Candice", "Score": 3},
+			},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 26, NA},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{1.5, math.NaN(), 3.0},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 26}}, []string{"Name", "Age"}, []string{"Name"}),
+			[][]interface{}{{"Candice"}},
+			[]map[string]interface{}{{"Age": 22.5}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19.0, 26.0, 22.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 26}}, []string{"Name", "Age"}, nil),
+			[][]interface{}{nil},
+			[]map[string]interface{}{{"Name": "Candice", "Age": "unknown"}},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"19", "26", "unknown"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(columns []string, dtypes []string) DataFrame {
+				newDf, err := NewEmptyDataFrame(columns, dtypes)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([]string{"Name", "Age", "Score"}, []string{"string", "int", "float64"}),
+			[][]interface{}{nil},
+			[]map[string]interface{}{{"Name": "Avery", "Height": 180}},
+			DataFrame{},
+			fmt.Errorf("%w: Height", ErrColumnNotFound),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 26}}, []string{"Name", "Age"}, []string{"Name"}),
+			[][]interface{}{nil},
+			[]map[string]interface{}{{"Name": "Candice", "Age": 22}},
+			DataFrame{},
+			fmt.Errorf("length of index [] (0) and names (1) does not match"),
+		},
+	}
+
+	for _, test := range appendRowTests {
+		var err error
+		for i, row := range test.arg3 {
+			if err = test.arg1.AppendRow(test.arg2[i], row); err != nil {
+				break
+			}
+		}
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+		if err != nil {
+			continue
+		}
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.expected, test.arg1)
+		}
+	}
+}
+
+func BenchmarkDataFrameNewDerivedCol(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.NewDerivedCol("New Column", "Salary")
+	}
+}
+
+func TestDataFrameNewDerivedCol(t *testing.T) {
+	type newDerivedColTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     string
+		expected DataFrame
+	}
+	newDerivedColTests := []newDerivedColTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"NewAge",
+			"Age",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19.0, 27.0, 22.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+					{
+						[]interface{}{19.0, 27.0, 22.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"NewAge",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex", "NewAge"},
+			},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"NewCol",
+			"Doesn't Exist",
+			DataFrame{},
+		},
+	}
+	for _, test := range newDerivedColTests {
+		output, err := test.arg1.NewDerivedCol(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameCut(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Cut("Age", []float64{0, 25, 30, 40}, []string{"young", "prime", "veteran"})
+	}
+}
+
+func TestDataFrameCut(t *testing.T) {
+	type cutTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          []float64
+		arg4          []string
+		expected      DataFrame
+		expectedError error
+	}
+	cutTests := []cutTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {8, 18, 19, 65, 70, NA}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			[]float64{0, 18, 65, 120},
+			[]string{"child", "adult", "senior"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {5, []interface{}{"Fiona"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{8, 18, 19, 65, 70, NA},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {5, []interface{}{"Fiona"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{"child", "child", "adult", "adult", "senior", math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {5, []interface{}{"Fiona"}}},
+							[]string{"Name"},
+						},
+						"Age_bin",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {5, []interface{}{"Fiona"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Age_bin"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {8, 18, 19, 65, 70, NA}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			[]float64{0, 18, 65, 120},
+			[]string{"child", "adult"},
+			DataFrame{},
+			fmt.Errorf("length of labels (2) must be one less than length of bins (4)"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {8, 18, 19, 65, 70, NA}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			[]float64{0, 65, 18},
+			[]string{"child", "adult"},
+			DataFrame{},
+			fmt.Errorf("bins must be strictly increasing"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {8, 18, 19, 65, 70, NA}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Name",
+			[]float64{0, 18},
+			[]string{"child"},
+			DataFrame{},
+			fmt.Errorf("column data type is not float64 or int"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {70, NA}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			[]float64{0, 18},
+			[]string{"child"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{70, NA},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Age_bin",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Age_bin"},
+			},
+			nil,
+		},
+	}
+	for _, test := range cutTests {
+		output, err := test.arg1.Cut(test.arg2, test.arg3, test.arg4)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameCumCount(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.CumCount("Salary")
+	}
+}
+
+func TestDataFrameCumCount(t *testing.T) {
+	type cumCountTest struct {
+		arg1          DataFrame
+		arg2          string
+		expected      DataFrame
+		expectedError error
+	}
+	cumCountTests := []cumCountTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {1.5, math.NaN(), math.NaN(), 4.0, 5.5}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Score",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana", "Ethan"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{1.5, math.NaN(), math.NaN(), 4.0, 5.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{1, 1, 1, 2, 3},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Score_cumcount",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score", "Score_cumcount"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {NA, 18, 19, NA, 70}}, []string{"Name", "Age"}, []string{"Name"}),
+			"Age",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana", "Ethan"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{NA, 18, 19, NA, 70},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{0, 1, 2, 2, 3},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Age_cumcount",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Age_cumcount"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {1.5, math.NaN(), math.NaN(), 4.0, 5.5}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Height",
+			DataFrame{},
+			fmt.Errorf("%w: Height", ErrColumnNotFound),
+		},
+	}
+
+	for _, test := range cumCountTests {
+		output, err := test.arg1.CumCount(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFramePercentRank(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.PercentRank("Salary")
+	}
+}
+
+func TestDataFramePercentRank(t *testing.T) {
+	type percentRankTest struct {
+		arg1          DataFrame
+		arg2          string
+		expected      DataFrame
+		expectedError error
+	}
+	percentRankTests := []percentRankTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, math.NaN(), 7.0, 3.0, 10.0}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Score",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana", "Ethan"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{3.0, math.NaN(), 7.0, 3.0, 10.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{0.5, math.NaN(), 0.75, 0.5, 1.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+							[]string{"Name"},
+						},
+						"Score_pctrank",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score", "Score_pctrank"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{20, 10}}, []string{"Age"}, nil),
+			"Age",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{20, 10},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{1.0, 0.5},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"Age_pctrank",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
+				},
+				[]string{"Age", "Age_pctrank"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, math.NaN(), 7.0, 3.0, 10.0}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Name",
+			DataFrame{},
+			fmt.Errorf("cannot rank, column %w", ErrTypeMismatch),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, math.NaN(), 7.0, 3.0, 10.0}}, []string{"Name", "Score"}, []string{"Name"}),
+			"Age",
+			DataFrame{},
+			fmt.Errorf("%w: Age", ErrColumnNotFound),
+		},
+	}
+
+	for _, test := range percentRankTests {
+		output, err := test.arg1.PercentRank(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameRollingApply(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	fn := func(window []float64) float64 {
+		return window[len(window)-1] - window[0]
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.RollingApply("Salary", 5, fn)
+	}
+}
+
+func TestDataFrameRollingApply(t *testing.T) {
+	weightedMean := func(window []float64) float64 {
+		total, weights := 0.0, 0.0
+		for i, v := range window {
+			total += float64(i+1) * v
+			weights += float64(i + 1)
+		}
+		return total / weights
+	}
+
+	type rollingApplyTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          int
+		expected      DataFrame
+		expectedError error
+	}
+	rollingApplyTests := []rollingApplyTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Mon", "Tue", "Wed", "Thu", "Fri"}, {10, 20, 30, NA, 60}, {"a", "b", "c", "d", "e"}}, []string{"Day", "Sales", "Note"}, []string{"Day"}),
+			"Sales",
+			3,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Mon", "Tue", "Wed", "Thu", "Fri"},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Day",
+						"string",
+					},
+					{
+						[]interface{}{10, 20, 30, NA, 60},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Sales",
+						"int",
+					},
+					{
+						[]interface{}{"a", "b", "c", "d", "e"},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Note",
+						"string",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), 140.0 / 6.0, math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Sales_rolling",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+					[]string{"Day"},
+				},
+				[]string{"Day", "Sales", "Note", "Sales_rolling"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Mon", "Tue", "Wed", "Thu", "Fri"}, {10, 20, 30, NA, 60}, {"a", "b", "c", "d", "e"}}, []string{"Day", "Sales", "Note"}, []string{"Day"}),
+			"Sales",
+			1,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Mon", "Tue", "Wed", "Thu", "Fri"},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Day",
+						"string",
+					},
+					{
+						[]interface{}{10, 20, 30, NA, 60},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Sales",
+						"int",
+					},
+					{
+						[]interface{}{"a", "b", "c", "d", "e"},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Note",
+						"string",
+					},
+					{
+						[]interface{}{10.0, 20.0, 30.0, math.NaN(), 60.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+							[]string{"Day"},
+						},
+						"Sales_rolling",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Mon"}}, {1, []interface{}{"Tue"}}, {2, []interface{}{"Wed"}}, {3, []interface{}{"Thu"}}, {4, []interface{}{"Fri"}}},
+					[]string{"Day"},
+				},
+				[]string{"Day", "Sales", "Note", "Sales_rolling"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Mon", "Tue", "Wed", "Thu", "Fri"}, {10, 20, 30, NA, 60}, {"a", "b", "c", "d", "e"}}, []string{"Day", "Sales", "Note"}, []string{"Day"}),
+			"Sales",
+			0,
+			DataFrame{},
+			fmt.Errorf("window should be at least 1, got 0"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Mon", "Tue", "Wed", "Thu", "Fri"}, {10, 20, 30, NA, 60}, {"a", "b", "c", "d", "e"}}, []string{"Day", "Sales", "Note"}, []string{"Day"}),
+			"Note",
+			2,
+			DataFrame{},
+			fmt.Errorf("cannot apply rolling function, column %w", ErrTypeMismatch),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Mon", "Tue", "Wed", "Thu", "Fri"}, {10, 20, 30, NA, 60}, {"a", "b", "c", "d", "e"}}, []string{"Day", "Sales", "Note"}, []string{"Day"}),
+			"Profit",
+			2,
+			DataFrame{},
+			fmt.Errorf("%w: Profit", ErrColumnNotFound),
+		},
+	}
+
+	for _, test := range rollingApplyTests {
+		output, err := test.arg1.RollingApply(test.arg2, test.arg3, weightedMean)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameRenameCol(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.RenameCol(map[string]string{"Name": "New Name", "Age": "New Age"})
+	}
+}
+
+func TestDataFrameRenameCol(t *testing.T) {
+	type renameColTest struct {
+		arg1     DataFrame
+		arg2     map[string]string
+		expected DataFrame
+	}
+	renameColTests := []renameColTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			map[string]string{"Name": "Names"},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Names", "Age", "Sex"}, []string{"Names"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			map[string]string{"Age": "HowOld"},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "HowOld", "Sex"}, []string{"Name"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name", "Sex"}),
+			map[string]string{"Name": "Names"},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Names", "Age", "Sex"}, []string{"Names", "Sex"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name", "Sex"}),
+			map[string]string{"Names": "Name"},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name", "Sex"}),
+		},
+	}
+
+	for _, test := range renameColTests {
+		err := test.arg1.RenameCol(test.arg2)
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+			if fmt.Sprint(err)[:22] == "column does not exist:" || fmt.Sprint(err)[:21] == "index does not exist:" {
+				continue
+			}
+			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+		}
+	}
+}
+
+func TestDataFrameRenameColSwap(t *testing.T) {
+	testDf := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"})
+
+	// renaming happens all at once, so two columns can swap names.
+	err := testDf.RenameCol(map[string]string{"Age": "Sex", "Sex": "Age"})
+	expected := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Sex", "Age"}, []string{"Name"})
+	if !cmp.Equal(testDf, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", expected, testDf, err)
+	}
+
+	// nothing is renamed if one of the columns does not exist.
+	err = testDf.RenameCol(map[string]string{"Name": "Names", "Height": "Tall"})
+	if fmt.Sprint(err) != "column does not exist: Height" || !cmp.Equal(testDf, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) {
+		t.Fatalf("expected %v, got %v, error %v", expected, testDf, err)
+	}
+}
+
+func BenchmarkDataFrameRename(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		newDf := copyDf(&testDf)
+		newDf.Rename(map[string]string{"Age": "Years"}, map[string]string{"Avery Bradley": "Avery"})
+	}
+}
+
+func TestDataFrameRename(t *testing.T) {
+	type renameTest struct {
+		arg1          DataFrame
+		arg2          map[string]string
+		arg3          map[string]string
+		expected      DataFrame
+		expectedError error
+	}
+	renameTests := []renameTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			map[string]string{"Age": "Years"},
+			map[string]string{"Bradley": "Brad"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Brad", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Brad"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 26, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Brad"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Years",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Brad"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Years"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			map[string]string{"Name": "Player"},
+			map[string]string{"Avery": "Ava", "Candice": "Candy"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Ava", "Bradley", "Candy"},
+						IndexData{
+							[]Index{{0, []interface{}{"Ava"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candy"}}},
+							[]string{"Player"},
+						},
+						"Player",
+						"string",
+					},
+					{
+						[]interface{}{19, 26, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Ava"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candy"}}},
+							[]string{"Player"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Ava"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candy"}}},
+					[]string{"Player"},
+				},
+				[]string{"Player", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}}, []string{"Name", "Age"}, nil),
+			nil,
+			map[string]string{"0": "zero"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 26, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			map[string]string{"Height": "Cm"},
+			map[string]string{"Bradley": "Brad"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 26, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			fmt.Errorf("%w: Height", ErrColumnNotFound),
+		},
+	}
+
+	for _, test := range renameTests {
+		err := test.arg1.Rename(test.arg2, test.arg3)
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameSetColumns(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	names := make([]string, len(testDf.columns))
+	for i := range names {
+		names[i] = fmt.Sprintf("col%d", i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.SetColumns(names)
+	}
+}
+
+func TestDataFrameSetColumns(t *testing.T) {
+	type setColumnsTest struct {
+		arg1          []string
+		arg2          []string
+		expectedIndex []string
+		expectedError error
+	}
+	setColumnsTests := []setColumnsTest{
+		{nil, []string{"Name", "Age", "Sex"}, []string{""}, nil},
+		{[]string{"A"}, []string{"Name", "Age", "Sex"}, []string{"Name"}, nil},
+		{[]string{"A", "C"}, []string{"Name", "Age", "Sex"}, []string{"Name", "Sex"}, nil},
+		{nil, []string{"Name", "Age"}, nil, fmt.Errorf("length of names (2) and columns (3) does not match")},
+	}
+
+	for _, test := range setColumnsTests {
+		testDf, err := ReadCsv("testfiles/testnoheader1.csv", test.arg1, CsvHasHeader(false))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		err = testDf.SetColumns(test.arg2)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+		if err != nil {
+			if !cmp.Equal(testDf.Columns(), []string{"A", "B", "C"}) {
+				t.Fatalf("expected columns to be unchanged, got %v", testDf.Columns())
+			}
+			continue
+		}
+
+		if !cmp.Equal(testDf.Columns(), test.arg2) || !cmp.Equal(testDf.index.names, test.expectedIndex) {
+			t.Fatalf("expected columns %v and index %v, got %v and %v", test.arg2, test.expectedIndex, testDf.Columns(), testDf.index.names)
+		}
+		for i, col := range test.arg2 {
+			ser, err := testDf.LocCol(col)
+			if err != nil || ser.name != col || !cmp.Equal(ser.data, testDf.series[i].data) || !cmp.Equal(ser.index.names, test.expectedIndex) {
+				t.Fatalf("expected column %v to be found, got %v, error %v", col, ser, err)
+			}
+		}
+	}
+}
+
+func BenchmarkDataFrameDedupColumns(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	merged, err := testDf.MergeDfsHorizontally(testDf)
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		merged.DedupColumns("_")
+	}
+}
+
+func TestDataFrameDedupColumns(t *testing.T) {
+	type dedupColumnsTest struct {
+		arg1     DataFrame
+		arg2     string
+		expected DataFrame
+	}
+	dedupColumnsTests := []dedupColumnsTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {1.5, 2.5}, {3, 4}, {5, 6}}, []string{"Name", "Score", "Score", "Score"}, []string{"Name"}),
+			"_",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{1.5, 2.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{3, 4},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score_1",
+						"int",
+					},
+					{
+						[]interface{}{5, 6},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score_2",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score", "Score_1", "Score_2"},
+			},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {1.5, 2.5}, {3, 4}, {5, 6}}, []string{"Name", "Score", "Score", "Score.1"}, []string{"Name"}),
+			".",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{1.5, 2.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{3, 4},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score.2",
+						"int",
+					},
+					{
+						[]interface{}{5, 6},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score.1",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score", "Score.2", "Score.1"},
+			},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {1.5, 2.5}}, []string{"Name", "Score"}, []string{"Name"}),
+			"_",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{1.5, 2.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score"},
+			},
+		},
+	}
+
+	for _, test := range dedupColumnsTests {
+		output := test.arg1.DedupColumns(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkDataFrameAddPrefix(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		newDf := copyDf(&testDf)
+		newDf.AddPrefix("x")
+	}
+}
+
+func TestDataFrameAddPrefix(t *testing.T) {
+	type addPrefixTest struct {
+		arg1     DataFrame
+		arg2     string
+		expected DataFrame
+	}
+	addPrefixTests := []addPrefixTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"left_",
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"left_Name", "left_Age", "left_Sex"}, []string{"left_Name"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}}, []string{"Name", "Age"}, nil),
+			"left_",
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}}, []string{"left_Name", "left_Age"}, nil),
+		},
+	}
+
+	for _, test := range addPrefixTests {
+		test.arg1.AddPrefix(test.arg2)
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) {
+			t.Fatalf("expected %v, got %v", test.expected, test.arg1)
+		}
+	}
+}
+
+func BenchmarkDataFrameAddSuffix(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		newDf := copyDf(&testDf)
+		newDf.AddSuffix("x")
+	}
+}
+
+func TestDataFrameAddSuffix(t *testing.T) {
+	type addSuffixTest struct {
+		arg1     DataFrame
+		arg2     string
+		expected DataFrame
+	}
+	addSuffixTests := []addSuffixTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"_2022",
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name_2022", "Age_2022", "Sex_2022"}, []string{"Name_2022"}),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}}, []string{"Name", "Age"}, nil),
+			"_2022",
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}}, []string{"Name_2022", "Age_2022"}, nil),
+		},
+	}
+
+	for _, test := range addSuffixTests {
+		test.arg1.AddSuffix(test.arg2)
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) {
+			t.Fatalf("expected %v, got %v", test.expected, test.arg1)
+		}
+	}
+}
+
+func TestDataFrameRenameIndex(t *testing.T) {
+	type renameIndexTest struct {
+		arg1          DataFrame
+		arg2          []string
+		expectedNames []string
+		expectedError error
+	}
+	renameIndexTests := []renameIndexTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"female", "male", "male"}, {"basketball", "volleyball", "basketball"}, {"clara", "brian", "dorian"}}, []string{"sex", "sport", "name"}, []string{"sex", "sport"}),
+			[]string{"gender", "activity"},
+			[]string{"gender", "activity"},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"female", "male", "male"}, {"basketball", "volleyball", "basketball"}, {"clara", "brian", "dorian"}}, []string{"sex", "sport", "name"}, []string{"sex", "sport"}),
+			[]string{"gender"},
+			[]string{"sex", "sport"},
+			fmt.Errorf("length of names (1) and index levels (2) does not match"),
+		},
+	}
+
+	for _, test := range renameIndexTests {
+		err := test.arg1.RenameIndex(test.arg2)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got error %v", test.expectedError, err)
+		}
+		if !cmp.Equal(test.arg1.index.names, test.expectedNames) {
+			t.Fatalf("expected %v, got %v", test.expectedNames, test.arg1.index.names)
+		}
+		for _, ser := range test.arg1.series {
+			if !cmp.Equal(ser.index.names, test.expectedNames) {
+				t.Fatalf("expected %v, got %v", test.expectedNames, ser.index.names)
+			}
+		}
+
+		output := captureStdout(t, test.arg1.Print)
+		header := strings.Fields(strings.SplitN(output, "\n", 2)[0])
+		if !cmp.Equal(header[:len(test.expectedNames)], test.expectedNames) {
+			t.Fatalf("expected header to start with %v, got %v", test.expectedNames, header)
+		}
+	}
+}
+
+func BenchmarkDataFrameMergeDfsHorizontally(b *testing.B) {
+	srcDf, err := ReadCsv("testfiles/mergeDfsHorizontally/1src.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	targetDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1target.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		srcDf.MergeDfsHorizontally(targetDf)
+	}
+}
+
+func TestDataFrameMergeDfsHorizontally(t *testing.T) {
+	type mergeDfsHorizontallyTest struct {
+		arg1     DataFrame
+		arg2     DataFrame
+		expected DataFrame
+	}
+	mergeDfsHorizontallyTests := []mergeDfsHorizontallyTest{
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1src.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1target.csv", []string{"Sex"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1exp.csv", nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/2src.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/2target.csv", []string{"Height"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/2exp.csv", nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+		},
+	}
+	for _, test := range mergeDfsHorizontallyTests {
+		output, err := test.arg1.MergeDfsHorizontally(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestDataFrameMergeDfsHorizontallyDedup(t *testing.T) {
+	src, err := NewDataFrame([][]interface{}{{"Avery", "Bradley"}, {19, 27}}, []string{"Name", "Age"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, err := NewDataFrame([][]interface{}{{"Celtics", "Lakers"}, {20, 28}}, []string{"Team", "Age"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := src.MergeDfsHorizontally(target, "_")
+	expected := DataFrame{
+		[]Series{
+			{
+				[]interface{}{"Avery", "Bradley"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
+				},
+				"Name",
+				"string",
+			},
+			{
+				[]interface{}{19, 27},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
+				},
+				"Age",
+				"int",
+			},
+			{
+				[]interface{}{"Celtics", "Lakers"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
+				},
+				"Team",
+				"string",
+			},
+			{
+				[]interface{}{20, 28},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
+				},
+				"Age_1",
+				"int",
+			},
+		},
+		IndexData{
+			[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+			[]string{""},
+		},
+		[]string{"Name", "Age", "Team", "Age_1"},
+	}
+	if !cmp.Equal(output, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", expected, output, err)
+	}
+
+	_, err = src.MergeDfsHorizontally(target, "_", ".")
+	if fmt.Sprint(err) != "only one dedupSuffix can be given" {
+		t.Fatalf("expected an error for two suffixes, got %v", err)
+	}
+}
+
+func BenchmarkDataFrameMergeDfsVertically(b *testing.B) {
+	srcDf, err := ReadCsv("testfiles/mergeDfsVertically/1src.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	targetDf, err := ReadCsv("./testfiles/mergeDfsVertically/1target.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		srcDf.MergeDfsVertically(targetDf)
+	}
+}
+
+func TestDataFrameMergeDfsVertically(t *testing.T) {
+	type mergeDfsVerticallyTest struct {
+		arg1     DataFrame
+		arg2     DataFrame
+		expected DataFrame
+	}
+	mergeDfsVerticallyTests := []mergeDfsVerticallyTest{
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/1src.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/1target.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/1exp.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/2src.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/2target.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/2exp.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+		},
+	}
+	for _, test := range mergeDfsVerticallyTests {
+		output, err := test.arg1.MergeDfsVertically(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestDataFrameMergeDfsVerticallyJoin(t *testing.T) {
+	type mergeDfsVerticallyJoinTest struct {
+		arg1          DataFrame
+		arg2          DataFrame
+		arg3          string
+		expected      DataFrame
+		expectedError error
+	}
+	mergeDfsVerticallyJoinTests := []mergeDfsVerticallyJoinTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {"Male", "Male"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana"}, {22, 30}, {160.5, 170.0}}, []string{"Name", "Age", "Height"}, []string{"Name"}),
+			"outer",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 27, 22, 30},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male", math.NaN(), math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), 160.5, 170.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Height",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex", "Height"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {"Male", "Male"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana"}, {22, 30}, {160.5, 170.0}}, []string{"Name", "Age", "Height"}, []string{"Name"}),
+			"inner",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 27, 22, 30},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {"Male", "Male"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Candice", "Diana"}, {22, 30}, {160.5, 170.0}}, []string{"Name", "Age", "Height"}, []string{"Name"}),
+			"left",
+			DataFrame{},
+			fmt.Errorf("join can only be either \"inner\" or \"outer\""),
+		},
+	}
+	for _, test := range mergeDfsVerticallyJoinTests {
+		output, err := test.arg1.MergeDfsVertically(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || (fmt.Sprint(err) != fmt.Sprint(test.expectedError)) {
+			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+		}
+	}
+
+	testDf, err := NewDataFrame([][]interface{}{{"Avery"}}, []string{"Name"}, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = testDf.MergeDfsVertically(testDf, "inner", "outer")
+	if err == nil {
+		t.Fatalf("expected an error when passing more than one join")
+	}
+}
+
+func BenchmarkConcat(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Concat([]DataFrame{testDf, testDf, testDf}, 0)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	type concatTest struct {
+		arg1          []DataFrame
+		arg2          int
+		expected      DataFrame
+		expectedError error
+	}
+	concatTests := []concatTest{
+		{
+			[]DataFrame{
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Avery", "Bradley"}, {19, 27}}, []string{"Name", "Age"}, []string{"Name"}),
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Candice"}, {"Female"}}, []string{"Name", "Sex"}, []string{"Name"}),
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Diana"}, {30}, {"Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			},
+			0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 27, NA, 30},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{math.NaN(), math.NaN(), "Female", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+			nil,
+		},
+		{
+			[]DataFrame{
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Avery", "Bradley"}, {19, 27}}, []string{"Name", "Age"}, []string{"Name"}),
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{1.5}}, []string{"Score"}, nil),
+			},
+			1,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 27},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{1.5, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Score"},
+			},
+			nil,
+		},
+		{
+			[]DataFrame{},
+			0,
+			DataFrame{},
+			fmt.Errorf("no DataFrame to concatenate"),
+		},
+		{
+			[]DataFrame{
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Avery", "Bradley"}, {19, 27}}, []string{"Name", "Age"}, []string{"Name"}),
+			},
+			2,
+			DataFrame{},
+			fmt.Errorf("axis can only be either 0 or 1, got 2"),
+		},
+	}
+
+	for _, test := range concatTests {
+		output, err := Concat(test.arg1, test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || (fmt.Sprint(err) != fmt.Sprint(test.expectedError)) {
+			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkConcatWithKeys(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ConcatWithKeys([]DataFrame{testDf, testDf, testDf}, []string{"a", "b", "c"})
+	}
+}
+
+func TestConcatWithKeys(t *testing.T) {
+	type concatWithKeysTest struct {
+		arg1          []DataFrame
+		arg2          []string
+		expected      DataFrame
+		expectedError error
+	}
+	concatWithKeysTests := []concatWithKeysTest{
+		{
+			[]DataFrame{
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Avery", "Bradley"}, {12, 7}}, []string{"Name", "Points"}, []string{"Name"}),
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Candice"}, {21}}, []string{"Name", "Points"}, []string{"Name"}),
+			},
+			[]string{"2021", "2022"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{12, 7, 21},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Points",
+						"int",
+					},
+					{
+						[]interface{}{"2021", "2021", "2022"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"_source",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Points", "_source"},
+			},
+			nil,
+		},
+		{
+			[]DataFrame{
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Avery", "Bradley"}, {12, 7}}, []string{"Name", "Points"}, []string{"Name"}),
+			},
+			[]string{"2021", "2022"},
+			DataFrame{},
+			fmt.Errorf("length of keys (2) and DataFrame objects (1) does not match"),
+		},
+		{
+			[]DataFrame{
+				func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+					newDf, err := NewDataFrame(data, columns, indexCols)
+					if err != nil {
+						t.Error(err)
+					}
+					return newDf
+				}([][]interface{}{{"Avery"}, {"2020"}}, []string{"Name", "_source"}, []string{"Name"}),
+			},
+			[]string{"2021"},
+			DataFrame{},
+			fmt.Errorf("column '_source' already exists in 2021"),
+		},
+	}
+
+	for _, test := range concatWithKeysTests {
+		output, err := ConcatWithKeys(test.arg1, test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameSortByIndex(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.SortByIndex(true)
+	}
+}
+
+func TestDataFrameSortByIndex(t *testing.T) {
+	type sortByIndexTest struct {
+		arg1     DataFrame
+		arg2     bool
+		expected DataFrame
+	}
+	sortByIndexTests := []sortByIndexTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Bradley", "Candice", "Avery"}, {27.0, 22.0, 19.0}, {"Male", "Female", "Male"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			true,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19.0, 27.0, 22.0},
+						IndexData{
+							[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+		// {
+		// 	func() DataFrame {
+		// 		newDf, err := ReadCsv("./testfiles/nba.csv", []string{"Team"})
+		// 		if err != nil {
+		// 			t.Error(err)
+		// 		}
+		// 		return *newDf
+		// 	}(),
+		// 	true,
+		// 	func() DataFrame {
+		// 		newDf, err := ReadCsv("./testfiles/nba.csv", []string{"Team"})
+		// 		if err != nil {
+		// 			t.Error(err)
+		// 		}
+		// 		return *newDf
+		// 	}(),
+		// },
+	}
+	for _, test := range sortByIndexTests {
+		test.arg1.Print()
+		err := test.arg1.SortByIndex(test.arg2)
+		test.arg1.Print()
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameSortByValues(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.SortByValues("Name", true)
+	}
+}
+
+func TestDataFrameSortByValues(t *testing.T) {
+	type sortByValuesTest struct {
+		arg1     DataFrame
+		arg2     string
+		arg3     bool
+		expected DataFrame
+	}
+	sortByValuesTests := []sortByValuesTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Bradley", "Candice", "Avery"}, {27.0, 22.0, 19.0}, {"Male", "Female", "Male"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			"Age",
+			true,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Candice", "Bradley"},
+						IndexData{
+							[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19.0, 22.0, 27.0},
+						IndexData{
+							[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Female", "Male"},
+						IndexData{
+							[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+		},
+	}
+
+	for _, test := range sortByValuesTests {
+		test.arg1.Print()
+		err := test.arg1.SortByValues(test.arg2, test.arg3)
+		test.arg1.Print()
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameSortByValuesStable(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.SortByValuesStable("Team", true)
+	}
+}
+
+func TestDataFrameSortByValuesStable(t *testing.T) {
+	type sortByValuesStableTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          bool
+		expected      DataFrame
+		expectedError error
+	}
+	sortByValuesStableTests := []sortByValuesStableTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 9, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			"Score",
+			true,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Candice", "Fiona", "Bradley", "Ethan", "George", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Red", "Red", "Blue", "Blue", "Red", "Green", "Blue"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{9, 9, 9, 10, 10, 10, NA},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 9, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			"Score",
+			false,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Bradley", "Ethan", "George", "Avery", "Candice", "Fiona", "Diana"},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Blue", "Red", "Green", "Red", "Red", "Blue", "Blue"},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{10, 10, 10, 9, 9, 9, NA},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"Bradley"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 9, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			"Height",
+			true,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 9, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+
+	for _, test := range sortByValuesStableTests {
+		err := test.arg1.SortByValuesStable(test.arg2, test.arg3)
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+		}
+	}
+}
+
+func TestDataFrameSortByValuesStableRepeated(t *testing.T) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	// sorting by the secondary key first and then the primary key should sort by both.
+	if err := testDf.SortByValuesStable("Name", true); err != nil {
+		t.Error(err)
+	}
+	if err := testDf.SortByValuesStable("Team", true); err != nil {
+		t.Error(err)
+	}
+
+	items, err := testDf.LocColsItems("Team", "Name")
+	if err != nil {
+		t.Error(err)
+	}
+	for i := 1; i < len(items[0]); i++ {
+		teamOrder := compareValues(items[0][i-1], items[0][i])
+		if teamOrder > 0 || teamOrder == 0 && compareValues(items[1][i-1], items[1][i]) > 0 {
+			t.Fatalf("rows are not sorted by Team and Name: %v %v before %v %v", items[0][i-1], items[1][i-1], items[0][i], items[1][i])
+		}
+	}
+}
+
+func BenchmarkDataFrameSortByColumnsValues(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.SortByColumnsValues([]string{"Team", "Salary"}, []bool{true, false})
+	}
+}
+
+func TestDataFrameSortByColumnsValues(t *testing.T) {
+	type sortByColumnsValuesTest struct {
+		arg1          DataFrame
+		arg2          []string
+		arg3          []bool
+		expected      DataFrame
+		expectedError error
+	}
+	sortByColumnsValuesTests := []sortByColumnsValuesTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 7, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Team", "Score"},
+			[]bool{true, false},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Bradley", "Fiona", "Diana", "George", "Ethan", "Avery", "Candice"},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}, {6, []interface{}{"George"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Blue", "Blue", "Blue", "Green", "Red", "Red", "Red"},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}, {6, []interface{}{"George"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{10, 9, NA, 10, 10, 9, 7},
+						IndexData{
+							[]Index{{1, []interface{}{"Bradley"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}, {6, []interface{}{"George"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{1, []interface{}{"Bradley"}}, {5, []interface{}{"Fiona"}}, {3, []interface{}{"Diana"}}, {6, []interface{}{"George"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 7, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Score", "Team"},
+			[]bool{true, false},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Candice", "Avery", "Fiona", "Ethan", "George", "Bradley", "Diana"},
+						IndexData{
+							[]Index{{2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {5, []interface{}{"Fiona"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {1, []interface{}{"Bradley"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Red", "Red", "Blue", "Red", "Green", "Blue", "Blue"},
+						IndexData{
+							[]Index{{2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {5, []interface{}{"Fiona"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {1, []interface{}{"Bradley"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{7, 9, 9, 10, 10, 10, NA},
+						IndexData{
+							[]Index{{2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {5, []interface{}{"Fiona"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {1, []interface{}{"Bradley"}}, {3, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {5, []interface{}{"Fiona"}}, {4, []interface{}{"Ethan"}}, {6, []interface{}{"George"}}, {1, []interface{}{"Bradley"}}, {3, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 7, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Team", "Score"},
+			[]bool{true},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 7, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			fmt.Errorf("length of by (2) and ascending (1) does not match"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 7, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			[]string{"Team", "Height"},
+			[]bool{true, true},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George"}, {"Red", "Blue", "Red", "Blue", "Red", "Blue", "Green"}, {9, 10, 7, NA, 10, 9, 10}}, []string{"Name", "Team", "Score"}, []string{"Name"}),
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+
+	for _, test := range sortByColumnsValuesTests {
+		err := test.arg1.SortByColumnsValues(test.arg2, test.arg3)
+		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameDropColAt(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.DropColAt(3)
+	}
+}
+
+func TestDataFrameDropColAt(t *testing.T) {
+	type dropColAtTest struct {
+		arg1          DataFrame
+		arg2          int
+		expected      DataFrame
+		expectedError error
+	}
+	dropColAtTests := []dropColAtTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {1.5, 2.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			1,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{1.5, 2.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {1.5, 2.5}, {3, 4}}, []string{"Name", "Score", "Score"}, []string{"Name"}),
+			2,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{1.5, 2.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {1.5, 2.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			0,
+			DataFrame{},
+			fmt.Errorf("cannot drop index column 'Name'"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {1.5, 2.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			3,
+			DataFrame{},
+			fmt.Errorf("column index out of bounds: 3"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {1.5, 2.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			-1,
+			DataFrame{},
+			fmt.Errorf("column index out of bounds: -1"),
+		},
+	}
+
+	for _, test := range dropColAtTests {
+		output, err := test.arg1.DropColAt(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameDropNaN(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.DropNaN(0)
 	}
 }
 
-func TestDataFrameLoc(t *testing.T) {
-	type dataframeLocTest struct {
+func TestDataFrameDropNaN(t *testing.T) {
+	type dropNaNTest struct {
 		arg1     DataFrame
-		arg2     []string
-		arg3     [][]interface{}
+		arg2     int
 		expected DataFrame
 	}
-	dataframeLocTests := []dataframeLocTest{
+	dropNaNTests := []dropNaNTest{
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdropnan1.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery Bradley", "Jae Crowder", "R.J. Hunter"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Boston Celtics", "Boston Celtics", "Boston Celtics"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{0.0, 99.0, 28.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Number",
+						"float64",
+					},
+					{
+						[]interface{}{"PG", "SF", "SG"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Position",
+						"string",
+					},
+					{
+						[]interface{}{25.0, 25.0, 22.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"6-2", "6-6", "6-5"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Height",
+						"string",
+					},
+					{
+						[]interface{}{180.0, 235.0, 185.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Weight",
+						"float64",
+					},
+					{
+						[]interface{}{"Texas", "Marquette", "Georgia State"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"College",
+						"string",
+					},
+					{
+						[]interface{}{7730337.0, 6796117.0, 1148640.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Salary",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
+			},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdropnan2.csv", []string{"Name"})
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			1,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery Bradley", "Jae Crowder", "John Holland", "R.J. Hunter"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Boston Celtics", "Boston Celtics", "Boston Celtics", "Boston Celtics"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{"PG", "SF", "SG", "SG"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Position",
+						"string",
+					},
+					{
+						[]interface{}{25.0, 25.0, 27.0, 22.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"float64",
+					},
+					{
+						[]interface{}{"6-2", "6-6", "6-5", "6-5"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Height",
+						"string",
+					},
+					{
+						[]interface{}{180.0, 235.0, 205.0, 185.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Weight",
+						"float64",
+					},
+					{
+						[]interface{}{"Texas", "Marquette", "Boston University", "Georgia State"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"College",
+						"string",
+					},
+					{
+						[]interface{}{7730337.0, 6796117.0, 5000000.0, 1148640.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+							[]string{"Name"},
+						},
+						"Salary",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Position", "Age", "Height", "Weight", "College", "Salary"},
+			},
+		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -1878,76 +9572,139 @@ func TestDataFrameLoc(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			[]string{"Age"},
-			[][]interface{}{{"Bradley"}},
+			}([][]interface{}{{"a", "b", "c", "d"}, {3, "", 1, 7}}, []string{"Key", "Count"}, []string{"Key"}),
+			0,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{27},
+						[]interface{}{"a", "c", "d"},
 						IndexData{
-							[]Index{{1, []interface{}{"Bradley"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"a"}}, {2, []interface{}{"c"}}, {3, []interface{}{"d"}}},
+							[]string{"Key"},
 						},
-						"Age",
+						"Key",
+						"string",
+					},
+					{
+						[]interface{}{3, 1, 7},
+						IndexData{
+							[]Index{{0, []interface{}{"a"}}, {2, []interface{}{"c"}}, {3, []interface{}{"d"}}},
+							[]string{"Key"},
+						},
+						"Count",
 						"int",
 					},
 				},
 				IndexData{
-					[]Index{{1, []interface{}{"Bradley"}}},
-					[]string{"Name"},
+					[]Index{{0, []interface{}{"a"}}, {2, []interface{}{"c"}}, {3, []interface{}{"d"}}},
+					[]string{"Key"},
 				},
-				[]string{"Age"},
+				[]string{"Key", "Count"},
 			},
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfloc1.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			[]string{"Age", "Name"},
-			[][]interface{}{{"John Holland"}},
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.5, 2.5, math.NaN()}, {"a", "b", "c"}, {true, false, true}}, []string{"Name", "Age", "Score", "Grade", "Active"}, []string{"Name"}),
+			1,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{27.0},
+						[]interface{}{"Avery", "Bradley", "Candice"},
 						IndexData{
-							[]Index{{2, []interface{}{"John Holland"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"Age",
-						"float64",
+						"Name",
+						"string",
 					},
 					{
-						[]interface{}{"John Holland"},
+						[]interface{}{"a", "b", "c"},
 						IndexData{
-							[]Index{{2, []interface{}{"John Holland"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"Name",
+						"Grade",
 						"string",
 					},
+					{
+						[]interface{}{true, false, true},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Active",
+						"bool",
+					},
 				},
 				IndexData{
-					[]Index{{2, []interface{}{"John Holland"}}},
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 					[]string{"Name"},
 				},
-				[]string{"Age", "Name"},
+				[]string{"Name", "Grade", "Active"},
+			},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"w", "x", "y", "z"}, {1.0, math.NaN(), 3.0, 4.0}, {1.0, math.NaN(), 3.0, 4.0}}, []string{"key", "a", "b"}, []string{"key"}),
+			0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"w", "y", "z"},
+						IndexData{
+							[]Index{{0, []interface{}{"w"}}, {2, []interface{}{"y"}}, {3, []interface{}{"z"}}},
+							[]string{"key"},
+						},
+						"key",
+						"string",
+					},
+					{
+						[]interface{}{1.0, 3.0, 4.0},
+						IndexData{
+							[]Index{{0, []interface{}{"w"}}, {2, []interface{}{"y"}}, {3, []interface{}{"z"}}},
+							[]string{"key"},
+						},
+						"a",
+						"float64",
+					},
+					{
+						[]interface{}{1.0, 3.0, 4.0},
+						IndexData{
+							[]Index{{0, []interface{}{"w"}}, {2, []interface{}{"y"}}, {3, []interface{}{"z"}}},
+							[]string{"key"},
+						},
+						"b",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"w"}}, {2, []interface{}{"y"}}, {3, []interface{}{"z"}}},
+					[]string{"key"},
+				},
+				[]string{"key", "a", "b"},
 			},
 		},
 	}
-	for _, test := range dataframeLocTests {
-		output, err := test.arg1.Loc(test.arg2, test.arg3...)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+
+	for _, test := range dropNaNTests {
+		output, err := test.arg1.DropNaN(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameColAdd(b *testing.B) {
+func BenchmarkDataFrameReplace(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -1955,19 +9712,19 @@ func BenchmarkDataFrameColAdd(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.ColAdd("Salary", rand.Float64())
+		testDf.Replace("Boston Celtics", "Celtics")
 	}
 }
 
-func TestDataFrameColAdd(t *testing.T) {
-	type colAddTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     float64
-		expected DataFrame
-	}
-
-	colAddTests := []colAddTest{
+func TestDataFrameReplace(t *testing.T) {
+	type replaceTest struct {
+		arg1          DataFrame
+		arg2          interface{}
+		arg3          interface{}
+		expected      DataFrame
+		expectedError error
+	}
+	replaceTests := []replaceTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -1975,10 +9732,169 @@ func TestDataFrameColAdd(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Name",
-			5.0,
-			DataFrame{},
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, -999, 22}, {-999.0, 7.5, 8.0}, {"Male", "unknown", "Female"}}, []string{"Name", "Age", "Score", "Sex"}, []string{"Name"}),
+			-999,
+			math.NaN(),
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, NA, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{math.NaN(), 7.5, 8.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "unknown", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score", "Sex"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, -999, 22}, {-999.0, 7.5, 8.0}, {"Male", "unknown", "Female"}}, []string{"Name", "Age", "Score", "Sex"}, []string{"Name"}),
+			-999,
+			0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 0, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{0.0, 7.5, 8.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "unknown", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score", "Sex"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, -999, 22}, {-999.0, 7.5, 8.0}, {"Male", "unknown", "Female"}}, []string{"Name", "Age", "Score", "Sex"}, []string{"Name"}),
+			"unknown",
+			"Male",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, -999, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{-999.0, 7.5, 8.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Age", "Score", "Sex"},
+			},
+			nil,
 		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
@@ -1987,9 +9903,9 @@ func TestDataFrameColAdd(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			5.0,
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, -999, 22}, {-999.0, 7.5, 8.0}, {"Male", "unknown", "Female"}}, []string{"Name", "Age", "Score", "Sex"}, []string{"Name"}),
+			-999,
+			"missing",
 			DataFrame{
 				[]Series{
 					{
@@ -2002,16 +9918,25 @@ func TestDataFrameColAdd(t *testing.T) {
 						"string",
 					},
 					{
-						[]interface{}{24.0, 32.0, 27.0},
+						[]interface{}{"19", "missing", "22"},
 						IndexData{
 							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
 						"Age",
-						"float64",
+						"string",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
+						[]interface{}{"missing", "7.5", "8"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"string",
+					},
+					{
+						[]interface{}{"Male", "unknown", "Female"},
 						IndexData{
 							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
@@ -2024,39 +9949,10 @@ func TestDataFrameColAdd(t *testing.T) {
 					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"Name", "Age", "Score", "Sex"},
 			},
+			nil,
 		},
-	}
-	for _, test := range colAddTests {
-		output, err := test.arg1.ColAdd(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
-		}
-	}
-}
-
-func BenchmarkDataFrameColSub(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		testDf.ColSub("Salary", rand.Float64())
-	}
-}
-
-func TestDataFrameColSub(t *testing.T) {
-	type colSubTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     float64
-		expected DataFrame
-	}
-
-	colSubTests := []colSubTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2064,10 +9960,11 @@ func TestDataFrameColSub(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Name",
-			5.0,
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, -999, 22}, {-999.0, 7.5, 8.0}, {"Male", "unknown", "Female"}}, []string{"Name", "Age", "Score", "Sex"}, []string{"Name"}),
+			"Male",
+			[]string{"M"},
 			DataFrame{},
+			fmt.Errorf("new value [M] is not a bool, int, float64, or string"),
 		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
@@ -2076,9 +9973,9 @@ func TestDataFrameColSub(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			5.0,
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, "", 22}, {math.NaN(), 7.5, 8.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			math.NaN(),
+			0,
 			DataFrame{
 				[]Series{
 					{
@@ -2091,41 +9988,82 @@ func TestDataFrameColSub(t *testing.T) {
 						"string",
 					},
 					{
-						[]interface{}{14.0, 22.0, 17.0},
+						[]interface{}{19, 0, 22},
 						IndexData{
 							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
 						"Age",
-						"float64",
+						"int",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
+						[]interface{}{0.0, 7.5, 8.0},
 						IndexData{
 							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"Sex",
-						"string",
+						"Score",
+						"float64",
 					},
 				},
 				IndexData{
 					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"Name", "Age", "Score"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{-999, 2, 3}, {-999, 5, 6}}, []string{"Id", "Value"}, []string{"Id"}),
+			-999,
+			0,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{-999, 2, 3},
+						IndexData{
+							[]Index{{0, []interface{}{-999}}, {1, []interface{}{2}}, {2, []interface{}{3}}},
+							[]string{"Id"},
+						},
+						"Id",
+						"int",
+					},
+					{
+						[]interface{}{0, 5, 6},
+						IndexData{
+							[]Index{{0, []interface{}{-999}}, {1, []interface{}{2}}, {2, []interface{}{3}}},
+							[]string{"Id"},
+						},
+						"Value",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{-999}}, {1, []interface{}{2}}, {2, []interface{}{3}}},
+					[]string{"Id"},
+				},
+				[]string{"Id", "Value"},
 			},
+			nil,
 		},
 	}
-	for _, test := range colSubTests {
-		output, err := test.arg1.ColSub(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+
+	for _, test := range replaceTests {
+		output, err := test.arg1.Replace(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameColMul(b *testing.B) {
+func BenchmarkDataFrameRemoveOutliers(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -2133,31 +10071,19 @@ func BenchmarkDataFrameColMul(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.ColMul("Salary", rand.Float64())
+		testDf.RemoveOutliers("Salary", 1.5)
 	}
 }
 
-func TestDataFrameColMul(t *testing.T) {
-	type colMulTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     float64
-		expected DataFrame
-	}
-
-	colMulTests := []colMulTest{
-		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Name",
-			5.0,
-			DataFrame{},
-		},
+func TestDataFrameRemoveOutliers(t *testing.T) {
+	type removeOutliersTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          float64
+		expected      DataFrame
+		expectedError error
+	}
+	removeOutliersTests := []removeOutliersTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2165,56 +10091,96 @@ func TestDataFrameColMul(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			2.0,
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George", "Hannah", "Isaac"}, {10.0, 12.0, 11.0, 13.0, 12.0, 100.0, -50.0, 11.0, math.NaN()}, {30, 25, 27, 40, 25, 33, 29, 31, 22}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			"Score",
+			1.5,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Hannah", "Isaac"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {7, []interface{}{"Hannah"}}, {8, []interface{}{"Isaac"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{38.0, 54.0, 44.0},
+						[]interface{}{10.0, 12.0, 11.0, 13.0, 12.0, 11.0, math.NaN()},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {7, []interface{}{"Hannah"}}, {8, []interface{}{"Isaac"}}},
 							[]string{"Name"},
 						},
-						"Age",
+						"Score",
 						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
+						[]interface{}{30, 25, 27, 40, 25, 31, 22},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {7, []interface{}{"Hannah"}}, {8, []interface{}{"Isaac"}}},
 							[]string{"Name"},
 						},
-						"Sex",
-						"string",
+						"Age",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {3, []interface{}{"Diana"}}, {4, []interface{}{"Ethan"}}, {7, []interface{}{"Hannah"}}, {8, []interface{}{"Isaac"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"Name", "Score", "Age"},
 			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George", "Hannah", "Isaac"}, {10.0, 12.0, 11.0, 13.0, 12.0, 100.0, -50.0, 11.0, math.NaN()}, {30, 25, 27, 40, 25, 33, 29, 31, 22}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			"Name",
+			1.5,
+			DataFrame{},
+			fmt.Errorf("cannot remove outliers from column 'Name', %w", ErrTypeMismatch),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George", "Hannah", "Isaac"}, {10.0, 12.0, 11.0, 13.0, 12.0, 100.0, -50.0, 11.0, math.NaN()}, {30, 25, 27, 40, 25, 33, 29, 31, 22}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			"Height",
+			1.5,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Height"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona", "George", "Hannah", "Isaac"}, {10.0, 12.0, 11.0, 13.0, 12.0, 100.0, -50.0, 11.0, math.NaN()}, {30, 25, 27, 40, 25, 33, 29, 31, 22}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			"Score",
+			-1,
+			DataFrame{},
+			fmt.Errorf("k cannot be negative, got -1"),
 		},
 	}
-	for _, test := range colMulTests {
-		output, err := test.arg1.ColMul(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+	for _, test := range removeOutliersTests {
+		output, err := test.arg1.RemoveOutliers(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameColDiv(b *testing.B) {
+func BenchmarkDataFrameDuplicated(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -2222,19 +10188,103 @@ func BenchmarkDataFrameColDiv(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.ColDiv("Salary", rand.Float64())
+		testDf.Duplicated([]string{"Team", "Position"}, "first")
 	}
 }
 
-func TestDataFrameColDiv(t *testing.T) {
-	type colDivTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     float64
-		expected DataFrame
-	}
-
-	colDivTests := []colDivTest{
+func TestDataFrameDuplicated(t *testing.T) {
+	type duplicatedTest struct {
+		arg1          DataFrame
+		arg2          []string
+		arg3          string
+		expected      Series
+		expectedError error
+	}
+	duplicatedTests := []duplicatedTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {"Red", "Blue", "Red", "Red", "Blue", "Green"}, {19, 25, 19, 19, 25, NA}}, []string{"Name", "Team", "Age"}, nil),
+			[]string{"Team", "Age"},
+			"first",
+			Series{
+				[]interface{}{false, false, true, true, true, false},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}, {5, []interface{}{5}}},
+					[]string{""},
+				},
+				"Duplicated",
+				"bool",
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {"Red", "Blue", "Red", "Red", "Blue", "Green"}, {19, 25, 19, 19, 25, NA}}, []string{"Name", "Team", "Age"}, nil),
+			[]string{"Team", "Age"},
+			"last",
+			Series{
+				[]interface{}{true, true, true, false, false, false},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}, {5, []interface{}{5}}},
+					[]string{""},
+				},
+				"Duplicated",
+				"bool",
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {"Red", "Blue", "Red", "Red", "Blue", "Green"}, {19, 25, 19, 19, 25, NA}}, []string{"Name", "Team", "Age"}, nil),
+			[]string{"Team", "Age"},
+			"none",
+			Series{
+				[]interface{}{true, true, true, true, true, false},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}, {5, []interface{}{5}}},
+					[]string{""},
+				},
+				"Duplicated",
+				"bool",
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {"Red", "Blue", "Red", "Red", "Blue", "Green"}, {19, 25, 19, 19, 25, NA}}, []string{"Name", "Team", "Age"}, nil),
+			nil,
+			"first",
+			Series{
+				[]interface{}{false, false, false, false, false, false},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}, {5, []interface{}{5}}},
+					[]string{""},
+				},
+				"Duplicated",
+				"bool",
+			},
+			nil,
+		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2242,10 +10292,11 @@ func TestDataFrameColDiv(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Name",
-			5.0,
-			DataFrame{},
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {"Red", "Blue", "Red", "Red", "Blue", "Green"}, {19, 25, 19, 19, 25, NA}}, []string{"Name", "Team", "Age"}, nil),
+			[]string{"Height"},
+			"first",
+			Series{},
+			fmt.Errorf("column does not exist: Height"),
 		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
@@ -2254,56 +10305,54 @@ func TestDataFrameColDiv(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			5.0,
-			DataFrame{
-				[]Series{
-					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Name",
-						"string",
-					},
-					{
-						[]interface{}{3.8, 5.4, 4.4},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Age",
-						"float64",
-					},
-					{
-						[]interface{}{"Male", "Male", "Female"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Sex",
-						"string",
-					},
-				},
-				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-					[]string{"Name"},
-				},
-				[]string{"Name", "Age", "Sex"},
-			},
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan", "Fiona"}, {"Red", "Blue", "Red", "Red", "Blue", "Green"}, {19, 25, 19, 19, 25, NA}}, []string{"Name", "Team", "Age"}, nil),
+			[]string{"Team"},
+			"middle",
+			Series{},
+			fmt.Errorf("keep must be \"first\", \"last\", or \"none\", got \"middle\""),
 		},
 	}
-	for _, test := range colDivTests {
-		output, err := test.arg1.ColDiv(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+
+	for _, test := range duplicatedTests {
+		output, err := test.arg1.Duplicated(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameColMod(b *testing.B) {
+func TestDataFrameDuplicatedCount(t *testing.T) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	mask, err := testDf.Duplicated([]string{"Team", "Position"}, "first")
+	if err != nil {
+		t.Error(err)
+	}
+
+	// the number of marked rows should match the number of rows that dropping duplicates would remove.
+	marked := 0
+	for _, data := range mask.data {
+		if data.(bool) {
+			marked++
+		}
+	}
+	items, err := testDf.LocColsItems("Team", "Position")
+	if err != nil {
+		t.Error(err)
+	}
+	unique := make(map[string]bool)
+	for i := range items[0] {
+		unique[fmt.Sprint(items[0][i], "|", items[1][i])] = true
+	}
+	if marked != len(items[0])-len(unique) {
+		t.Fatalf("expected %v duplicates, got %v", len(items[0])-len(unique), marked)
+	}
+}
+
+func BenchmarkDataFrameNlargest(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -2311,31 +10360,18 @@ func BenchmarkDataFrameColMod(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.ColMod("Salary", rand.Float64())
+		testDf.Nlargest(10, "Salary")
 	}
 }
 
-func TestDataFrameColMod(t *testing.T) {
-	type colModTest struct {
+func TestDataFrameNlargest(t *testing.T) {
+	type nlargestTest struct {
 		arg1     DataFrame
-		arg2     string
-		arg3     float64
+		arg2     int
+		arg3     string
 		expected DataFrame
 	}
-
-	colModTests := []colModTest{
-		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Name",
-			5.0,
-			DataFrame{},
-		},
+	nlargestTests := []nlargestTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2343,88 +10379,46 @@ func TestDataFrameColMod(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			5.0,
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, 9.0, 9.0, math.NaN(), 1.0}, {30, 25, 25, 40, 25}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			2,
+			"Score",
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{"Bradley", "Candice"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{4.0, 2.0, 2.0},
+						[]interface{}{9.0, 9.0},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"Age",
+						"Score",
 						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
+						[]interface{}{25, 25},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 							[]string{"Name"},
 						},
-						"Sex",
-						"string",
+						"Age",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"Name", "Score", "Age"},
 			},
 		},
-	}
-	for _, test := range colModTests {
-		output, err := test.arg1.ColMod(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
-		}
-	}
-}
-
-func BenchmarkDataFrameColGt(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		testDf.ColGt("Salary", rand.Float64())
-	}
-}
-
-func TestDataFrameColGt(t *testing.T) {
-	type colGtTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     float64
-		expected DataFrame
-	}
-
-	colGtTests := []colGtTest{
-		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Name",
-			5.0,
-			DataFrame{},
-		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2432,88 +10426,46 @@ func TestDataFrameColGt(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			25.0,
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, 9.0, 9.0, math.NaN(), 1.0}, {30, 25, 25, 40, 25}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			10,
+			"Score",
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{"Bradley", "Candice", "Avery", "Ethan"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {4, []interface{}{"Ethan"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{false, true, false},
+						[]interface{}{9.0, 9.0, 3.0, 1.0},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {4, []interface{}{"Ethan"}}},
 							[]string{"Name"},
 						},
-						"Age",
-						"bool",
+						"Score",
+						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
+						[]interface{}{25, 25, 30, 25},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {4, []interface{}{"Ethan"}}},
 							[]string{"Name"},
 						},
-						"Sex",
-						"string",
+						"Age",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {0, []interface{}{"Avery"}}, {4, []interface{}{"Ethan"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"Name", "Score", "Age"},
 			},
 		},
-	}
-	for _, test := range colGtTests {
-		output, err := test.arg1.ColGt(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
-		}
-	}
-}
-
-func BenchmarkDataFrameColLt(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		testDf.ColLt("Salary", rand.Float64())
-	}
-}
-
-func TestDataFrameColLt(t *testing.T) {
-	type colLtTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     float64
-		expected DataFrame
-	}
-
-	colLtTests := []colLtTest{
-		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Name",
-			5.0,
-			DataFrame{},
-		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2521,76 +10473,46 @@ func TestDataFrameColLt(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			22.0,
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, 9.0, 9.0, math.NaN(), 1.0}, {30, 25, 25, 40, 25}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			0,
+			"Score",
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{true, false, false},
+						[]interface{}{},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Age",
-						"bool",
+						"Score",
+						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
+						[]interface{}{},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{},
 							[]string{"Name"},
 						},
-						"Sex",
-						"string",
-					},
-				},
-				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-					[]string{"Name"},
-				},
-				[]string{"Name", "Age", "Sex"},
-			},
-		},
-	}
-	for _, test := range colLtTests {
-		output, err := test.arg1.ColLt(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
-		}
-	}
-}
-
-func BenchmarkDataFrameColEq(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		testDf.ColEq("Salary", rand.Float64())
-	}
-}
-
-func TestDataFrameColEq(t *testing.T) {
-	type colEqTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     float64
-		expected DataFrame
-	}
-
-	colEqTests := []colEqTest{
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Score", "Age"},
+			},
+		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2598,9 +10520,9 @@ func TestDataFrameColEq(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, 9.0, 9.0, math.NaN(), 1.0}, {30, 25, 25, 40, 25}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			2,
 			"Name",
-			5.0,
 			DataFrame{},
 		},
 		{
@@ -2610,79 +10532,67 @@ func TestDataFrameColEq(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {30, "", 25, 40, 25}}, []string{"Name", "Age"}, []string{"Name"}),
+			2,
 			"Age",
-			19.0,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{"Diana", "Avery"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{3, []interface{}{"Diana"}}, {0, []interface{}{"Avery"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{true, false, false},
+						[]interface{}{40, 30},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{3, []interface{}{"Diana"}}, {0, []interface{}{"Avery"}}},
 							[]string{"Name"},
 						},
 						"Age",
-						"bool",
-					},
-					{
-						[]interface{}{"Male", "Male", "Female"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Sex",
-						"string",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]Index{{3, []interface{}{"Diana"}}, {0, []interface{}{"Avery"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"Name", "Age"},
 			},
 		},
 	}
-	for _, test := range colEqTests {
-		output, err := test.arg1.ColEq(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+
+	for _, test := range nlargestTests {
+		output, err := test.arg1.Nlargest(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs(), cmpopts.EquateEmpty()) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameNewCol(b *testing.B) {
+func BenchmarkDataFrameNsmallest(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
 	}
-	data := make([]interface{}, len(testDf.series[0].data))
-	for i := 0; i < len(testDf.series[0].data); i++ {
-		data[i] = rand.Float64()
-	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.NewCol("New Column", data)
+		testDf.Nsmallest(10, "Salary")
 	}
 }
 
-func TestDataFrameNewCol(t *testing.T) {
-	type newColTest struct {
+func TestDataFrameNsmallest(t *testing.T) {
+	type nsmallestTest struct {
 		arg1     DataFrame
-		arg2     string
-		arg3     []interface{}
+		arg2     int
+		arg3     string
 		expected DataFrame
 	}
-	newColTests := []newColTest{
+	nsmallestTests := []nsmallestTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2690,53 +10600,44 @@ func TestDataFrameNewCol(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Nationality",
-			[]interface{}{"USA", "UK", "Canada"},
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, 9.0, 9.0, math.NaN(), 1.0}, {30, 25, 25, 40, 25}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			2,
+			"Score",
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{"Ethan", "Avery"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{19.0, 27.0, 22.0},
+						[]interface{}{1.0, 3.0},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}},
 							[]string{"Name"},
 						},
-						"Age",
+						"Score",
 						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Sex",
-						"string",
-					},
-					{
-						[]interface{}{"USA", "UK", "Canada"},
+						[]interface{}{25, 30},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}},
 							[]string{"Name"},
 						},
-						"Nationality",
-						"string",
+						"Age",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]Index{{4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex", "Nationality"},
+				[]string{"Name", "Score", "Age"},
 			},
 		},
 		{
@@ -2746,84 +10647,46 @@ func TestDataFrameNewCol(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age+5",
-			[]interface{}{"", "", ""},
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, 9.0, 9.0, math.NaN(), 1.0}, {30, 25, 25, 40, 25}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			3,
+			"Age",
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{"Bradley", "Candice", "Ethan"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{19.0, 27.0, 22.0},
+						[]interface{}{9.0, 9.0, 1.0},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}},
 							[]string{"Name"},
 						},
-						"Age",
+						"Score",
 						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Sex",
-						"string",
-					},
-					{
-						[]interface{}{math.NaN(), math.NaN(), math.NaN()},
+						[]interface{}{25, 25, 25},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}},
 							[]string{"Name"},
 						},
-						"Age+5",
-						"string",
+						"Age",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex", "Age+5"},
+				[]string{"Name", "Score", "Age"},
 			},
 		},
-	}
-	for _, test := range newColTests {
-		output, err := test.arg1.NewCol(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
-		}
-	}
-}
-
-func BenchmarkDataFrameNewDerivedCol(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		testDf.NewDerivedCol("New Column", "Salary")
-	}
-}
-
-func TestDataFrameNewDerivedCol(t *testing.T) {
-	type newDerivedColTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     string
-		expected DataFrame
-	}
-	newDerivedColTests := []newDerivedColTest{
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2831,77 +10694,57 @@ func TestDataFrameNewDerivedCol(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"NewAge",
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana", "Ethan"}, {3.0, 9.0, 9.0, math.NaN(), 1.0}, {30, 25, 25, 40, 25}}, []string{"Name", "Score", "Age"}, []string{"Name"}),
+			10,
 			"Age",
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{"Bradley", "Candice", "Ethan", "Avery", "Diana"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {3, []interface{}{"Diana"}}},
 							[]string{"Name"},
 						},
 						"Name",
 						"string",
 					},
 					{
-						[]interface{}{19.0, 27.0, 22.0},
+						[]interface{}{9.0, 9.0, 1.0, 3.0, math.NaN()},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {3, []interface{}{"Diana"}}},
 							[]string{"Name"},
 						},
-						"Age",
+						"Score",
 						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Sex",
-						"string",
-					},
-					{
-						[]interface{}{19.0, 27.0, 22.0},
+						[]interface{}{25, 25, 25, 30, 40},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {3, []interface{}{"Diana"}}},
 							[]string{"Name"},
 						},
-						"NewAge",
-						"float64",
+						"Age",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]Index{{1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}, {4, []interface{}{"Ethan"}}, {0, []interface{}{"Avery"}}, {3, []interface{}{"Diana"}}},
 					[]string{"Name"},
 				},
-				[]string{"Name", "Age", "Sex", "NewAge"},
+				[]string{"Name", "Score", "Age"},
 			},
 		},
-		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"NewCol",
-			"Doesn't Exist",
-			DataFrame{},
-		},
 	}
-	for _, test := range newDerivedColTests {
-		output, err := test.arg1.NewDerivedCol(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
+
+	for _, test := range nsmallestTests {
+		output, err := test.arg1.Nsmallest(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || (!cmp.Equal(output, DataFrame{}, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) && err != nil) {
 			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameRenameCol(b *testing.B) {
+func BenchmarkDataFrameIdxmax(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -2909,51 +10752,103 @@ func BenchmarkDataFrameRenameCol(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.RenameCol(map[string]string{"Name": "New Name", "Age": "New Age"})
+		testDf.Idxmax("Salary")
 	}
 }
 
-func TestDataFrameRenameCol(t *testing.T) {
-	type renameColTest struct {
-		arg1     DataFrame
-		arg2     map[string]string
-		expected DataFrame
-	}
-	renameColTests := []renameColTest{
-		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			map[string]string{"Name": "Names"},
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Names", "Age", "Sex"}, []string{"Names"}),
-		},
-		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			map[string]string{"Age": "HowOld"},
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "HowOld", "Sex"}, []string{"Name"}),
+func TestDataFrameIdxmax(t *testing.T) {
+	testDf, err := NewDataFrame(
+		[][]interface{}{
+			{"Avery", "Bradley", "Candice", "Diana"},
+			{25.5, math.NaN(), 31.0, 19.0},
+			{7, 12, 3, 12},
+			{math.NaN(), math.NaN(), math.NaN(), math.NaN()},
+			{"guard", "center", "forward", "guard"},
 		},
+		[]string{"Name", "Points", "Rebounds", "Steals", "Position"},
+		[]string{"Name"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type idxmaxTest struct {
+		arg1          string
+		expectedMax   []interface{}
+		expectedMin   []interface{}
+		expectedError error
+	}
+	idxmaxTests := []idxmaxTest{
+		{"Points", []interface{}{"Candice"}, []interface{}{"Diana"}, nil},
+		{"Rebounds", []interface{}{"Bradley"}, []interface{}{"Candice"}, nil},
+		{"Steals", nil, nil, fmt.Errorf("column 'Steals' has no values other than NaN")},
+		{"Position", nil, nil, fmt.Errorf("column %w", ErrTypeMismatch)},
+		{"Assists", nil, nil, fmt.Errorf("%w: Assists", ErrColumnNotFound)},
+	}
+
+	for _, test := range idxmaxTests {
+		outputMax, err := testDf.Idxmax(test.arg1)
+		if !cmp.Equal(outputMax, test.expectedMax) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expectedMax, outputMax, err)
+		}
+		outputMin, err := testDf.Idxmin(test.arg1)
+		if !cmp.Equal(outputMin, test.expectedMin) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expectedMin, outputMin, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameSampleWeighted(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.SampleWeighted(100, "Weight", int64(i))
+	}
+}
+
+func TestDataFrameSampleWeighted(t *testing.T) {
+	testDf := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {1, 1, 98, 0}, {"a", "b", "c", "d"}}, []string{"Name", "Weight", "Team"}, []string{"Name"})
+
+	output, err := testDf.SampleWeighted(1000, "Weight", 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Len() != 1000 || !cmp.Equal(output.columns, testDf.columns) {
+		t.Fatalf("expected 1000 rows with columns %v, got %v rows with columns %v", testDf.columns, output.Len(), output.columns)
+	}
+	counts := make(map[interface{}]int)
+	for _, index := range output.index.index {
+		counts[index.value[0]]++
+	}
+	if counts["Candice"] < 900 || counts["Diana"] != 0 {
+		t.Fatalf("expected the row of weight 98 to dominate and the row of weight 0 to never be drawn, got %v", counts)
+	}
+
+	again, err := testDf.SampleWeighted(1000, "Weight", 42)
+	if !cmp.Equal(output, again, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+		t.Fatalf("expected the same seed to draw the same rows, error %v", err)
+	}
+
+	type sampleWeightedErrorTest struct {
+		arg1          DataFrame
+		arg2          int
+		arg3          string
+		expectedError error
+	}
+	sampleWeightedErrorTests := []sampleWeightedErrorTest{
+		{testDf, -1, "Weight", fmt.Errorf("n cannot be negative, got -1")},
+		{testDf, 10, "Height", fmt.Errorf("%w: Height", ErrColumnNotFound)},
+		{testDf, 10, "Team", fmt.Errorf("cannot sample, weight column %w", ErrTypeMismatch)},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
 				newDf, err := NewDataFrame(data, columns, indexCols)
@@ -2961,15 +10856,10 @@ func TestDataFrameRenameCol(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name", "Sex"}),
-			map[string]string{"Name": "Names"},
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Names", "Age", "Sex"}, []string{"Names", "Sex"}),
+			}([][]interface{}{{"Avery", "Bradley"}, {1.5, -0.5}}, []string{"Name", "Weight"}, []string{"Name"}),
+			10,
+			"Weight",
+			fmt.Errorf("weights cannot be negative, got -0.5 at position 1"),
 		},
 		{
 			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
@@ -2978,186 +10868,460 @@ func TestDataFrameRenameCol(t *testing.T) {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name", "Sex"}),
-			map[string]string{"Names": "Name"},
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name", "Sex"}),
+			}([][]interface{}{{"Avery", "Bradley"}, {0, NA}}, []string{"Name", "Weight"}, []string{"Name"}),
+			10,
+			"Weight",
+			fmt.Errorf("weights must not all be zero"),
 		},
 	}
-
-	for _, test := range renameColTests {
-		err := test.arg1.RenameCol(test.arg2)
-		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
-			if fmt.Sprint(err)[:22] == "column does not exist:" || fmt.Sprint(err)[:21] == "index does not exist:" {
-				continue
-			}
-			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+	for _, test := range sampleWeightedErrorTests {
+		_, err := test.arg1.SampleWeighted(test.arg2, test.arg3, 42)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameMergeDfsHorizontally(b *testing.B) {
-	srcDf, err := ReadCsv("testfiles/mergeDfsHorizontally/1src.csv", []string{"Name"})
+func BenchmarkDataFrameTrainTestSplit(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
 	}
-	targetDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1target.csv", []string{"Name"})
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.TrainTestSplit(0.2, "Position", int64(i))
+	}
+}
+
+func TestDataFrameTrainTestSplit(t *testing.T) {
+	ids := make([]interface{}, 100)
+	classes := make([]interface{}, 100)
+	for i := range ids {
+		ids[i] = i
+		classes[i] = "a"
+		if i%5 == 0 {
+			classes[i] = "b"
+		}
+	}
+	testDf, err := NewDataFrame([][]interface{}{ids, classes}, []string{"Id", "Class"}, []string{"Id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type trainTestSplitTest struct {
+		arg1              float64
+		arg2              string
+		expectedTrainLen  int
+		expectedTestLen   int
+		expectedTestCount map[interface{}]int
+	}
+	trainTestSplitTests := []trainTestSplitTest{
+		{0.25, "Class", 75, 25, map[interface{}]int{"a": 20, "b": 5}},
+		{0.5, "Class", 50, 50, map[interface{}]int{"a": 40, "b": 10}},
+		{0.25, "", 75, 25, nil},
+	}
+
+	for _, test := range trainTestSplitTests {
+		train, testSet, err := testDf.TrainTestSplit(test.arg1, test.arg2, 42)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if train.Len() != test.expectedTrainLen || testSet.Len() != test.expectedTestLen {
+			t.Fatalf("expected %v train and %v test rows, got %v and %v", test.expectedTrainLen, test.expectedTestLen, train.Len(), testSet.Len())
+		}
+
+		// every row should end up in exactly one of the sets, in the original order
+		seen := make(map[interface{}]bool)
+		for _, df := range []DataFrame{train, testSet} {
+			for i, index := range df.index.index {
+				if seen[index.value[0]] || i > 0 && index.id < df.index.index[i-1].id {
+					t.Fatalf("expected each row once and in order, got %v", df.index.index)
+				}
+				seen[index.value[0]] = true
+			}
+		}
+		if len(seen) != testDf.Len() {
+			t.Fatalf("expected %v rows in total, got %v", testDf.Len(), len(seen))
+		}
+
+		if test.expectedTestCount != nil {
+			testCount := make(map[interface{}]int)
+			for _, class := range testSet.series[1].data {
+				testCount[class]++
+			}
+			if !cmp.Equal(testCount, test.expectedTestCount) {
+				t.Fatalf("expected class counts %v in the test set, got %v", test.expectedTestCount, testCount)
+			}
+		}
+
+		again, _, err := testDf.TrainTestSplit(test.arg1, test.arg2, 42)
+		if !cmp.Equal(train, again, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+			t.Fatalf("expected the same seed to give the same split, error %v", err)
+		}
+	}
+
+	type trainTestSplitErrorTest struct {
+		arg1          float64
+		arg2          string
+		expectedError error
+	}
+	trainTestSplitErrorTests := []trainTestSplitErrorTest{
+		{0, "Class", fmt.Errorf("testFrac must be between 0 and 1, got 0")},
+		{1, "", fmt.Errorf("testFrac must be between 0 and 1, got 1")},
+		{0.25, "Team", fmt.Errorf("%w: Team", ErrColumnNotFound)},
+	}
+	for _, test := range trainTestSplitErrorTests {
+		_, _, err := testDf.TrainTestSplit(test.arg1, test.arg2, 42)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameExplode(b *testing.B) {
+	tags := make([]interface{}, 1000)
+	for i := range tags {
+		tags[i] = []interface{}{"a", "b", "c"}
+	}
+	testDf, err := NewDataFrame([][]interface{}{tags}, []string{"Tags"}, nil)
 	if err != nil {
 		b.Error(err)
 	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		srcDf.MergeDfsHorizontally(targetDf)
+		testDf.Explode("Tags")
 	}
 }
 
-func TestDataFrameMergeDfsHorizontally(t *testing.T) {
-	type mergeDfsHorizontallyTest struct {
-		arg1     DataFrame
-		arg2     DataFrame
-		expected DataFrame
-	}
-	mergeDfsHorizontallyTests := []mergeDfsHorizontallyTest{
+func TestDataFrameExplode(t *testing.T) {
+	type explodeTest struct {
+		arg1          DataFrame
+		arg2          string
+		expected      DataFrame
+		expectedError error
+	}
+	explodeTests := []explodeTest{
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1src.csv", []string{"Name"})
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}(),
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1target.csv", []string{"Sex"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/1exp.csv", nil)
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {[]interface{}{"guard", "captain"}, []interface{}{"center"}, []interface{}{}, []interface{}{"forward"}}}, []string{"Name", "Tags"}, []string{"Name"}),
+			"Tags",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Avery", "Bradley", "Candice", "Diana"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Avery"}}, {2, []interface{}{"Bradley"}}, {3, []interface{}{"Candice"}}, {4, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"guard", "captain", "center", math.NaN(), "forward"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Avery"}}, {2, []interface{}{"Bradley"}}, {3, []interface{}{"Candice"}}, {4, []interface{}{"Diana"}}},
+							[]string{"Name"},
+						},
+						"Tags",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Avery"}}, {2, []interface{}{"Bradley"}}, {3, []interface{}{"Candice"}}, {4, []interface{}{"Diana"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Tags"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
+			}([][]interface{}{{[]interface{}{1, 2, 3}, []interface{}{4}}, {"x", "y"}}, []string{"Scores", "Group"}, nil),
+			"Scores",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{1, 2, 3, 4},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}, {2, []interface{}{0}}, {3, []interface{}{1}}},
+							[]string{""},
+						},
+						"Scores",
+						"int",
+					},
+					{
+						[]interface{}{"x", "x", "x", "y"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}, {2, []interface{}{0}}, {3, []interface{}{1}}},
+							[]string{""},
+						},
+						"Group",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}, {2, []interface{}{0}}, {3, []interface{}{1}}},
+					[]string{""},
+				},
+				[]string{"Scores", "Group"},
+			},
+			nil,
 		},
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/2src.csv", []string{"Name"})
+				newDf, err := ReadJsonByColumns("testfiles/readjsonbycolumns/5.json", nil)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/2target.csv", []string{"Height"})
+			"Scores",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}, {2, []interface{}{1}}, {3, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{10.0, 12.0, math.NaN(), 7.0},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}, {2, []interface{}{1}}, {3, []interface{}{2}}},
+							[]string{""},
+						},
+						"Scores",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}, {2, []interface{}{1}}, {3, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Scores"},
+			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsHorizontally/2exp.csv", nil)
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {[]interface{}{"guard", "captain"}, []interface{}{"center"}, []interface{}{}, []interface{}{"forward"}}}, []string{"Name", "Tags"}, []string{"Name"}),
+			"Name",
+			DataFrame{},
+			fmt.Errorf("cannot explode index column 'Name'"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
+			}([][]interface{}{{"Avery", "Bradley", "Candice", "Diana"}, {[]interface{}{"guard", "captain"}, []interface{}{"center"}, []interface{}{}, []interface{}{"forward"}}}, []string{"Name", "Tags"}, []string{"Name"}),
+			"Labels",
+			DataFrame{},
+			fmt.Errorf("%w: Labels", ErrColumnNotFound),
 		},
 	}
-	for _, test := range mergeDfsHorizontallyTests {
-		output, err := test.arg1.MergeDfsHorizontally(test.arg2)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
-			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+
+	for _, test := range explodeTests {
+		output, err := test.arg1.Explode(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameMergeDfsVertically(b *testing.B) {
-	srcDf, err := ReadCsv("testfiles/mergeDfsVertically/1src.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	targetDf, err := ReadCsv("./testfiles/mergeDfsVertically/1target.csv", []string{"Name"})
+func BenchmarkDataFramePivot(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
 	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		srcDf.MergeDfsVertically(targetDf)
+		testDf.Pivot("Sex", "Height")
 	}
 }
 
-func TestDataFrameMergeDfsVertically(t *testing.T) {
-	type mergeDfsVerticallyTest struct {
-		arg1     DataFrame
-		arg2     DataFrame
-		expected DataFrame
+func TestDataFramePivot(t *testing.T) {
+	type pivotTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          string
+		expected      DataFrame
+		expectedError error
 	}
-	mergeDfsVerticallyTests := []mergeDfsVerticallyTest{
+	pivotTests := []pivotTest{
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/1src.csv", []string{"Name"})
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}(),
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/1target.csv", []string{"Name"})
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}(),
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/1exp.csv", []string{"Name"})
+				newDf, err := ReadCsv("./testfiles/testdfpivot1.csv", []string{"Name"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
+			"Sex",
+			"Height",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{172, 180, NA},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Male",
+						"int",
+					},
+					{
+						[]interface{}{NA, NA, 165},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Female",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Male", "Female"},
+			},
+			nil,
 		},
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/2src.csv", []string{"Name"})
+				newDf, err := ReadCsv("./testfiles/testdfpivot2.csv", []string{"Time"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
+			"Fruit",
+			"Color",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Red", math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
+							[]string{"Time"},
+						},
+						"Apple",
+						"string",
+					},
+					{
+						[]interface{}{"Yellow", "Yellow"},
+						IndexData{
+							[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
+							[]string{"Time"},
+						},
+						"Banana",
+						"string",
+					},
+					{
+						[]interface{}{math.NaN(), "Red"},
+						IndexData{
+							[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
+							[]string{"Time"},
+						},
+						"Cherry",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
+					[]string{"Time"},
+				},
+				[]string{"Apple", "Banana", "Cherry"},
+			},
+			nil,
+		},
+		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/2target.csv", []string{"Name"})
+				newDf, err := ReadCsv("./testfiles/testdfpivot3.csv", []string{"Name"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
+			"Sex",
+			"Height",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{NA, 180, NA},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Male",
+						"int",
+					},
+					{
+						[]interface{}{NA, NA, 165},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Female",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Male", "Female"},
+			},
+			nil,
+		},
+		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/mergeDfsVertically/2exp.csv", []string{"Name"})
+				newDf, err := ReadCsv("./testfiles/testdfpivot4.csv", []string{"Time"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
 			}(),
+			"Fruit",
+			"Color",
+			DataFrame{},
+			fmt.Errorf("index [12:00] has more than one value for column 'Apple', use PivotTable with an aggregation function instead"),
 		},
 	}
-	for _, test := range mergeDfsVerticallyTests {
-		output, err := test.arg1.MergeDfsVertically(test.arg2)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
-			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+
+	for _, test := range pivotTests {
+		output, err := test.arg1.Pivot(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameSortByIndex(b *testing.B) {
+func BenchmarkDataFramePivotTable(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -3165,506 +11329,794 @@ func BenchmarkDataFrameSortByIndex(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.SortByIndex(true)
+		testDf.PivotTable("Team", "Height", "Salary", Mean)
 	}
 }
 
-func TestDataFrameSortByIndex(t *testing.T) {
-	type sortByIndexTest struct {
+func TestDataFramePivotTable(t *testing.T) {
+	type pivotTableTest struct {
 		arg1     DataFrame
-		arg2     bool
+		arg2     string
+		arg3     string
+		arg4     string
+		arg5     StatsFunc
 		expected DataFrame
 	}
-	sortByIndexTests := []sortByIndexTest{
+	pivotTableTests := []pivotTableTest{
 		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/testdfpivottable1.csv", []string{"Name"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Bradley", "Candice", "Avery"}, {27.0, 22.0, 19.0}, {"Male", "Female", "Male"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			true,
+			}(),
+			"Team",
+			"Height",
+			"Salary",
+			Mean,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Bradley", "Candice"},
+						[]interface{}{math.NaN(), 1500000.0},
 						IndexData{
-							[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
 						},
-						"Name",
-						"string",
+						"5-11",
+						"float64",
 					},
 					{
-						[]interface{}{19.0, 27.0, 22.0},
+						[]interface{}{6912869.0, math.NaN()},
 						IndexData{
-							[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
 						},
-						"Age",
+						"5-9",
 						"float64",
 					},
 					{
-						[]interface{}{"Male", "Male", "Female"},
+						[]interface{}{5000000.0, 958633.333},
 						IndexData{
-							[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
 						},
-						"Sex",
-						"string",
+						"6-10",
+						"float64",
+					},
+					{
+						[]interface{}{math.NaN(), 1140240.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-11",
+						"float64",
+					},
+					{
+						[]interface{}{4777348.5, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-2",
+						"float64",
+					},
+					{
+						[]interface{}{math.NaN(), 2697445.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-3",
+						"float64",
+					},
+					{
+						[]interface{}{3431040.0, 817107.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-4",
+						"float64",
+					},
+					{
+						[]interface{}{1148640.0, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-5",
+						"float64",
+					},
+					{
+						[]interface{}{4272978.5, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-6",
+						"float64",
+					},
+					{
+						[]interface{}{3425510.0, 1467660.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-7",
+						"float64",
+					},
+					{
+						[]interface{}{1170960.0, 7330732.5},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-8",
+						"float64",
+					},
+					{
+						[]interface{}{7284630.0, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"6-9",
+						"float64",
+					},
+					{
+						[]interface{}{2391067.5, 19689000.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+							[]string{"Team"},
+						},
+						"7-0",
+						"float64",
 					},
 				},
 				IndexData{
-					[]Index{{2, []interface{}{"Avery"}}, {0, []interface{}{"Bradley"}}, {1, []interface{}{"Candice"}}},
-					[]string{"Name"},
+					[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
+					[]string{"Team"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"5-11", "5-9", "6-10", "6-11", "6-2", "6-3", "6-4", "6-5", "6-6", "6-7", "6-8", "6-9", "7-0"},
 			},
 		},
-		// {
-		// 	func() DataFrame {
-		// 		newDf, err := ReadCsv("./testfiles/nba.csv", []string{"Team"})
-		// 		if err != nil {
-		// 			t.Error(err)
-		// 		}
-		// 		return *newDf
-		// 	}(),
-		// 	true,
-		// 	func() DataFrame {
-		// 		newDf, err := ReadCsv("./testfiles/nba.csv", []string{"Team"})
-		// 		if err != nil {
-		// 			t.Error(err)
-		// 		}
-		// 		return *newDf
-		// 	}(),
-		// },
-	}
-	for _, test := range sortByIndexTests {
-		test.arg1.Print()
-		err := test.arg1.SortByIndex(test.arg2)
-		test.arg1.Print()
-		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
-		}
-	}
-}
-
-func BenchmarkDataFrameSortByValues(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		testDf.SortByValues("Name", true)
-	}
-}
-
-func TestDataFrameSortByValues(t *testing.T) {
-	type sortByValuesTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     bool
-		expected DataFrame
-	}
-	sortByValuesTests := []sortByValuesTest{
 		{
-			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
-				newDf, err := NewDataFrame(data, columns, indexCols)
+			func() DataFrame {
+				newDf, err := ReadCsv("./testfiles/airquality.csv", []string{"Time"})
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}([][]interface{}{{"Bradley", "Candice", "Avery"}, {27.0, 22.0, 19.0}, {"Male", "Female", "Male"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
-			"Age",
-			true,
+			}(),
+			"location",
+			"parameter",
+			"value",
+			Mean,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery", "Candice", "Bradley"},
-						IndexData{
-							[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
-							[]string{"Name"},
-						},
-						"Name",
-						"string",
-					},
-					{
-						[]interface{}{19.0, 22.0, 27.0},
+						[]interface{}{26.951, 29.374, 29.740},
 						IndexData{
-							[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"BETR801"}}, {1, []interface{}{"FR04014"}}, {2, []interface{}{"London Westminster"}}},
+							[]string{"location"},
 						},
-						"Age",
+						"no2",
 						"float64",
 					},
 					{
-						[]interface{}{"Male", "Female", "Male"},
+						[]interface{}{23.169, math.NaN(), 13.444},
 						IndexData{
-							[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"BETR801"}}, {1, []interface{}{"FR04014"}}, {2, []interface{}{"London Westminster"}}},
+							[]string{"location"},
 						},
-						"Sex",
-						"string",
+						"pm25",
+						"float64",
 					},
 				},
 				IndexData{
-					[]Index{{2, []interface{}{"Avery"}}, {1, []interface{}{"Candice"}}, {0, []interface{}{"Bradley"}}},
-					[]string{"Name"},
+					[]Index{{0, []interface{}{"BETR801"}}, {1, []interface{}{"FR04014"}}, {2, []interface{}{"London Westminster"}}},
+					[]string{"location"},
 				},
-				[]string{"Name", "Age", "Sex"},
+				[]string{"no2", "pm25"},
 			},
 		},
 	}
 
-	for _, test := range sortByValuesTests {
-		test.arg1.Print()
-		err := test.arg1.SortByValues(test.arg2, test.arg3)
-		test.arg1.Print()
-		if !cmp.Equal(test.arg1, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+	for _, test := range pivotTableTests {
+		output, err := test.arg1.PivotTable(test.arg2, test.arg3, test.arg4, test.arg5)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFrameDropNaN(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+func TestDataFramePivotTableWith(t *testing.T) {
+	testDf, err := NewDataFrame([][]interface{}{{"S1", "S1", "S1", "S2", "S2", "S1"}, {"Mon", "Mon", "Tue", "Mon", "Mon", "Mon"}, {"apple", "pear", "pear", "kiwi", "kiwi", "apple"}}, []string{"Store", "Day", "Item"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := DataFrame{
+		[]Series{
+			{
+				[]interface{}{"apple", "kiwi"},
+				IndexData{
+					[]Index{{0, []interface{}{"S1"}}, {1, []interface{}{"S2"}}},
+					[]string{"Store"},
+				},
+				"Mon",
+				"string",
+			},
+			{
+				[]interface{}{"pear", math.NaN()},
+				IndexData{
+					[]Index{{0, []interface{}{"S1"}}, {1, []interface{}{"S2"}}},
+					[]string{"Store"},
+				},
+				"Tue",
+				"string",
+			},
+		},
+		IndexData{
+			[]Index{{0, []interface{}{"S1"}}, {1, []interface{}{"S2"}}},
+			[]string{"Store"},
+		},
+		[]string{"Mon", "Tue"},
+	}
+
+	output, err := testDf.PivotTableWith("Store", "Day", "Item", AggFunc(modeOfStrings))
+	if !cmp.Equal(output, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", expected, output, err)
+	}
+}
+
+// cancelAfterContext is a context that reports itself as cancelled after Err has been called a set number of times.
+// This lets tests cancel an operation partway through without depending on timing.
+type cancelAfterContext struct {
+	context.Context
+	calls int
+	after int
+}
+
+func (c *cancelAfterContext) Err() error {
+	c.calls++
+	if c.calls > c.after {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestDataFrameContext(t *testing.T) {
+	testDf, err := NewSampleDataFrame(map[string]string{"Team": "string", "Score": "int", "Season": "int"}, 5000, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	type contextTest struct {
+		arg1          context.Context
+		expectedError error
+	}
+	contextTests := []contextTest{
+		{
+			context.Background(),
+			nil,
+		},
+		{
+			func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			}(),
+			context.Canceled,
+		},
+		{
+			&cancelAfterContext{context.Background(), 0, 2},
+			context.Canceled,
+		},
+	}
+
+	for _, test := range contextTests {
+		_, err := testDf.GroupByContext(test.arg1, "Season")
+		if !errors.Is(err, test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+		if ctx, ok := test.arg1.(*cancelAfterContext); ok && ctx.calls <= 1 {
+			t.Fatalf("expected GroupByContext to stop partway through, stopped after %d checks", ctx.calls)
+		}
+	}
+
+	contextTests[2].arg1 = &cancelAfterContext{context.Background(), 0, 2}
+	for _, test := range contextTests {
+		_, err := testDf.PivotTableContext(test.arg1, "Team", "Season", "Score", Mean)
+		if !errors.Is(err, test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+	}
+}
+
+func BenchmarkDataFrameMelt(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/airquality.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	dfPivoted, err := testDf.PivotTable("location", "parameter", "value", Mean)
 	if err != nil {
 		b.Error(err)
 	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.DropNaN(0)
+		dfPivoted.Melt("parameter", "value", nil, nil)
 	}
 }
 
-func TestDataFrameDropNaN(t *testing.T) {
-	type dropNaNTest struct {
-		arg1     DataFrame
-		arg2     int
-		expected DataFrame
+func TestDataFrameMelt(t *testing.T) {
+	type meltTest struct {
+		arg1          DataFrame
+		arg2          string
+		arg3          string
+		arg4          []string
+		arg5          []string
+		expected      DataFrame
+		expectedError error
 	}
-	dropNaNTests := []dropNaNTest{
+	meltTests := []meltTest{
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdropnan1.csv", []string{"Name"})
+				newDf, err := ReadCsv("./testfiles/airquality.csv", []string{"Time"})
 				if err != nil {
 					t.Error(err)
 				}
-				return newDf
+				dfPivoted, _ := newDf.PivotTable("location", "parameter", "value", Mean)
+				return dfPivoted
 			}(),
-			0,
+			"parameter",
+			"value",
+			nil,
+			nil,
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{"Avery Bradley", "Jae Crowder", "R.J. Hunter"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Name",
-						"string",
-					},
-					{
-						[]interface{}{"Boston Celtics", "Boston Celtics", "Boston Celtics"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Team",
-						"string",
-					},
-					{
-						[]interface{}{0.0, 99.0, 28.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Number",
-						"float64",
-					},
-					{
-						[]interface{}{"PG", "SF", "SG"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Position",
-						"string",
-					},
-					{
-						[]interface{}{25.0, 25.0, 22.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Age",
-						"float64",
-					},
-					{
-						[]interface{}{"6-2", "6-6", "6-5"},
+						[]interface{}{"BETR801", "BETR801", "FR04014", "FR04014", "London Westminster", "London Westminster"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{
+								{0, []interface{}{"BETR801"}},
+								{1, []interface{}{"BETR801"}},
+								{2, []interface{}{"FR04014"}},
+								{3, []interface{}{"FR04014"}},
+								{4, []interface{}{"London Westminster"}},
+								{5, []interface{}{"London Westminster"}},
+							},
+							[]string{"location"},
 						},
-						"Height",
+						"location",
 						"string",
 					},
 					{
-						[]interface{}{180.0, 235.0, 185.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Weight",
-						"float64",
-					},
-					{
-						[]interface{}{"Texas", "Marquette", "Georgia State"},
+						[]interface{}{"no2", "pm25", "no2", "pm25", "no2", "pm25"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{
+								{0, []interface{}{"BETR801"}},
+								{1, []interface{}{"BETR801"}},
+								{2, []interface{}{"FR04014"}},
+								{3, []interface{}{"FR04014"}},
+								{4, []interface{}{"London Westminster"}},
+								{5, []interface{}{"London Westminster"}},
+							},
+							[]string{"location"},
 						},
-						"College",
+						"parameter",
 						"string",
 					},
 					{
-						[]interface{}{7730337.0, 6796117.0, 1148640.0},
+						[]interface{}{26.951, 23.169, 29.374, math.NaN(), 29.740, 13.444},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{
+								{0, []interface{}{"BETR801"}},
+								{1, []interface{}{"BETR801"}},
+								{2, []interface{}{"FR04014"}},
+								{3, []interface{}{"FR04014"}},
+								{4, []interface{}{"London Westminster"}},
+								{5, []interface{}{"London Westminster"}},
+							},
+							[]string{"location"},
 						},
-						"Salary",
+						"value",
 						"float64",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {3, []interface{}{"R.J. Hunter"}}},
-					[]string{"Name"},
+					[]Index{
+						{0, []interface{}{"BETR801"}},
+						{1, []interface{}{"BETR801"}},
+						{2, []interface{}{"FR04014"}},
+						{3, []interface{}{"FR04014"}},
+						{4, []interface{}{"London Westminster"}},
+						{5, []interface{}{"London Westminster"}},
+					},
+					[]string{"location"},
 				},
-				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
+				[]string{"location", "parameter", "value"},
 			},
+			nil,
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdropnan2.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
-				}
-				return newDf
-			}(),
-			1,
-			DataFrame{
-				[]Series{
-					{
-						[]interface{}{"Avery Bradley", "Jae Crowder", "John Holland", "R.J. Hunter"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Name",
-						"string",
-					},
-					{
-						[]interface{}{"Boston Celtics", "Boston Celtics", "Boston Celtics", "Boston Celtics"},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Team",
-						"string",
-					},
+				}
+				return newDf
+			}([][]interface{}{{"s1", "s2"}, {"Avery", "Bradley"}, {"A", "B"}, {90, 80}, {85, 70}, {77, 66}}, []string{"Id", "Name", "Class", "Math", "Science", "Art"}, []string{"Id"}),
+			"Subject",
+			"Score",
+			[]string{"Name", "Class"},
+			[]string{"Math", "Science"},
+			DataFrame{
+				[]Series{
 					{
-						[]interface{}{"PG", "SF", "SG", "SG"},
+						[]interface{}{"s1", "s1", "s2", "s2"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"s1"}}, {1, []interface{}{"s1"}}, {2, []interface{}{"s2"}}, {3, []interface{}{"s2"}}},
+							[]string{"Id"},
 						},
-						"Position",
+						"Id",
 						"string",
 					},
 					{
-						[]interface{}{25.0, 25.0, 27.0, 22.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
-						},
-						"Age",
-						"float64",
-					},
-					{
-						[]interface{}{"6-2", "6-6", "6-5", "6-5"},
+						[]interface{}{"Avery", "Avery", "Bradley", "Bradley"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"s1"}}, {1, []interface{}{"s1"}}, {2, []interface{}{"s2"}}, {3, []interface{}{"s2"}}},
+							[]string{"Id"},
 						},
-						"Height",
+						"Name",
 						"string",
 					},
 					{
-						[]interface{}{180.0, 235.0, 205.0, 185.0},
+						[]interface{}{"A", "A", "B", "B"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"s1"}}, {1, []interface{}{"s1"}}, {2, []interface{}{"s2"}}, {3, []interface{}{"s2"}}},
+							[]string{"Id"},
 						},
-						"Weight",
-						"float64",
+						"Class",
+						"string",
 					},
 					{
-						[]interface{}{"Texas", "Marquette", "Boston University", "Georgia State"},
+						[]interface{}{"Math", "Science", "Math", "Science"},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"s1"}}, {1, []interface{}{"s1"}}, {2, []interface{}{"s2"}}, {3, []interface{}{"s2"}}},
+							[]string{"Id"},
 						},
-						"College",
+						"Subject",
 						"string",
 					},
 					{
-						[]interface{}{7730337.0, 6796117.0, 5000000.0, 1148640.0},
+						[]interface{}{90, 85, 80, 70},
 						IndexData{
-							[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-							[]string{"Name"},
+							[]Index{{0, []interface{}{"s1"}}, {1, []interface{}{"s1"}}, {2, []interface{}{"s2"}}, {3, []interface{}{"s2"}}},
+							[]string{"Id"},
 						},
-						"Salary",
-						"float64",
+						"Score",
+						"int",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Avery Bradley"}}, {1, []interface{}{"Jae Crowder"}}, {2, []interface{}{"John Holland"}}, {3, []interface{}{"R.J. Hunter"}}},
-					[]string{"Name"},
+					[]Index{{0, []interface{}{"s1"}}, {1, []interface{}{"s1"}}, {2, []interface{}{"s2"}}, {3, []interface{}{"s2"}}},
+					[]string{"Id"},
 				},
-				[]string{"Name", "Team", "Position", "Age", "Height", "Weight", "College", "Salary"},
+				[]string{"Id", "Name", "Class", "Subject", "Score"},
 			},
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"s1", "s2"}, {"Avery", "Bradley"}, {"A", "B"}, {90, 80}, {85, 70}, {77, 66}}, []string{"Id", "Name", "Class", "Math", "Science", "Art"}, []string{"Id"}),
+			"Subject",
+			"Score",
+			[]string{"Name", "Class"},
+			[]string{"Math", "Name"},
+			DataFrame{},
+			fmt.Errorf("column 'Name' cannot be both an id and a value variable"),
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"s1", "s2"}, {"Avery", "Bradley"}, {"A", "B"}, {90, 80}, {85, 70}, {77, 66}}, []string{"Id", "Name", "Class", "Math", "Science", "Art"}, []string{"Id"}),
+			"Subject",
+			"Score",
+			[]string{"Gender"},
+			nil,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Gender"),
 		},
 	}
-
-	for _, test := range dropNaNTests {
-		output, err := test.arg1.DropNaN(test.arg2)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, test.arg1, err)
+	for _, test := range meltTests {
+		output, err := test.arg1.Melt(test.arg2, test.arg3, test.arg4, test.arg5)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
 
-func BenchmarkDataFramePivot(b *testing.B) {
+// func TestDataFrameGroupBy(t *testing.T) {
+// 	type groupByTest struct {
+// 		arg1     DataFrame
+// 		arg2     []string
+// 		expected *GroupBy
+// 	}
+// 	groupByTests := []groupByTest{
+// 		{
+// 			func() DataFrame {
+// 				df, err := ReadCsv("./testfiles/airquality.csv", []string{"date.utc"})
+// 				if err != nil {
+// 					t.Error(err)
+// 				}
+// 				return *df
+// 			}(),
+// 			[]string{"parameter", "location"},
+// 			&GroupBy{
+// 				func() *DataFrame {
+// 					df, err := ReadCsv("./testfiles/airquality.csv", []string{"date.utc"})
+// 					if err != nil {
+// 						t.Error(err)
+// 					}
+// 					return df
+// 				}(),
+// 				map[string][]interface{}{
+// 					"57b88dc64ef3f516950d6b78f19ab0df836341799c104fb6b23dddf99fe44d2690d4a9b1a7c7e7831493fa9421ffe8021dd4e3e6c43c753c587d5d1df4d9e377": {
+// 						"2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-15 01:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-13 01:00:00+00:00", "2019-06-12 01:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-24 01:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 01:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 01:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-01 02:00:00+00:00", "2019-05-01 01:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-28 02:00:00+00:00", "2019-04-28 01:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 01:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 01:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-19 01:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 01:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 01:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-12 02:00:00+00:00", "2019-04-12 01:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 01:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00", "2019-04-09 01:00:00+00:00",
+// 					},
+// 					"4f014d3c9993903073a9e5e6a32a2cb8773f0c285c4900f44e81a309ebb21399ec0998fdea2dc8ae3ce9fdfdaf49cdc12956783e512aaa0c7c145bf311335127": {
+// 						"2019-06-21 00:00:00+00:00", "2019-06-20 23:00:00+00:00", "2019-06-20 22:00:00+00:00", "2019-06-20 21:00:00+00:00", "2019-06-20 20:00:00+00:00", "2019-06-20 19:00:00+00:00", "2019-06-20 18:00:00+00:00", "2019-06-20 17:00:00+00:00", "2019-06-20 16:00:00+00:00", "2019-06-20 15:00:00+00:00", "2019-06-20 14:00:00+00:00", "2019-06-20 13:00:00+00:00", "2019-06-19 10:00:00+00:00", "2019-06-19 09:00:00+00:00", "2019-06-18 22:00:00+00:00", "2019-06-18 21:00:00+00:00", "2019-06-18 20:00:00+00:00", "2019-06-18 19:00:00+00:00", "2019-06-18 08:00:00+00:00", "2019-06-18 07:00:00+00:00", "2019-06-18 06:00:00+00:00", "2019-06-18 05:00:00+00:00", "2019-06-18 04:00:00+00:00", "2019-06-18 03:00:00+00:00", "2019-06-18 02:00:00+00:00", "2019-06-18 01:00:00+00:00", "2019-06-18 00:00:00+00:00", "2019-06-17 23:00:00+00:00", "2019-06-17 22:00:00+00:00", "2019-06-17 21:00:00+00:00", "2019-06-17 20:00:00+00:00", "2019-06-17 19:00:00+00:00", "2019-06-17 18:00:00+00:00", "2019-06-17 17:00:00+00:00", "2019-06-17 16:00:00+00:00", "2019-06-17 15:00:00+00:00", "2019-06-17 14:00:00+00:00", "2019-06-17 13:00:00+00:00", "2019-06-17 12:00:00+00:00", "2019-06-17 11:00:00+00:00", "2019-06-17 10:00:00+00:00", "2019-06-17 09:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-17 00:00:00+00:00", "2019-06-16 23:00:00+00:00", "2019-06-16 22:00:00+00:00", "2019-06-16 21:00:00+00:00", "2019-06-16 20:00:00+00:00", "2019-06-16 19:00:00+00:00", "2019-06-16 18:00:00+00:00", "2019-06-16 17:00:00+00:00", "2019-06-16 16:00:00+00:00", "2019-06-16 15:00:00+00:00", "2019-06-16 14:00:00+00:00", "2019-06-16 13:00:00+00:00", "2019-06-16 12:00:00+00:00", "2019-06-16 11:00:00+00:00", "2019-06-16 10:00:00+00:00", "2019-06-16 09:00:00+00:00", "2019-06-16 08:00:00+00:00", "2019-06-16 07:00:00+00:00", "2019-06-16 06:00:00+00:00", "2019-06-16 05:00:00+00:00", "2019-06-16 04:00:00+00:00", "2019-06-16 03:00:00+00:00", "2019-06-16 02:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-16 00:00:00+00:00", "2019-06-15 23:00:00+00:00", "2019-06-15 22:00:00+00:00", "2019-06-15 21:00:00+00:00", "2019-06-15 20:00:00+00:00", "2019-06-15 19:00:00+00:00", "2019-06-15 18:00:00+00:00", "2019-06-15 17:00:00+00:00", "2019-06-15 16:00:00+00:00", "2019-06-15 15:00:00+00:00", "2019-06-15 14:00:00+00:00", "2019-06-15 13:00:00+00:00", "2019-06-15 12:00:00+00:00", "2019-06-15 11:00:00+00:00", "2019-06-15 10:00:00+00:00", "2019-06-15 09:00:00+00:00", "2019-06-15 08:00:00+00:00", "2019-06-15 07:00:00+00:00", "2019-06-15 06:00:00+00:00", "2019-06-15 02:00:00+00:00", "2019-06-15 01:00:00+00:00", "2019-06-15 00:00:00+00:00", "2019-06-14 23:00:00+00:00", "2019-06-14 22:00:00+00:00", "2019-06-14 21:00:00+00:00", "2019-06-14 20:00:00+00:00", "2019-06-14 19:00:00+00:00", "2019-06-14 18:00:00+00:00", "2019-06-14 17:00:00+00:00", "2019-06-14 16:00:00+00:00", "2019-06-14 15:00:00+00:00", "2019-06-14 14:00:00+00:00", "2019-06-14 13:00:00+00:00", "2019-06-14 12:00:00+00:00", "2019-06-14 11:00:00+00:00", "2019-06-14 10:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-14 08:00:00+00:00", "2019-06-14 07:00:00+00:00", "2019-06-14 06:00:00+00:00", "2019-06-14 05:00:00+00:00", "2019-06-14 04:00:00+00:00", "2019-06-14 03:00:00+00:00", "2019-06-14 02:00:00+00:00", "2019-06-14 01:00:00+00:00", "2019-06-14 00:00:00+00:00", "2019-06-13 23:00:00+00:00", "2019-06-13 22:00:00+00:00", "2019-06-13 21:00:00+00:00", "2019-06-13 20:00:00+00:00", "2019-06-13 19:00:00+00:00", "2019-06-13 18:00:00+00:00", "2019-06-13 17:00:00+00:00", "2019-06-13 16:00:00+00:00", "2019-06-13 15:00:00+00:00", "2019-06-13 14:00:00+00:00", "2019-06-13 13:00:00+00:00", "2019-06-13 12:00:00+00:00", "2019-06-13 11:00:00+00:00", "2019-06-13 10:00:00+00:00", "2019-06-13 09:00:00+00:00", "2019-06-13 08:00:00+00:00", "2019-06-13 07:00:00+00:00", "2019-06-13 06:00:00+00:00", "2019-06-13 05:00:00+00:00", "2019-06-13 04:00:00+00:00", "2019-06-13 03:00:00+00:00", "2019-06-13 02:00:00+00:00", "2019-06-13 01:00:00+00:00", "2019-06-13 00:00:00+00:00", "2019-06-12 23:00:00+00:00", "2019-06-12 22:00:00+00:00", "2019-06-12 21:00:00+00:00", "2019-06-12 20:00:00+00:00", "2019-06-12 19:00:00+00:00", "2019-06-12 18:00:00+00:00", "2019-06-12 17:00:00+00:00", "2019-06-12 16:00:00+00:00", "2019-06-12 15:00:00+00:00", "2019-06-12 14:00:00+00:00", "2019-06-12 13:00:00+00:00", "2019-06-12 12:00:00+00:00", "2019-06-12 11:00:00+00:00", "2019-06-12 10:00:00+00:00", "2019-06-12 09:00:00+00:00", "2019-06-12 08:00:00+00:00", "2019-06-12 07:00:00+00:00", "2019-06-12 06:00:00+00:00", "2019-06-12 05:00:00+00:00", "2019-06-12 04:00:00+00:00", "2019-06-12 03:00:00+00:00", "2019-06-12 02:00:00+00:00", "2019-06-12 01:00:00+00:00", "2019-06-12 00:00:00+00:00", "2019-06-11 23:00:00+00:00", "2019-06-11 22:00:00+00:00", "2019-06-11 21:00:00+00:00", "2019-06-11 20:00:00+00:00", "2019-06-11 19:00:00+00:00", "2019-06-11 18:00:00+00:00", "2019-06-11 17:00:00+00:00", "2019-06-11 16:00:00+00:00", "2019-06-11 15:00:00+00:00", "2019-06-11 14:00:00+00:00", "2019-06-11 13:00:00+00:00", "2019-06-11 12:00:00+00:00", "2019-06-11 11:00:00+00:00", "2019-06-11 10:00:00+00:00", "2019-06-11 09:00:00+00:00", "2019-06-11 08:00:00+00:00", "2019-06-11 07:00:00+00:00", "2019-06-11 06:00:00+00:00", "2019-06-11 05:00:00+00:00", "2019-06-11 04:00:00+00:00", "2019-06-11 03:00:00+00:00", "2019-06-11 02:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-11 00:00:00+00:00", "2019-06-10 23:00:00+00:00", "2019-06-10 22:00:00+00:00", "2019-06-10 21:00:00+00:00", "2019-06-10 20:00:00+00:00", "2019-06-10 19:00:00+00:00", "2019-06-10 18:00:00+00:00", "2019-06-10 17:00:00+00:00", "2019-06-10 16:00:00+00:00", "2019-06-10 15:00:00+00:00", "2019-06-10 14:00:00+00:00", "2019-06-10 13:00:00+00:00", "2019-06-10 12:00:00+00:00", "2019-06-10 11:00:00+00:00", "2019-06-10 10:00:00+00:00", "2019-06-10 09:00:00+00:00", "2019-06-10 08:00:00+00:00", "2019-06-10 07:00:00+00:00", "2019-06-10 06:00:00+00:00", "2019-06-10 05:00:00+00:00", "2019-06-10 04:00:00+00:00", "2019-06-10 03:00:00+00:00", "2019-06-10 02:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-10 00:00:00+00:00", "2019-06-09 23:00:00+00:00", "2019-06-09 22:00:00+00:00", "2019-06-09 21:00:00+00:00", "2019-06-09 20:00:00+00:00", "2019-06-09 19:00:00+00:00", "2019-06-09 18:00:00+00:00", "2019-06-09 17:00:00+00:00", "2019-06-09 16:00:00+00:00", "2019-06-09 15:00:00+00:00", "2019-06-09 14:00:00+00:00", "2019-06-09 13:00:00+00:00", "2019-06-09 12:00:00+00:00", "2019-06-09 11:00:00+00:00", "2019-06-09 10:00:00+00:00", "2019-06-09 09:00:00+00:00", "2019-06-09 08:00:00+00:00", "2019-06-09 07:00:00+00:00", "2019-06-09 06:00:00+00:00", "2019-06-09 05:00:00+00:00", "2019-06-09 04:00:00+00:00", "2019-06-09 03:00:00+00:00", "2019-06-09 02:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-09 00:00:00+00:00", "2019-06-08 23:00:00+00:00", "2019-06-08 22:00:00+00:00", "2019-06-08 21:00:00+00:00", "2019-06-08 18:00:00+00:00", "2019-06-08 17:00:00+00:00", "2019-06-08 16:00:00+00:00", "2019-06-08 15:00:00+00:00", "2019-06-08 14:00:00+00:00", "2019-06-08 13:00:00+00:00", "2019-06-08 12:00:00+00:00", "2019-06-08 11:00:00+00:00", "2019-06-08 10:00:00+00:00", "2019-06-08 09:00:00+00:00", "2019-06-08 08:00:00+00:00", "2019-06-08 07:00:00+00:00", "2019-06-08 06:00:00+00:00", "2019-06-08 05:00:00+00:00", "2019-06-08 04:00:00+00:00", "2019-06-08 03:00:00+00:00", "2019-06-08 02:00:00+00:00", "2019-06-08 01:00:00+00:00", "2019-06-08 00:00:00+00:00", "2019-06-07 23:00:00+00:00", "2019-06-07 22:00:00+00:00", "2019-06-07 21:00:00+00:00", "2019-06-07 20:00:00+00:00", "2019-06-07 19:00:00+00:00", "2019-06-07 18:00:00+00:00", "2019-06-07 17:00:00+00:00", "2019-06-07 16:00:00+00:00", "2019-06-07 15:00:00+00:00", "2019-06-07 14:00:00+00:00", "2019-06-07 13:00:00+00:00", "2019-06-07 12:00:00+00:00", "2019-06-07 11:00:00+00:00", "2019-06-07 10:00:00+00:00", "2019-06-07 09:00:00+00:00", "2019-06-07 08:00:00+00:00", "2019-06-07 07:00:00+00:00", "2019-06-07 06:00:00+00:00", "2019-06-06 14:00:00+00:00", "2019-06-06 13:00:00+00:00", "2019-06-06 12:00:00+00:00", "2019-06-06 11:00:00+00:00", "2019-06-06 10:00:00+00:00", "2019-06-06 09:00:00+00:00", "2019-06-06 08:00:00+00:00", "2019-06-06 07:00:00+00:00", "2019-06-06 06:00:00+00:00", "2019-06-06 05:00:00+00:00", "2019-06-06 04:00:00+00:00", "2019-06-06 03:00:00+00:00", "2019-06-06 02:00:00+00:00", "2019-06-06 01:00:00+00:00", "2019-06-06 00:00:00+00:00", "2019-06-05 23:00:00+00:00", "2019-06-05 22:00:00+00:00", "2019-06-05 21:00:00+00:00", "2019-06-05 20:00:00+00:00", "2019-06-05 19:00:00+00:00", "2019-06-05 18:00:00+00:00", "2019-06-05 17:00:00+00:00", "2019-06-05 16:00:00+00:00", "2019-06-05 15:00:00+00:00", "2019-06-05 14:00:00+00:00", "2019-06-05 13:00:00+00:00", "2019-06-05 12:00:00+00:00", "2019-06-05 11:00:00+00:00", "2019-06-05 10:00:00+00:00", "2019-06-05 09:00:00+00:00", "2019-06-05 08:00:00+00:00", "2019-06-05 07:00:00+00:00", "2019-06-05 06:00:00+00:00", "2019-06-05 05:00:00+00:00", "2019-06-05 04:00:00+00:00", "2019-06-05 03:00:00+00:00", "2019-06-05 02:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-05 00:00:00+00:00", "2019-06-04 23:00:00+00:00", "2019-06-04 22:00:00+00:00", "2019-06-04 21:00:00+00:00", "2019-06-04 20:00:00+00:00", "2019-06-04 19:00:00+00:00", "2019-06-04 18:00:00+00:00", "2019-06-04 17:00:00+00:00", "2019-06-04 16:00:00+00:00", "2019-06-04 15:00:00+00:00", "2019-06-04 14:00:00+00:00", "2019-06-04 13:00:00+00:00", "2019-06-04 12:00:00+00:00", "2019-06-04 11:00:00+00:00", "2019-06-04 10:00:00+00:00", "2019-06-04 09:00:00+00:00", "2019-06-04 08:00:00+00:00", "2019-06-04 07:00:00+00:00", "2019-06-04 06:00:00+00:00", "2019-06-04 05:00:00+00:00", "2019-06-04 04:00:00+00:00", "2019-06-04 03:00:00+00:00", "2019-06-04 02:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-04 00:00:00+00:00", "2019-06-03 23:00:00+00:00", "2019-06-03 22:00:00+00:00", "2019-06-03 21:00:00+00:00", "2019-06-03 20:00:00+00:00", "2019-06-03 19:00:00+00:00", "2019-06-03 18:00:00+00:00", "2019-06-03 17:00:00+00:00", "2019-06-03 16:00:00+00:00", "2019-06-03 15:00:00+00:00", "2019-06-03 14:00:00+00:00", "2019-06-03 13:00:00+00:00", "2019-06-03 12:00:00+00:00", "2019-06-03 11:00:00+00:00", "2019-06-03 10:00:00+00:00", "2019-06-03 09:00:00+00:00", "2019-06-03 08:00:00+00:00", "2019-06-03 07:00:00+00:00", "2019-06-03 06:00:00+00:00", "2019-06-03 05:00:00+00:00", "2019-06-03 04:00:00+00:00", "2019-06-03 03:00:00+00:00", "2019-06-03 02:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-03 00:00:00+00:00", "2019-06-02 23:00:00+00:00", "2019-06-02 22:00:00+00:00", "2019-06-02 21:00:00+00:00", "2019-06-02 20:00:00+00:00", "2019-06-02 19:00:00+00:00", "2019-06-02 18:00:00+00:00", "2019-06-02 17:00:00+00:00", "2019-06-02 16:00:00+00:00", "2019-06-02 15:00:00+00:00", "2019-06-02 14:00:00+00:00", "2019-06-02 13:00:00+00:00", "2019-06-02 12:00:00+00:00", "2019-06-02 11:00:00+00:00", "2019-06-02 10:00:00+00:00", "2019-06-02 09:00:00+00:00", "2019-06-02 08:00:00+00:00", "2019-06-02 07:00:00+00:00", "2019-06-02 06:00:00+00:00", "2019-06-02 05:00:00+00:00", "2019-06-02 04:00:00+00:00", "2019-06-02 03:00:00+00:00", "2019-06-02 02:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-02 00:00:00+00:00", "2019-06-01 23:00:00+00:00", "2019-06-01 22:00:00+00:00", "2019-06-01 21:00:00+00:00", "2019-06-01 20:00:00+00:00", "2019-06-01 19:00:00+00:00", "2019-06-01 18:00:00+00:00", "2019-06-01 17:00:00+00:00", "2019-06-01 16:00:00+00:00", "2019-06-01 15:00:00+00:00", "2019-06-01 14:00:00+00:00", "2019-06-01 13:00:00+00:00", "2019-06-01 12:00:00+00:00", "2019-06-01 11:00:00+00:00", "2019-06-01 10:00:00+00:00", "2019-06-01 09:00:00+00:00", "2019-06-01 08:00:00+00:00", "2019-06-01 07:00:00+00:00", "2019-06-01 06:00:00+00:00", "2019-06-01 02:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-06-01 00:00:00+00:00", "2019-05-31 23:00:00+00:00", "2019-05-31 22:00:00+00:00", "2019-05-31 21:00:00+00:00", "2019-05-31 20:00:00+00:00", "2019-05-31 19:00:00+00:00", "2019-05-31 18:00:00+00:00", "2019-05-31 17:00:00+00:00", "2019-05-31 16:00:00+00:00", "2019-05-31 15:00:00+00:00", "2019-05-31 14:00:00+00:00", "2019-05-31 13:00:00+00:00", "2019-05-31 12:00:00+00:00", "2019-05-31 11:00:00+00:00", "2019-05-31 10:00:00+00:00", "2019-05-31 09:00:00+00:00", "2019-05-31 08:00:00+00:00", "2019-05-31 07:00:00+00:00", "2019-05-31 06:00:00+00:00", "2019-05-31 05:00:00+00:00", "2019-05-31 04:00:00+00:00", "2019-05-31 03:00:00+00:00", "2019-05-31 02:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-31 00:00:00+00:00", "2019-05-30 23:00:00+00:00", "2019-05-30 22:00:00+00:00", "2019-05-30 21:00:00+00:00", "2019-05-30 20:00:00+00:00", "2019-05-30 19:00:00+00:00", "2019-05-30 18:00:00+00:00", "2019-05-30 17:00:00+00:00", "2019-05-30 16:00:00+00:00", "2019-05-30 15:00:00+00:00", "2019-05-30 14:00:00+00:00", "2019-05-30 13:00:00+00:00", "2019-05-30 12:00:00+00:00", "2019-05-30 11:00:00+00:00", "2019-05-30 10:00:00+00:00", "2019-05-30 09:00:00+00:00", "2019-05-30 08:00:00+00:00", "2019-05-30 07:00:00+00:00", "2019-05-30 06:00:00+00:00", "2019-05-30 05:00:00+00:00", "2019-05-30 04:00:00+00:00", "2019-05-30 03:00:00+00:00", "2019-05-30 02:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-30 00:00:00+00:00", "2019-05-29 23:00:00+00:00", "2019-05-29 22:00:00+00:00", "2019-05-29 21:00:00+00:00", "2019-05-29 20:00:00+00:00", "2019-05-29 19:00:00+00:00", "2019-05-29 18:00:00+00:00", "2019-05-29 17:00:00+00:00", "2019-05-29 16:00:00+00:00", "2019-05-29 15:00:00+00:00", "2019-05-29 14:00:00+00:00", "2019-05-29 13:00:00+00:00", "2019-05-29 12:00:00+00:00", "2019-05-29 11:00:00+00:00", "2019-05-29 10:00:00+00:00", "2019-05-29 09:00:00+00:00", "2019-05-29 08:00:00+00:00", "2019-05-29 07:00:00+00:00", "2019-05-29 06:00:00+00:00", "2019-05-29 05:00:00+00:00", "2019-05-29 04:00:00+00:00", "2019-05-29 03:00:00+00:00", "2019-05-29 02:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-29 00:00:00+00:00", "2019-05-28 23:00:00+00:00", "2019-05-28 22:00:00+00:00", "2019-05-28 21:00:00+00:00", "2019-05-28 20:00:00+00:00", "2019-05-28 19:00:00+00:00", "2019-05-28 18:00:00+00:00", "2019-05-28 17:00:00+00:00", "2019-05-28 16:00:00+00:00", "2019-05-28 15:00:00+00:00", "2019-05-28 14:00:00+00:00", "2019-05-28 13:00:00+00:00", "2019-05-28 12:00:00+00:00", "2019-05-28 11:00:00+00:00", "2019-05-28 10:00:00+00:00", "2019-05-28 09:00:00+00:00", "2019-05-28 08:00:00+00:00", "2019-05-28 07:00:00+00:00", "2019-05-28 06:00:00+00:00", "2019-05-28 05:00:00+00:00", "2019-05-28 04:00:00+00:00", "2019-05-28 03:00:00+00:00", "2019-05-28 02:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-28 00:00:00+00:00", "2019-05-27 23:00:00+00:00", "2019-05-27 22:00:00+00:00", "2019-05-27 21:00:00+00:00", "2019-05-27 20:00:00+00:00", "2019-05-27 19:00:00+00:00", "2019-05-27 18:00:00+00:00", "2019-05-27 17:00:00+00:00", "2019-05-27 16:00:00+00:00", "2019-05-27 15:00:00+00:00", "2019-05-27 14:00:00+00:00", "2019-05-27 13:00:00+00:00", "2019-05-27 12:00:00+00:00", "2019-05-27 11:00:00+00:00", "2019-05-27 10:00:00+00:00", "2019-05-27 09:00:00+00:00", "2019-05-27 08:00:00+00:00", "2019-05-27 07:00:00+00:00", "2019-05-27 06:00:00+00:00", "2019-05-27 05:00:00+00:00", "2019-05-27 04:00:00+00:00", "2019-05-27 03:00:00+00:00", "2019-05-27 02:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-27 00:00:00+00:00", "2019-05-26 23:00:00+00:00", "2019-05-26 22:00:00+00:00", "2019-05-26 21:00:00+00:00", "2019-05-26 20:00:00+00:00", "2019-05-26 19:00:00+00:00", "2019-05-26 18:00:00+00:00", "2019-05-26 17:00:00+00:00", "2019-05-26 16:00:00+00:00", "2019-05-26 15:00:00+00:00", "2019-05-26 14:00:00+00:00", "2019-05-26 13:00:00+00:00", "2019-05-26 12:00:00+00:00", "2019-05-26 11:00:00+00:00", "2019-05-26 10:00:00+00:00", "2019-05-26 09:00:00+00:00", "2019-05-26 08:00:00+00:00", "2019-05-26 07:00:00+00:00", "2019-05-26 06:00:00+00:00", "2019-05-26 05:00:00+00:00", "2019-05-26 04:00:00+00:00", "2019-05-26 03:00:00+00:00", "2019-05-26 02:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-26 00:00:00+00:00", "2019-05-25 23:00:00+00:00", "2019-05-25 22:00:00+00:00", "2019-05-25 21:00:00+00:00", "2019-05-25 20:00:00+00:00", "2019-05-25 19:00:00+00:00", "2019-05-25 18:00:00+00:00", "2019-05-25 17:00:00+00:00", "2019-05-25 16:00:00+00:00", "2019-05-25 15:00:00+00:00", "2019-05-25 14:00:00+00:00", "2019-05-25 13:00:00+00:00", "2019-05-25 12:00:00+00:00", "2019-05-25 11:00:00+00:00", "2019-05-25 10:00:00+00:00", "2019-05-25 09:00:00+00:00", "2019-05-25 08:00:00+00:00", "2019-05-25 07:00:00+00:00", "2019-05-25 06:00:00+00:00", "2019-05-25 02:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-25 00:00:00+00:00", "2019-05-24 23:00:00+00:00", "2019-05-24 22:00:00+00:00", "2019-05-24 21:00:00+00:00", "2019-05-24 20:00:00+00:00", "2019-05-24 19:00:00+00:00", "2019-05-24 18:00:00+00:00", "2019-05-24 17:00:00+00:00", "2019-05-24 16:00:00+00:00", "2019-05-24 15:00:00+00:00", "2019-05-24 14:00:00+00:00", "2019-05-24 13:00:00+00:00", "2019-05-24 12:00:00+00:00", "2019-05-24 11:00:00+00:00", "2019-05-24 10:00:00+00:00", "2019-05-24 09:00:00+00:00", "2019-05-24 08:00:00+00:00", "2019-05-24 07:00:00+00:00", "2019-05-24 06:00:00+00:00", "2019-05-24 05:00:00+00:00", "2019-05-24 04:00:00+00:00", "2019-05-24 03:00:00+00:00", "2019-05-24 02:00:00+00:00", "2019-05-24 01:00:00+00:00", "2019-05-24 00:00:00+00:00", "2019-05-23 23:00:00+00:00", "2019-05-23 22:00:00+00:00", "2019-05-23 21:00:00+00:00", "2019-05-23 20:00:00+00:00", "2019-05-23 19:00:00+00:00", "2019-05-23 18:00:00+00:00", "2019-05-23 17:00:00+00:00", "2019-05-23 16:00:00+00:00", "2019-05-23 15:00:00+00:00", "2019-05-23 14:00:00+00:00", "2019-05-23 13:00:00+00:00", "2019-05-23 12:00:00+00:00", "2019-05-23 11:00:00+00:00", "2019-05-23 10:00:00+00:00", "2019-05-23 09:00:00+00:00", "2019-05-23 08:00:00+00:00", "2019-05-23 07:00:00+00:00", "2019-05-23 06:00:00+00:00", "2019-05-23 05:00:00+00:00", "2019-05-23 04:00:00+00:00", "2019-05-23 03:00:00+00:00", "2019-05-23 02:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-23 00:00:00+00:00", "2019-05-22 23:00:00+00:00", "2019-05-22 22:00:00+00:00", "2019-05-22 21:00:00+00:00", "2019-05-22 20:00:00+00:00", "2019-05-22 19:00:00+00:00", "2019-05-22 18:00:00+00:00", "2019-05-22 17:00:00+00:00", "2019-05-22 16:00:00+00:00", "2019-05-22 15:00:00+00:00", "2019-05-22 14:00:00+00:00", "2019-05-22 13:00:00+00:00", "2019-05-22 12:00:00+00:00", "2019-05-22 11:00:00+00:00", "2019-05-22 10:00:00+00:00", "2019-05-22 09:00:00+00:00", "2019-05-22 08:00:00+00:00", "2019-05-22 07:00:00+00:00", "2019-05-22 06:00:00+00:00", "2019-05-22 05:00:00+00:00", "2019-05-22 04:00:00+00:00", "2019-05-22 03:00:00+00:00", "2019-05-22 02:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-22 00:00:00+00:00", "2019-05-21 23:00:00+00:00", "2019-05-21 22:00:00+00:00", "2019-05-21 21:00:00+00:00", "2019-05-21 20:00:00+00:00", "2019-05-21 19:00:00+00:00", "2019-05-21 18:00:00+00:00", "2019-05-21 17:00:00+00:00", "2019-05-21 16:00:00+00:00", "2019-05-21 15:00:00+00:00", "2019-05-21 14:00:00+00:00", "2019-05-21 13:00:00+00:00", "2019-05-21 12:00:00+00:00", "2019-05-21 11:00:00+00:00", "2019-05-21 10:00:00+00:00", "2019-05-21 09:00:00+00:00", "2019-05-21 08:00:00+00:00", "2019-05-21 07:00:00+00:00", "2019-05-21 06:00:00+00:00", "2019-05-21 05:00:00+00:00", "2019-05-21 04:00:00+00:00", "2019-05-21 03:00:00+00:00", "2019-05-21 02:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-21 00:00:00+00:00", "2019-05-20 23:00:00+00:00", "2019-05-20 22:00:00+00:00", "2019-05-20 21:00:00+00:00", "2019-05-20 20:00:00+00:00", "2019-05-20 19:00:00+00:00", "2019-05-20 18:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 17:00:00+00:00", "2019-05-18 16:00:00+00:00", "2019-05-18 15:00:00+00:00", "2019-05-18 14:00:00+00:00", "2019-05-18 13:00:00+00:00", "2019-05-18 12:00:00+00:00", "2019-05-18 11:00:00+00:00", "2019-05-18 10:00:00+00:00", "2019-05-18 09:00:00+00:00", "2019-05-18 08:00:00+00:00", "2019-05-18 07:00:00+00:00", "2019-05-18 06:00:00+00:00", "2019-05-18 05:00:00+00:00", "2019-05-18 04:00:00+00:00", "2019-05-18 03:00:00+00:00", "2019-05-18 02:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-18 00:00:00+00:00", "2019-05-17 23:00:00+00:00", "2019-05-17 22:00:00+00:00", "2019-05-17 21:00:00+00:00", "2019-05-17 20:00:00+00:00", "2019-05-17 19:00:00+00:00", "2019-05-17 18:00:00+00:00", "2019-05-17 17:00:00+00:00", "2019-05-17 16:00:00+00:00", "2019-05-17 15:00:00+00:00", "2019-05-17 14:00:00+00:00", "2019-05-17 13:00:00+00:00", "2019-05-17 12:00:00+00:00", "2019-05-17 11:00:00+00:00", "2019-05-17 10:00:00+00:00", "2019-05-17 09:00:00+00:00", "2019-05-17 08:00:00+00:00", "2019-05-17 07:00:00+00:00", "2019-05-17 06:00:00+00:00", "2019-05-17 05:00:00+00:00", "2019-05-17 04:00:00+00:00", "2019-05-17 03:00:00+00:00", "2019-05-17 02:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-17 00:00:00+00:00", "2019-05-16 23:00:00+00:00", "2019-05-16 22:00:00+00:00", "2019-05-16 21:00:00+00:00", "2019-05-16 20:00:00+00:00", "2019-05-16 19:00:00+00:00", "2019-05-16 18:00:00+00:00", "2019-05-16 17:00:00+00:00", "2019-05-16 16:00:00+00:00", "2019-05-16 15:00:00+00:00", "2019-05-16 14:00:00+00:00", "2019-05-16 13:00:00+00:00", "2019-05-16 12:00:00+00:00", "2019-05-16 11:00:00+00:00", "2019-05-16 10:00:00+00:00", "2019-05-16 09:00:00+00:00", "2019-05-16 08:00:00+00:00", "2019-05-16 07:00:00+00:00", "2019-05-16 05:00:00+00:00", "2019-05-16 04:00:00+00:00", "2019-05-16 03:00:00+00:00", "2019-05-16 02:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-16 00:00:00+00:00", "2019-05-15 23:00:00+00:00", "2019-05-15 22:00:00+00:00", "2019-05-15 21:00:00+00:00", "2019-05-15 20:00:00+00:00", "2019-05-15 19:00:00+00:00", "2019-05-15 18:00:00+00:00", "2019-05-15 17:00:00+00:00", "2019-05-15 16:00:00+00:00", "2019-05-15 15:00:00+00:00", "2019-05-15 14:00:00+00:00", "2019-05-15 13:00:00+00:00", "2019-05-15 12:00:00+00:00", "2019-05-15 11:00:00+00:00", "2019-05-15 10:00:00+00:00", "2019-05-15 09:00:00+00:00", "2019-05-15 08:00:00+00:00", "2019-05-15 07:00:00+00:00", "2019-05-15 06:00:00+00:00", "2019-05-15 05:00:00+00:00", "2019-05-15 04:00:00+00:00", "2019-05-15 03:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 01:00:00+00:00", "2019-05-15 00:00:00+00:00", "2019-05-14 23:00:00+00:00", "2019-05-14 22:00:00+00:00", "2019-05-14 21:00:00+00:00", "2019-05-14 20:00:00+00:00", "2019-05-14 19:00:00+00:00", "2019-05-14 18:00:00+00:00", "2019-05-14 17:00:00+00:00", "2019-05-14 16:00:00+00:00", "2019-05-14 15:00:00+00:00", "2019-05-14 14:00:00+00:00", "2019-05-14 13:00:00+00:00", "2019-05-14 12:00:00+00:00", "2019-05-14 11:00:00+00:00", "2019-05-14 10:00:00+00:00", "2019-05-14 09:00:00+00:00", "2019-05-14 08:00:00+00:00", "2019-05-14 07:00:00+00:00", "2019-05-14 06:00:00+00:00", "2019-05-14 05:00:00+00:00", "2019-05-14 04:00:00+00:00", "2019-05-14 03:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-14 00:00:00+00:00", "2019-05-13 23:00:00+00:00", "2019-05-13 22:00:00+00:00", "2019-05-13 21:00:00+00:00", "2019-05-13 20:00:00+00:00", "2019-05-13 19:00:00+00:00", "2019-05-13 18:00:00+00:00", "2019-05-13 17:00:00+00:00", "2019-05-13 16:00:00+00:00", "2019-05-13 15:00:00+00:00", "2019-05-13 14:00:00+00:00", "2019-05-13 13:00:00+00:00", "2019-05-13 12:00:00+00:00", "2019-05-13 11:00:00+00:00", "2019-05-13 10:00:00+00:00", "2019-05-13 09:00:00+00:00", "2019-05-13 08:00:00+00:00", "2019-05-13 07:00:00+00:00", "2019-05-13 06:00:00+00:00", "2019-05-13 05:00:00+00:00", "2019-05-13 04:00:00+00:00", "2019-05-13 03:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-13 00:00:00+00:00", "2019-05-12 23:00:00+00:00", "2019-05-12 22:00:00+00:00", "2019-05-12 21:00:00+00:00", "2019-05-12 20:00:00+00:00", "2019-05-12 19:00:00+00:00", "2019-05-12 18:00:00+00:00", "2019-05-12 17:00:00+00:00", "2019-05-12 16:00:00+00:00", "2019-05-12 15:00:00+00:00", "2019-05-12 14:00:00+00:00", "2019-05-12 13:00:00+00:00", "2019-05-12 12:00:00+00:00", "2019-05-12 11:00:00+00:00", "2019-05-12 10:00:00+00:00", "2019-05-12 09:00:00+00:00", "2019-05-12 08:00:00+00:00", "2019-05-12 07:00:00+00:00", "2019-05-12 06:00:00+00:00", "2019-05-12 05:00:00+00:00", "2019-05-12 04:00:00+00:00", "2019-05-12 03:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-12 00:00:00+00:00", "2019-05-11 23:00:00+00:00", "2019-05-11 22:00:00+00:00", "2019-05-11 21:00:00+00:00", "2019-05-11 20:00:00+00:00", "2019-05-11 19:00:00+00:00", "2019-05-11 18:00:00+00:00", "2019-05-11 17:00:00+00:00", "2019-05-11 16:00:00+00:00", "2019-05-11 15:00:00+00:00", "2019-05-11 14:00:00+00:00", "2019-05-11 13:00:00+00:00", "2019-05-11 12:00:00+00:00", "2019-05-11 11:00:00+00:00", "2019-05-11 10:00:00+00:00", "2019-05-11 09:00:00+00:00", "2019-05-11 08:00:00+00:00", "2019-05-11 07:00:00+00:00", "2019-05-11 06:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-11 00:00:00+00:00", "2019-05-10 23:00:00+00:00", "2019-05-10 22:00:00+00:00", "2019-05-10 21:00:00+00:00", "2019-05-10 20:00:00+00:00", "2019-05-10 19:00:00+00:00", "2019-05-10 18:00:00+00:00", "2019-05-10 17:00:00+00:00", "2019-05-10 16:00:00+00:00", "2019-05-10 15:00:00+00:00", "2019-05-10 14:00:00+00:00", "2019-05-10 13:00:00+00:00", "2019-05-10 12:00:00+00:00", "2019-05-10 11:00:00+00:00", "2019-05-10 10:00:00+00:00", "2019-05-10 09:00:00+00:00", "2019-05-10 08:00:00+00:00", "2019-05-10 07:00:00+00:00", "2019-05-10 06:00:00+00:00", "2019-05-10 05:00:00+00:00", "2019-05-10 04:00:00+00:00", "2019-05-10 03:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-10 00:00:00+00:00", "2019-05-09 23:00:00+00:00", "2019-05-09 22:00:00+00:00", "2019-05-09 21:00:00+00:00", "2019-05-09 20:00:00+00:00", "2019-05-09 19:00:00+00:00", "2019-05-09 18:00:00+00:00", "2019-05-09 17:00:00+00:00", "2019-05-09 16:00:00+00:00", "2019-05-09 15:00:00+00:00", "2019-05-09 14:00:00+00:00", "2019-05-09 13:00:00+00:00", "2019-05-09 12:00:00+00:00", "2019-05-09 11:00:00+00:00", "2019-05-09 10:00:00+00:00", "2019-05-09 09:00:00+00:00", "2019-05-09 08:00:00+00:00", "2019-05-09 07:00:00+00:00", "2019-05-09 06:00:00+00:00", "2019-05-09 05:00:00+00:00", "2019-05-09 04:00:00+00:00", "2019-05-09 03:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 01:00:00+00:00", "2019-05-09 00:00:00+00:00", "2019-05-08 23:00:00+00:00", "2019-05-08 22:00:00+00:00", "2019-05-08 21:00:00+00:00", "2019-05-08 20:00:00+00:00", "2019-05-08 19:00:00+00:00", "2019-05-08 18:00:00+00:00", "2019-05-08 17:00:00+00:00", "2019-05-08 16:00:00+00:00", "2019-05-08 15:00:00+00:00", "2019-05-08 14:00:00+00:00", "2019-05-08 13:00:00+00:00", "2019-05-08 12:00:00+00:00", "2019-05-08 11:00:00+00:00", "2019-05-08 10:00:00+00:00", "2019-05-08 09:00:00+00:00", "2019-05-08 08:00:00+00:00", "2019-05-08 07:00:00+00:00", "2019-05-08 06:00:00+00:00", "2019-05-08 05:00:00+00:00", "2019-05-08 04:00:00+00:00", "2019-05-08 03:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-08 00:00:00+00:00", "2019-05-07 23:00:00+00:00", "2019-05-07 22:00:00+00:00", "2019-05-07 21:00:00+00:00", "2019-05-07 20:00:00+00:00", "2019-05-07 19:00:00+00:00", "2019-05-07 18:00:00+00:00", "2019-05-07 17:00:00+00:00", "2019-05-07 16:00:00+00:00", "2019-05-07 15:00:00+00:00", "2019-05-07 14:00:00+00:00", "2019-05-07 13:00:00+00:00", "2019-05-07 12:00:00+00:00", "2019-05-07 11:00:00+00:00", "2019-05-07 10:00:00+00:00", "2019-05-07 09:00:00+00:00", "2019-05-07 08:00:00+00:00", "2019-05-07 07:00:00+00:00", "2019-05-07 06:00:00+00:00", "2019-05-07 05:00:00+00:00", "2019-05-07 04:00:00+00:00", "2019-05-07 03:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-07 00:00:00+00:00", "2019-05-06 23:00:00+00:00", "2019-05-06 22:00:00+00:00", "2019-05-06 21:00:00+00:00", "2019-05-06 20:00:00+00:00", "2019-05-06 19:00:00+00:00", "2019-05-06 18:00:00+00:00", "2019-05-06 17:00:00+00:00", "2019-05-06 16:00:00+00:00", "2019-05-06 15:00:00+00:00", "2019-05-06 14:00:00+00:00", "2019-05-06 13:00:00+00:00", "2019-05-06 12:00:00+00:00", "2019-05-06 11:00:00+00:00", "2019-05-06 10:00:00+00:00", "2019-05-06 09:00:00+00:00", "2019-05-06 08:00:00+00:00", "2019-05-06 07:00:00+00:00", "2019-05-06 06:00:00+00:00", "2019-05-06 05:00:00+00:00", "2019-05-06 04:00:00+00:00", "2019-05-06 03:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-06 00:00:00+00:00", "2019-05-05 23:00:00+00:00", "2019-05-05 22:00:00+00:00", "2019-05-05 21:00:00+00:00", "2019-05-05 20:00:00+00:00", "2019-05-05 19:00:00+00:00", "2019-05-05 18:00:00+00:00", "2019-05-05 17:00:00+00:00", "2019-05-05 16:00:00+00:00", "2019-05-05 15:00:00+00:00", "2019-05-05 14:00:00+00:00", "2019-05-05 13:00:00+00:00", "2019-05-05 12:00:00+00:00", "2019-05-05 11:00:00+00:00", "2019-05-05 10:00:00+00:00", "2019-05-05 09:00:00+00:00", "2019-05-05 08:00:00+00:00", "2019-05-05 07:00:00+00:00", "2019-05-05 06:00:00+00:00", "2019-05-05 05:00:00+00:00", "2019-05-05 04:00:00+00:00", "2019-05-05 03:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-05 00:00:00+00:00", "2019-05-04 23:00:00+00:00", "2019-05-04 22:00:00+00:00", "2019-05-04 21:00:00+00:00", "2019-05-04 20:00:00+00:00", "2019-05-04 19:00:00+00:00", "2019-05-04 18:00:00+00:00", "2019-05-04 17:00:00+00:00", "2019-05-04 16:00:00+00:00", "2019-05-04 15:00:00+00:00", "2019-05-04 14:00:00+00:00", "2019-05-04 13:00:00+00:00", "2019-05-04 12:00:00+00:00", "2019-05-04 11:00:00+00:00", "2019-05-04 10:00:00+00:00", "2019-05-04 09:00:00+00:00", "2019-05-04 08:00:00+00:00", "2019-05-04 07:00:00+00:00", "2019-05-04 06:00:00+00:00", "2019-05-04 05:00:00+00:00", "2019-05-04 04:00:00+00:00", "2019-05-04 03:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-04 00:00:00+00:00", "2019-05-03 23:00:00+00:00", "2019-05-03 22:00:00+00:00", "2019-05-03 21:00:00+00:00", "2019-05-03 20:00:00+00:00", "2019-05-03 19:00:00+00:00", "2019-05-03 18:00:00+00:00", "2019-05-03 17:00:00+00:00", "2019-05-03 16:00:00+00:00", "2019-05-03 15:00:00+00:00", "2019-05-03 14:00:00+00:00", "2019-05-03 13:00:00+00:00", "2019-05-03 12:00:00+00:00", "2019-05-03 11:00:00+00:00", "2019-05-03 10:00:00+00:00", "2019-05-03 09:00:00+00:00", "2019-05-03 08:00:00+00:00", "2019-05-03 07:00:00+00:00", "2019-05-03 06:00:00+00:00", "2019-05-03 05:00:00+00:00", "2019-05-03 04:00:00+00:00", "2019-05-03 03:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-03 00:00:00+00:00", "2019-05-02 23:00:00+00:00", "2019-05-02 22:00:00+00:00", "2019-05-02 21:00:00+00:00", "2019-05-02 20:00:00+00:00", "2019-05-02 19:00:00+00:00", "2019-05-02 18:00:00+00:00", "2019-05-02 17:00:00+00:00", "2019-05-02 16:00:00+00:00", "2019-05-02 15:00:00+00:00", "2019-05-02 14:00:00+00:00", "2019-05-02 13:00:00+00:00", "2019-05-02 12:00:00+00:00", "2019-05-02 11:00:00+00:00", "2019-05-02 10:00:00+00:00", "2019-05-02 09:00:00+00:00", "2019-05-02 08:00:00+00:00", "2019-05-02 07:00:00+00:00", "2019-05-02 06:00:00+00:00", "2019-05-02 05:00:00+00:00", "2019-05-02 04:00:00+00:00", "2019-05-02 03:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-02 00:00:00+00:00", "2019-05-01 23:00:00+00:00", "2019-05-01 22:00:00+00:00", "2019-05-01 21:00:00+00:00", "2019-05-01 20:00:00+00:00", "2019-05-01 19:00:00+00:00", "2019-05-01 18:00:00+00:00", "2019-05-01 17:00:00+00:00", "2019-05-01 16:00:00+00:00", "2019-05-01 15:00:00+00:00", "2019-05-01 14:00:00+00:00", "2019-05-01 13:00:00+00:00", "2019-05-01 12:00:00+00:00", "2019-05-01 11:00:00+00:00", "2019-05-01 10:00:00+00:00", "2019-05-01 09:00:00+00:00", "2019-05-01 08:00:00+00:00", "2019-05-01 07:00:00+00:00", "2019-05-01 06:00:00+00:00", "2019-05-01 05:00:00+00:00", "2019-05-01 04:00:00+00:00", "2019-05-01 03:00:00+00:00", "2019-05-01 02:00:00+00:00", "2019-05-01 01:00:00+00:00", "2019-05-01 00:00:00+00:00", "2019-04-30 23:00:00+00:00", "2019-04-30 22:00:00+00:00", "2019-04-30 21:00:00+00:00", "2019-04-30 20:00:00+00:00", "2019-04-30 19:00:00+00:00", "2019-04-30 18:00:00+00:00", "2019-04-30 17:00:00+00:00", "2019-04-30 16:00:00+00:00", "2019-04-30 15:00:00+00:00", "2019-04-30 14:00:00+00:00", "2019-04-30 13:00:00+00:00", "2019-04-30 12:00:00+00:00", "2019-04-30 11:00:00+00:00", "2019-04-30 10:00:00+00:00", "2019-04-30 09:00:00+00:00", "2019-04-30 08:00:00+00:00", "2019-04-30 07:00:00+00:00", "2019-04-30 06:00:00+00:00", "2019-04-30 05:00:00+00:00", "2019-04-30 04:00:00+00:00", "2019-04-30 03:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-30 00:00:00+00:00", "2019-04-29 23:00:00+00:00", "2019-04-29 22:00:00+00:00", "2019-04-29 21:00:00+00:00", "2019-04-29 20:00:00+00:00", "2019-04-29 19:00:00+00:00", "2019-04-29 18:00:00+00:00", "2019-04-29 17:00:00+00:00", "2019-04-29 16:00:00+00:00", "2019-04-29 15:00:00+00:00", "2019-04-29 14:00:00+00:00", "2019-04-29 13:00:00+00:00", "2019-04-29 12:00:00+00:00", "2019-04-29 11:00:00+00:00", "2019-04-29 10:00:00+00:00", "2019-04-29 09:00:00+00:00", "2019-04-29 08:00:00+00:00", "2019-04-29 07:00:00+00:00", "2019-04-29 06:00:00+00:00", "2019-04-29 05:00:00+00:00", "2019-04-29 04:00:00+00:00", "2019-04-29 03:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-29 00:00:00+00:00", "2019-04-28 23:00:00+00:00", "2019-04-28 22:00:00+00:00", "2019-04-28 21:00:00+00:00", "2019-04-28 20:00:00+00:00", "2019-04-28 19:00:00+00:00", "2019-04-28 18:00:00+00:00", "2019-04-28 17:00:00+00:00", "2019-04-28 16:00:00+00:00", "2019-04-28 15:00:00+00:00", "2019-04-28 14:00:00+00:00", "2019-04-28 13:00:00+00:00", "2019-04-28 12:00:00+00:00", "2019-04-28 11:00:00+00:00", "2019-04-28 10:00:00+00:00", "2019-04-28 09:00:00+00:00", "2019-04-28 08:00:00+00:00", "2019-04-28 07:00:00+00:00", "2019-04-28 06:00:00+00:00", "2019-04-28 05:00:00+00:00", "2019-04-28 04:00:00+00:00", "2019-04-28 03:00:00+00:00", "2019-04-28 02:00:00+00:00", "2019-04-28 01:00:00+00:00", "2019-04-28 00:00:00+00:00", "2019-04-27 23:00:00+00:00", "2019-04-27 22:00:00+00:00", "2019-04-27 21:00:00+00:00", "2019-04-27 20:00:00+00:00", "2019-04-27 19:00:00+00:00", "2019-04-27 18:00:00+00:00", "2019-04-27 17:00:00+00:00", "2019-04-27 16:00:00+00:00", "2019-04-27 15:00:00+00:00", "2019-04-27 14:00:00+00:00", "2019-04-27 13:00:00+00:00", "2019-04-27 12:00:00+00:00", "2019-04-27 11:00:00+00:00", "2019-04-27 10:00:00+00:00", "2019-04-27 09:00:00+00:00", "2019-04-27 08:00:00+00:00", "2019-04-27 07:00:00+00:00", "2019-04-27 06:00:00+00:00", "2019-04-27 05:00:00+00:00", "2019-04-27 04:00:00+00:00", "2019-04-27 03:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 01:00:00+00:00", "2019-04-27 00:00:00+00:00", "2019-04-26 23:00:00+00:00", "2019-04-26 22:00:00+00:00", "2019-04-26 21:00:00+00:00", "2019-04-26 20:00:00+00:00", "2019-04-26 19:00:00+00:00", "2019-04-26 18:00:00+00:00", "2019-04-26 17:00:00+00:00", "2019-04-26 16:00:00+00:00", "2019-04-26 15:00:00+00:00", "2019-04-26 14:00:00+00:00", "2019-04-26 13:00:00+00:00", "2019-04-26 12:00:00+00:00", "2019-04-26 11:00:00+00:00", "2019-04-26 10:00:00+00:00", "2019-04-26 09:00:00+00:00", "2019-04-26 08:00:00+00:00", "2019-04-26 07:00:00+00:00", "2019-04-26 06:00:00+00:00", "2019-04-26 05:00:00+00:00", "2019-04-26 04:00:00+00:00", "2019-04-26 03:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-26 00:00:00+00:00", "2019-04-25 23:00:00+00:00", "2019-04-25 22:00:00+00:00", "2019-04-25 21:00:00+00:00", "2019-04-25 20:00:00+00:00", "2019-04-25 19:00:00+00:00", "2019-04-25 18:00:00+00:00", "2019-04-25 17:00:00+00:00", "2019-04-25 16:00:00+00:00", "2019-04-25 15:00:00+00:00", "2019-04-25 14:00:00+00:00", "2019-04-25 13:00:00+00:00", "2019-04-25 12:00:00+00:00", "2019-04-25 11:00:00+00:00", "2019-04-25 10:00:00+00:00", "2019-04-25 09:00:00+00:00", "2019-04-25 08:00:00+00:00", "2019-04-25 07:00:00+00:00", "2019-04-25 06:00:00+00:00", "2019-04-25 05:00:00+00:00", "2019-04-25 04:00:00+00:00", "2019-04-25 03:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 01:00:00+00:00", "2019-04-25 00:00:00+00:00", "2019-04-24 23:00:00+00:00", "2019-04-24 22:00:00+00:00", "2019-04-24 21:00:00+00:00", "2019-04-24 20:00:00+00:00", "2019-04-24 19:00:00+00:00", "2019-04-24 18:00:00+00:00", "2019-04-24 17:00:00+00:00", "2019-04-24 16:00:00+00:00", "2019-04-24 15:00:00+00:00", "2019-04-24 14:00:00+00:00", "2019-04-24 13:00:00+00:00", "2019-04-24 12:00:00+00:00", "2019-04-24 11:00:00+00:00", "2019-04-24 10:00:00+00:00", "2019-04-24 09:00:00+00:00", "2019-04-24 08:00:00+00:00", "2019-04-24 07:00:00+00:00", "2019-04-24 06:00:00+00:00", "2019-04-24 05:00:00+00:00", "2019-04-24 04:00:00+00:00", "2019-04-24 03:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 01:00:00+00:00", "2019-04-24 00:00:00+00:00", "2019-04-23 23:00:00+00:00", "2019-04-23 22:00:00+00:00", "2019-04-23 21:00:00+00:00", "2019-04-23 20:00:00+00:00", "2019-04-23 19:00:00+00:00", "2019-04-23 18:00:00+00:00", "2019-04-23 17:00:00+00:00", "2019-04-23 16:00:00+00:00", "2019-04-23 15:00:00+00:00", "2019-04-23 14:00:00+00:00", "2019-04-23 13:00:00+00:00", "2019-04-23 12:00:00+00:00", "2019-04-23 11:00:00+00:00", "2019-04-23 10:00:00+00:00", "2019-04-23 09:00:00+00:00", "2019-04-23 08:00:00+00:00", "2019-04-23 07:00:00+00:00", "2019-04-23 06:00:00+00:00", "2019-04-23 05:00:00+00:00", "2019-04-23 04:00:00+00:00", "2019-04-23 03:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-23 00:00:00+00:00", "2019-04-22 23:00:00+00:00", "2019-04-22 22:00:00+00:00", "2019-04-22 21:00:00+00:00", "2019-04-22 20:00:00+00:00", "2019-04-22 19:00:00+00:00", "2019-04-22 18:00:00+00:00", "2019-04-22 17:00:00+00:00", "2019-04-22 16:00:00+00:00", "2019-04-22 15:00:00+00:00", "2019-04-22 14:00:00+00:00", "2019-04-22 13:00:00+00:00", "2019-04-22 12:00:00+00:00", "2019-04-22 11:00:00+00:00", "2019-04-22 10:00:00+00:00", "2019-04-22 09:00:00+00:00", "2019-04-22 08:00:00+00:00", "2019-04-22 07:00:00+00:00", "2019-04-22 06:00:00+00:00", "2019-04-22 05:00:00+00:00", "2019-04-22 04:00:00+00:00", "2019-04-22 03:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-22 00:00:00+00:00", "2019-04-21 23:00:00+00:00", "2019-04-21 22:00:00+00:00", "2019-04-21 21:00:00+00:00", "2019-04-21 20:00:00+00:00", "2019-04-21 19:00:00+00:00", "2019-04-21 18:00:00+00:00", "2019-04-21 17:00:00+00:00", "2019-04-21 16:00:00+00:00", "2019-04-21 15:00:00+00:00", "2019-04-21 14:00:00+00:00", "2019-04-21 13:00:00+00:00", "2019-04-21 12:00:00+00:00", "2019-04-21 11:00:00+00:00", "2019-04-21 10:00:00+00:00", "2019-04-21 09:00:00+00:00", "2019-04-21 08:00:00+00:00", "2019-04-21 07:00:00+00:00", "2019-04-21 06:00:00+00:00", "2019-04-21 05:00:00+00:00", "2019-04-21 04:00:00+00:00", "2019-04-21 03:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-21 00:00:00+00:00", "2019-04-20 23:00:00+00:00", "2019-04-20 22:00:00+00:00", "2019-04-20 21:00:00+00:00", "2019-04-20 20:00:00+00:00", "2019-04-20 19:00:00+00:00", "2019-04-20 18:00:00+00:00", "2019-04-20 17:00:00+00:00", "2019-04-20 16:00:00+00:00", "2019-04-20 15:00:00+00:00", "2019-04-20 14:00:00+00:00", "2019-04-20 13:00:00+00:00", "2019-04-20 12:00:00+00:00", "2019-04-20 11:00:00+00:00", "2019-04-20 10:00:00+00:00", "2019-04-20 09:00:00+00:00", "2019-04-20 08:00:00+00:00", "2019-04-20 07:00:00+00:00", "2019-04-20 06:00:00+00:00", "2019-04-20 05:00:00+00:00", "2019-04-20 04:00:00+00:00", "2019-04-20 03:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-20 00:00:00+00:00", "2019-04-19 23:00:00+00:00", "2019-04-19 22:00:00+00:00", "2019-04-19 21:00:00+00:00", "2019-04-19 20:00:00+00:00", "2019-04-19 19:00:00+00:00", "2019-04-19 18:00:00+00:00", "2019-04-19 17:00:00+00:00", "2019-04-19 16:00:00+00:00", "2019-04-19 15:00:00+00:00", "2019-04-19 14:00:00+00:00", "2019-04-19 13:00:00+00:00", "2019-04-19 12:00:00+00:00", "2019-04-19 11:00:00+00:00", "2019-04-19 10:00:00+00:00", "2019-04-19 09:00:00+00:00", "2019-04-19 08:00:00+00:00", "2019-04-19 07:00:00+00:00", "2019-04-19 06:00:00+00:00", "2019-04-19 05:00:00+00:00", "2019-04-19 04:00:00+00:00", "2019-04-19 03:00:00+00:00", "2019-04-19 02:00:00+00:00", "2019-04-19 01:00:00+00:00", "2019-04-19 00:00:00+00:00", "2019-04-18 23:00:00+00:00", "2019-04-18 22:00:00+00:00", "2019-04-18 21:00:00+00:00", "2019-04-18 20:00:00+00:00", "2019-04-18 19:00:00+00:00", "2019-04-18 18:00:00+00:00", "2019-04-18 17:00:00+00:00", "2019-04-18 16:00:00+00:00", "2019-04-18 15:00:00+00:00", "2019-04-18 14:00:00+00:00", "2019-04-18 13:00:00+00:00", "2019-04-18 12:00:00+00:00", "2019-04-18 11:00:00+00:00", "2019-04-18 10:00:00+00:00", "2019-04-18 09:00:00+00:00", "2019-04-18 08:00:00+00:00", "2019-04-18 07:00:00+00:00", "2019-04-18 06:00:00+00:00", "2019-04-18 05:00:00+00:00", "2019-04-18 04:00:00+00:00", "2019-04-18 03:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-18 00:00:00+00:00", "2019-04-17 23:00:00+00:00", "2019-04-17 22:00:00+00:00", "2019-04-17 21:00:00+00:00", "2019-04-17 20:00:00+00:00", "2019-04-17 19:00:00+00:00", "2019-04-17 18:00:00+00:00", "2019-04-17 17:00:00+00:00", "2019-04-17 16:00:00+00:00", "2019-04-17 15:00:00+00:00", "2019-04-17 14:00:00+00:00", "2019-04-17 13:00:00+00:00", "2019-04-17 12:00:00+00:00", "2019-04-17 11:00:00+00:00", "2019-04-17 10:00:00+00:00", "2019-04-17 09:00:00+00:00", "2019-04-17 08:00:00+00:00", "2019-04-17 07:00:00+00:00", "2019-04-17 06:00:00+00:00", "2019-04-17 05:00:00+00:00", "2019-04-17 04:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 01:00:00+00:00", "2019-04-17 00:00:00+00:00", "2019-04-16 23:00:00+00:00", "2019-04-16 22:00:00+00:00", "2019-04-16 21:00:00+00:00", "2019-04-16 20:00:00+00:00", "2019-04-16 19:00:00+00:00", "2019-04-16 18:00:00+00:00", "2019-04-16 17:00:00+00:00", "2019-04-16 16:00:00+00:00", "2019-04-16 15:00:00+00:00", "2019-04-16 14:00:00+00:00", "2019-04-16 13:00:00+00:00", "2019-04-16 12:00:00+00:00", "2019-04-16 11:00:00+00:00", "2019-04-16 10:00:00+00:00", "2019-04-16 09:00:00+00:00", "2019-04-16 08:00:00+00:00", "2019-04-16 07:00:00+00:00", "2019-04-16 06:00:00+00:00", "2019-04-16 05:00:00+00:00", "2019-04-16 04:00:00+00:00", "2019-04-16 03:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 01:00:00+00:00", "2019-04-16 00:00:00+00:00", "2019-04-15 23:00:00+00:00", "2019-04-15 22:00:00+00:00", "2019-04-15 21:00:00+00:00", "2019-04-15 20:00:00+00:00", "2019-04-15 19:00:00+00:00", "2019-04-15 18:00:00+00:00", "2019-04-15 17:00:00+00:00", "2019-04-15 16:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-15 00:00:00+00:00", "2019-04-14 23:00:00+00:00", "2019-04-14 22:00:00+00:00", "2019-04-14 21:00:00+00:00", "2019-04-14 20:00:00+00:00", "2019-04-14 19:00:00+00:00", "2019-04-14 18:00:00+00:00", "2019-04-14 17:00:00+00:00", "2019-04-14 16:00:00+00:00", "2019-04-14 15:00:00+00:00", "2019-04-14 14:00:00+00:00", "2019-04-14 13:00:00+00:00", "2019-04-14 12:00:00+00:00", "2019-04-14 11:00:00+00:00", "2019-04-14 10:00:00+00:00", "2019-04-14 09:00:00+00:00", "2019-04-14 08:00:00+00:00", "2019-04-14 07:00:00+00:00", "2019-04-14 06:00:00+00:00", "2019-04-14 05:00:00+00:00", "2019-04-14 04:00:00+00:00", "2019-04-14 03:00:00+00:00", "2019-04-14 02:00:00+00:00", "2019-04-14 01:00:00+00:00", "2019-04-14 00:00:00+00:00", "2019-04-13 23:00:00+00:00", "2019-04-13 22:00:00+00:00", "2019-04-13 21:00:00+00:00", "2019-04-13 20:00:00+00:00", "2019-04-13 19:00:00+00:00", "2019-04-13 18:00:00+00:00", "2019-04-13 17:00:00+00:00", "2019-04-13 16:00:00+00:00", "2019-04-13 15:00:00+00:00", "2019-04-13 14:00:00+00:00", "2019-04-13 13:00:00+00:00", "2019-04-13 12:00:00+00:00", "2019-04-13 11:00:00+00:00", "2019-04-13 10:00:00+00:00", "2019-04-13 09:00:00+00:00", "2019-04-13 08:00:00+00:00", "2019-04-13 07:00:00+00:00", "2019-04-13 06:00:00+00:00", "2019-04-13 05:00:00+00:00", "2019-04-13 04:00:00+00:00", "2019-04-13 03:00:00+00:00", "2019-04-13 02:00:00+00:00", "2019-04-13 01:00:00+00:00", "2019-04-13 00:00:00+00:00", "2019-04-12 23:00:00+00:00", "2019-04-12 22:00:00+00:00", "2019-04-12 21:00:00+00:00", "2019-04-12 20:00:00+00:00", "2019-04-12 19:00:00+00:00", "2019-04-12 18:00:00+00:00", "2019-04-12 17:00:00+00:00", "2019-04-12 16:00:00+00:00", "2019-04-12 15:00:00+00:00", "2019-04-12 14:00:00+00:00", "2019-04-12 13:00:00+00:00", "2019-04-12 12:00:00+00:00", "2019-04-12 11:00:00+00:00", "2019-04-12 10:00:00+00:00", "2019-04-12 09:00:00+00:00", "2019-04-12 08:00:00+00:00", "2019-04-12 07:00:00+00:00", "2019-04-12 06:00:00+00:00", "2019-04-12 05:00:00+00:00", "2019-04-12 04:00:00+00:00", "2019-04-12 03:00:00+00:00", "2019-04-12 02:00:00+00:00", "2019-04-12 01:00:00+00:00", "2019-04-12 00:00:00+00:00", "2019-04-11 23:00:00+00:00", "2019-04-11 22:00:00+00:00", "2019-04-11 21:00:00+00:00", "2019-04-11 20:00:00+00:00", "2019-04-11 19:00:00+00:00", "2019-04-11 18:00:00+00:00", "2019-04-11 17:00:00+00:00", "2019-04-11 16:00:00+00:00", "2019-04-11 15:00:00+00:00", "2019-04-11 14:00:00+00:00", "2019-04-11 13:00:00+00:00", "2019-04-11 12:00:00+00:00", "2019-04-11 11:00:00+00:00", "2019-04-11 10:00:00+00:00", "2019-04-11 09:00:00+00:00", "2019-04-11 08:00:00+00:00", "2019-04-11 07:00:00+00:00", "2019-04-11 06:00:00+00:00", "2019-04-11 05:00:00+00:00", "2019-04-11 04:00:00+00:00", "2019-04-11 03:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 01:00:00+00:00", "2019-04-11 00:00:00+00:00", "2019-04-10 23:00:00+00:00", "2019-04-10 22:00:00+00:00", "2019-04-10 21:00:00+00:00", "2019-04-10 20:00:00+00:00", "2019-04-10 19:00:00+00:00", "2019-04-10 18:00:00+00:00", "2019-04-10 17:00:00+00:00", "2019-04-10 16:00:00+00:00", "2019-04-10 15:00:00+00:00", "2019-04-10 14:00:00+00:00", "2019-04-10 13:00:00+00:00", "2019-04-10 12:00:00+00:00", "2019-04-10 11:00:00+00:00", "2019-04-10 10:00:00+00:00", "2019-04-10 09:00:00+00:00", "2019-04-10 08:00:00+00:00", "2019-04-10 07:00:00+00:00", "2019-04-10 06:00:00+00:00", "2019-04-10 05:00:00+00:00", "2019-04-10 04:00:00+00:00", "2019-04-10 03:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-10 00:00:00+00:00", "2019-04-09 23:00:00+00:00", "2019-04-09 22:00:00+00:00", "2019-04-09 21:00:00+00:00", "2019-04-09 20:00:00+00:00", "2019-04-09 19:00:00+00:00", "2019-04-09 18:00:00+00:00", "2019-04-09 17:00:00+00:00", "2019-04-09 16:00:00+00:00", "2019-04-09 15:00:00+00:00", "2019-04-09 14:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00", "2019-04-09 01:00:00+00:00",
+// 					},
+// 					"5a19d02982add20b2d3c0bc86795c532f97f8ad4642548abe3e854fdfbd68612c4b9d89b608c3423a3b10c39290f6522d59858517d29b4272959ff4a96759770": {
+// 						"2019-06-17 11:00:00+00:00", "2019-06-17 10:00:00+00:00", "2019-06-17 09:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-17 00:00:00+00:00", "2019-06-16 23:00:00+00:00", "2019-06-16 21:00:00+00:00", "2019-06-16 20:00:00+00:00", "2019-06-16 19:00:00+00:00", "2019-06-16 18:00:00+00:00", "2019-06-16 17:00:00+00:00", "2019-06-16 16:00:00+00:00", "2019-06-16 15:00:00+00:00", "2019-06-16 14:00:00+00:00", "2019-06-16 13:00:00+00:00", "2019-06-16 12:00:00+00:00", "2019-06-16 11:00:00+00:00", "2019-06-16 10:00:00+00:00", "2019-06-16 09:00:00+00:00", "2019-06-16 08:00:00+00:00", "2019-06-16 07:00:00+00:00", "2019-06-16 06:00:00+00:00", "2019-06-16 05:00:00+00:00", "2019-06-16 04:00:00+00:00", "2019-06-16 03:00:00+00:00", "2019-06-16 02:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-16 00:00:00+00:00", "2019-06-15 23:00:00+00:00", "2019-06-15 22:00:00+00:00", "2019-06-15 21:00:00+00:00", "2019-06-15 20:00:00+00:00", "2019-06-15 19:00:00+00:00", "2019-06-15 18:00:00+00:00", "2019-06-15 17:00:00+00:00", "2019-06-15 16:00:00+00:00", "2019-06-15 15:00:00+00:00", "2019-06-15 14:00:00+00:00", "2019-06-15 13:00:00+00:00", "2019-06-15 12:00:00+00:00", "2019-06-15 11:00:00+00:00", "2019-06-15 10:00:00+00:00", "2019-06-15 09:00:00+00:00", "2019-06-15 08:00:00+00:00", "2019-06-15 07:00:00+00:00", "2019-06-15 06:00:00+00:00", "2019-06-15 05:00:00+00:00", "2019-06-15 04:00:00+00:00", "2019-06-15 00:00:00+00:00", "2019-06-14 23:00:00+00:00", "2019-06-14 22:00:00+00:00", "2019-06-14 21:00:00+00:00", "2019-06-14 20:00:00+00:00", "2019-06-14 19:00:00+00:00", "2019-06-14 18:00:00+00:00", "2019-06-14 17:00:00+00:00", "2019-06-14 16:00:00+00:00", "2019-06-14 15:00:00+00:00", "2019-06-14 14:00:00+00:00", "2019-06-14 13:00:00+00:00", "2019-06-14 12:00:00+00:00", "2019-06-14 11:00:00+00:00", "2019-06-14 10:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-14 08:00:00+00:00", "2019-06-14 07:00:00+00:00", "2019-06-14 06:00:00+00:00", "2019-06-14 05:00:00+00:00", "2019-06-14 04:00:00+00:00", "2019-06-14 03:00:00+00:00", "2019-06-14 02:00:00+00:00", "2019-06-14 00:00:00+00:00", "2019-06-13 23:00:00+00:00", "2019-06-13 22:00:00+00:00", "2019-06-13 21:00:00+00:00", "2019-06-13 20:00:00+00:00", "2019-06-13 19:00:00+00:00", "2019-06-13 18:00:00+00:00", "2019-06-13 17:00:00+00:00", "2019-06-13 16:00:00+00:00", "2019-06-13 15:00:00+00:00", "2019-06-13 14:00:00+00:00", "2019-06-13 13:00:00+00:00", "2019-06-13 12:00:00+00:00", "2019-06-13 11:00:00+00:00", "2019-06-13 10:00:00+00:00", "2019-06-13 09:00:00+00:00", "2019-06-13 08:00:00+00:00", "2019-06-13 07:00:00+00:00", "2019-06-13 06:00:00+00:00", "2019-06-13 05:00:00+00:00", "2019-06-13 04:00:00+00:00", "2019-06-13 03:00:00+00:00", "2019-06-13 02:00:00+00:00", "2019-06-13 00:00:00+00:00", "2019-06-12 23:00:00+00:00", "2019-06-12 21:00:00+00:00", "2019-06-12 20:00:00+00:00", "2019-06-12 19:00:00+00:00", "2019-06-12 18:00:00+00:00", "2019-06-12 17:00:00+00:00", "2019-06-12 16:00:00+00:00", "2019-06-12 15:00:00+00:00", "2019-06-12 14:00:00+00:00", "2019-06-12 13:00:00+00:00", "2019-06-12 12:00:00+00:00", "2019-06-12 11:00:00+00:00", "2019-06-12 10:00:00+00:00", "2019-06-12 09:00:00+00:00", "2019-06-12 08:00:00+00:00", "2019-06-12 07:00:00+00:00", "2019-06-12 06:00:00+00:00", "2019-06-12 05:00:00+00:00", "2019-06-12 04:00:00+00:00", "2019-06-12 03:00:00+00:00", "2019-06-12 00:00:00+00:00", "2019-06-11 23:00:00+00:00", "2019-06-11 22:00:00+00:00", "2019-06-11 21:00:00+00:00", "2019-06-11 20:00:00+00:00", "2019-06-11 19:00:00+00:00", "2019-06-11 18:00:00+00:00", "2019-06-11 17:00:00+00:00", "2019-06-11 16:00:00+00:00", "2019-06-11 15:00:00+00:00", "2019-06-11 14:00:00+00:00", "2019-06-11 13:00:00+00:00", "2019-06-11 12:00:00+00:00", "2019-06-11 11:00:00+00:00", "2019-06-11 10:00:00+00:00", "2019-06-11 09:00:00+00:00", "2019-06-11 08:00:00+00:00", "2019-06-11 07:00:00+00:00", "2019-06-11 06:00:00+00:00", "2019-06-11 05:00:00+00:00", "2019-06-11 04:00:00+00:00", "2019-06-11 03:00:00+00:00", "2019-06-11 02:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-11 00:00:00+00:00", "2019-06-10 23:00:00+00:00", "2019-06-10 22:00:00+00:00", "2019-06-10 21:00:00+00:00", "2019-06-10 20:00:00+00:00", "2019-06-10 19:00:00+00:00", "2019-06-10 18:00:00+00:00", "2019-06-10 17:00:00+00:00", "2019-06-10 16:00:00+00:00", "2019-06-10 15:00:00+00:00", "2019-06-10 14:00:00+00:00", "2019-06-10 13:00:00+00:00", "2019-06-10 12:00:00+00:00", "2019-06-10 11:00:00+00:00", "2019-06-10 10:00:00+00:00", "2019-06-10 09:00:00+00:00", "2019-06-10 08:00:00+00:00", "2019-06-10 07:00:00+00:00", "2019-06-10 06:00:00+00:00", "2019-06-10 05:00:00+00:00", "2019-06-10 04:00:00+00:00", "2019-06-10 03:00:00+00:00", "2019-06-10 02:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-10 00:00:00+00:00", "2019-06-09 23:00:00+00:00", "2019-06-09 21:00:00+00:00", "2019-06-09 20:00:00+00:00", "2019-06-09 19:00:00+00:00", "2019-06-09 18:00:00+00:00", "2019-06-09 17:00:00+00:00", "2019-06-09 16:00:00+00:00", "2019-06-09 15:00:00+00:00", "2019-06-09 14:00:00+00:00", "2019-06-09 13:00:00+00:00", "2019-06-09 12:00:00+00:00", "2019-06-09 11:00:00+00:00", "2019-06-09 10:00:00+00:00", "2019-06-09 09:00:00+00:00", "2019-06-09 08:00:00+00:00", "2019-06-09 07:00:00+00:00", "2019-06-09 06:00:00+00:00", "2019-06-09 05:00:00+00:00", "2019-06-09 04:00:00+00:00", "2019-06-09 03:00:00+00:00", "2019-06-09 02:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-09 00:00:00+00:00", "2019-06-08 23:00:00+00:00", "2019-06-08 21:00:00+00:00", "2019-06-08 20:00:00+00:00", "2019-06-08 19:00:00+00:00", "2019-06-08 18:00:00+00:00", "2019-06-08 17:00:00+00:00", "2019-06-08 16:00:00+00:00", "2019-06-08 15:00:00+00:00", "2019-06-08 14:00:00+00:00", "2019-06-08 13:00:00+00:00", "2019-06-08 12:00:00+00:00", "2019-06-08 11:00:00+00:00", "2019-06-08 10:00:00+00:00", "2019-06-08 09:00:00+00:00", "2019-06-08 08:00:00+00:00", "2019-06-08 07:00:00+00:00", "2019-06-08 06:00:00+00:00", "2019-06-08 05:00:00+00:00", "2019-06-08 04:00:00+00:00", "2019-06-08 03:00:00+00:00", "2019-06-08 02:00:00+00:00", "2019-06-08 00:00:00+00:00", "2019-06-07 23:00:00+00:00", "2019-06-07 21:00:00+00:00", "2019-06-07 20:00:00+00:00", "2019-06-07 19:00:00+00:00", "2019-06-07 18:00:00+00:00", "2019-06-07 17:00:00+00:00", "2019-06-07 16:00:00+00:00", "2019-06-07 15:00:00+00:00", "2019-06-07 14:00:00+00:00", "2019-06-07 13:00:00+00:00", "2019-06-07 12:00:00+00:00", "2019-06-07 11:00:00+00:00", "2019-06-07 10:00:00+00:00", "2019-06-07 09:00:00+00:00", "2019-06-07 08:00:00+00:00", "2019-06-07 07:00:00+00:00", "2019-06-07 06:00:00+00:00", "2019-06-07 05:00:00+00:00", "2019-06-07 04:00:00+00:00", "2019-06-07 03:00:00+00:00", "2019-06-07 02:00:00+00:00", "2019-06-07 01:00:00+00:00", "2019-06-07 00:00:00+00:00", "2019-06-06 23:00:00+00:00", "2019-06-06 22:00:00+00:00", "2019-06-06 21:00:00+00:00", "2019-06-06 20:00:00+00:00", "2019-06-06 19:00:00+00:00", "2019-06-06 18:00:00+00:00", "2019-06-06 17:00:00+00:00", "2019-06-06 16:00:00+00:00", "2019-06-06 15:00:00+00:00", "2019-06-06 14:00:00+00:00", "2019-06-06 13:00:00+00:00", "2019-06-06 12:00:00+00:00", "2019-06-06 11:00:00+00:00", "2019-06-06 10:00:00+00:00", "2019-06-06 09:00:00+00:00", "2019-06-06 08:00:00+00:00", "2019-06-06 07:00:00+00:00", "2019-06-06 06:00:00+00:00", "2019-06-06 05:00:00+00:00", "2019-06-06 04:00:00+00:00", "2019-06-06 03:00:00+00:00", "2019-06-06 02:00:00+00:00", "2019-06-06 00:00:00+00:00", "2019-06-05 23:00:00+00:00", "2019-06-05 22:00:00+00:00", "2019-06-05 21:00:00+00:00", "2019-06-05 20:00:00+00:00", "2019-06-05 19:00:00+00:00", "2019-06-05 18:00:00+00:00", "2019-06-05 17:00:00+00:00", "2019-06-05 16:00:00+00:00", "2019-06-05 15:00:00+00:00", "2019-06-05 14:00:00+00:00", "2019-06-05 13:00:00+00:00", "2019-06-05 12:00:00+00:00", "2019-06-05 11:00:00+00:00", "2019-06-05 10:00:00+00:00", "2019-06-05 09:00:00+00:00", "2019-06-05 08:00:00+00:00", "2019-06-05 07:00:00+00:00", "2019-06-05 06:00:00+00:00", "2019-06-05 05:00:00+00:00", "2019-06-05 04:00:00+00:00", "2019-06-05 03:00:00+00:00", "2019-06-05 02:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-05 00:00:00+00:00", "2019-06-04 23:00:00+00:00", "2019-06-04 22:00:00+00:00", "2019-06-04 21:00:00+00:00", "2019-06-04 20:00:00+00:00", "2019-06-04 19:00:00+00:00", "2019-06-04 18:00:00+00:00", "2019-06-04 17:00:00+00:00", "2019-06-04 16:00:00+00:00", "2019-06-04 15:00:00+00:00", "2019-06-04 14:00:00+00:00", "2019-06-04 13:00:00+00:00", "2019-06-04 12:00:00+00:00", "2019-06-04 11:00:00+00:00", "2019-06-04 10:00:00+00:00", "2019-06-04 09:00:00+00:00", "2019-06-04 08:00:00+00:00", "2019-06-04 07:00:00+00:00", "2019-06-04 06:00:00+00:00", "2019-06-04 05:00:00+00:00", "2019-06-04 04:00:00+00:00", "2019-06-04 03:00:00+00:00", "2019-06-04 02:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-04 00:00:00+00:00", "2019-06-03 23:00:00+00:00", "2019-06-03 22:00:00+00:00", "2019-06-03 21:00:00+00:00", "2019-06-03 20:00:00+00:00", "2019-06-03 19:00:00+00:00", "2019-06-03 18:00:00+00:00", "2019-06-03 17:00:00+00:00", "2019-06-03 16:00:00+00:00", "2019-06-03 15:00:00+00:00", "2019-06-03 14:00:00+00:00", "2019-06-03 13:00:00+00:00", "2019-06-03 12:00:00+00:00", "2019-06-03 11:00:00+00:00", "2019-06-03 10:00:00+00:00", "2019-06-03 09:00:00+00:00", "2019-06-03 08:00:00+00:00", "2019-06-03 07:00:00+00:00", "2019-06-03 06:00:00+00:00", "2019-06-03 05:00:00+00:00", "2019-06-03 04:00:00+00:00", "2019-06-03 03:00:00+00:00", "2019-06-03 02:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-03 00:00:00+00:00", "2019-06-02 23:00:00+00:00", "2019-06-02 22:00:00+00:00", "2019-06-02 21:00:00+00:00", "2019-06-02 20:00:00+00:00", "2019-06-02 19:00:00+00:00", "2019-06-02 18:00:00+00:00", "2019-06-02 17:00:00+00:00", "2019-06-02 16:00:00+00:00", "2019-06-02 15:00:00+00:00", "2019-06-02 14:00:00+00:00", "2019-06-02 13:00:00+00:00", "2019-06-02 12:00:00+00:00", "2019-06-02 11:00:00+00:00", "2019-06-02 10:00:00+00:00", "2019-06-02 09:00:00+00:00", "2019-06-02 08:00:00+00:00", "2019-06-02 07:00:00+00:00", "2019-06-02 06:00:00+00:00", "2019-06-02 05:00:00+00:00", "2019-06-02 04:00:00+00:00", "2019-06-02 03:00:00+00:00", "2019-06-02 02:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-02 00:00:00+00:00", "2019-06-01 23:00:00+00:00", "2019-06-01 22:00:00+00:00", "2019-06-01 21:00:00+00:00", "2019-06-01 20:00:00+00:00", "2019-06-01 19:00:00+00:00", "2019-06-01 18:00:00+00:00", "2019-06-01 17:00:00+00:00", "2019-06-01 16:00:00+00:00", "2019-06-01 15:00:00+00:00", "2019-06-01 14:00:00+00:00", "2019-06-01 13:00:00+00:00", "2019-06-01 12:00:00+00:00", "2019-06-01 11:00:00+00:00", "2019-06-01 10:00:00+00:00", "2019-06-01 09:00:00+00:00", "2019-06-01 08:00:00+00:00", "2019-06-01 07:00:00+00:00", "2019-06-01 06:00:00+00:00", "2019-06-01 05:00:00+00:00", "2019-06-01 04:00:00+00:00", "2019-06-01 03:00:00+00:00", "2019-06-01 02:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-06-01 00:00:00+00:00", "2019-05-31 23:00:00+00:00", "2019-05-31 22:00:00+00:00", "2019-05-31 21:00:00+00:00", "2019-05-31 20:00:00+00:00", "2019-05-31 19:00:00+00:00", "2019-05-31 18:00:00+00:00", "2019-05-31 17:00:00+00:00", "2019-05-31 16:00:00+00:00", "2019-05-31 15:00:00+00:00", "2019-05-31 14:00:00+00:00", "2019-05-31 13:00:00+00:00", "2019-05-31 12:00:00+00:00", "2019-05-31 11:00:00+00:00", "2019-05-31 10:00:00+00:00", "2019-05-31 09:00:00+00:00", "2019-05-31 08:00:00+00:00", "2019-05-31 07:00:00+00:00", "2019-05-31 06:00:00+00:00", "2019-05-31 05:00:00+00:00", "2019-05-31 04:00:00+00:00", "2019-05-31 03:00:00+00:00", "2019-05-31 02:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-31 00:00:00+00:00", "2019-05-30 23:00:00+00:00", "2019-05-30 22:00:00+00:00", "2019-05-30 21:00:00+00:00", "2019-05-30 20:00:00+00:00", "2019-05-30 19:00:00+00:00", "2019-05-30 18:00:00+00:00", "2019-05-30 17:00:00+00:00", "2019-05-30 16:00:00+00:00", "2019-05-30 15:00:00+00:00", "2019-05-30 14:00:00+00:00", "2019-05-30 13:00:00+00:00", "2019-05-30 12:00:00+00:00", "2019-05-30 11:00:00+00:00", "2019-05-30 10:00:00+00:00", "2019-05-30 09:00:00+00:00", "2019-05-30 08:00:00+00:00", "2019-05-30 07:00:00+00:00", "2019-05-30 06:00:00+00:00", "2019-05-30 05:00:00+00:00", "2019-05-30 04:00:00+00:00", "2019-05-30 03:00:00+00:00", "2019-05-30 02:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-30 00:00:00+00:00", "2019-05-29 23:00:00+00:00", "2019-05-29 22:00:00+00:00", "2019-05-29 21:00:00+00:00", "2019-05-29 20:00:00+00:00", "2019-05-29 19:00:00+00:00", "2019-05-29 18:00:00+00:00", "2019-05-29 17:00:00+00:00", "2019-05-29 16:00:00+00:00", "2019-05-29 15:00:00+00:00", "2019-05-29 14:00:00+00:00", "2019-05-29 13:00:00+00:00", "2019-05-29 12:00:00+00:00", "2019-05-29 11:00:00+00:00", "2019-05-29 10:00:00+00:00", "2019-05-29 09:00:00+00:00", "2019-05-29 08:00:00+00:00", "2019-05-29 07:00:00+00:00", "2019-05-29 06:00:00+00:00", "2019-05-29 05:00:00+00:00", "2019-05-29 04:00:00+00:00", "2019-05-29 03:00:00+00:00", "2019-05-29 02:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-29 00:00:00+00:00", "2019-05-28 23:00:00+00:00", "2019-05-28 21:00:00+00:00", "2019-05-28 20:00:00+00:00", "2019-05-28 19:00:00+00:00", "2019-05-28 18:00:00+00:00", "2019-05-28 17:00:00+00:00", "2019-05-28 16:00:00+00:00", "2019-05-28 15:00:00+00:00", "2019-05-28 14:00:00+00:00", "2019-05-28 13:00:00+00:00", "2019-05-28 12:00:00+00:00", "2019-05-28 11:00:00+00:00", "2019-05-28 10:00:00+00:00", "2019-05-28 09:00:00+00:00", "2019-05-28 08:00:00+00:00", "2019-05-28 07:00:00+00:00", "2019-05-28 06:00:00+00:00", "2019-05-28 05:00:00+00:00", "2019-05-28 04:00:00+00:00", "2019-05-28 03:00:00+00:00", "2019-05-28 02:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-28 00:00:00+00:00", "2019-05-27 23:00:00+00:00", "2019-05-27 22:00:00+00:00", "2019-05-27 21:00:00+00:00", "2019-05-27 20:00:00+00:00", "2019-05-27 19:00:00+00:00", "2019-05-27 18:00:00+00:00", "2019-05-27 17:00:00+00:00", "2019-05-27 16:00:00+00:00", "2019-05-27 15:00:00+00:00", "2019-05-27 14:00:00+00:00", "2019-05-27 13:00:00+00:00", "2019-05-27 12:00:00+00:00", "2019-05-27 11:00:00+00:00", "2019-05-27 10:00:00+00:00", "2019-05-27 09:00:00+00:00", "2019-05-27 08:00:00+00:00", "2019-05-27 07:00:00+00:00", "2019-05-27 06:00:00+00:00", "2019-05-27 05:00:00+00:00", "2019-05-27 04:00:00+00:00", "2019-05-27 03:00:00+00:00", "2019-05-27 02:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-27 00:00:00+00:00", "2019-05-26 23:00:00+00:00", "2019-05-26 22:00:00+00:00", "2019-05-26 21:00:00+00:00", "2019-05-26 20:00:00+00:00", "2019-05-26 19:00:00+00:00", "2019-05-26 18:00:00+00:00", "2019-05-26 17:00:00+00:00", "2019-05-26 16:00:00+00:00", "2019-05-26 15:00:00+00:00", "2019-05-26 14:00:00+00:00", "2019-05-26 13:00:00+00:00", "2019-05-26 12:00:00+00:00", "2019-05-26 11:00:00+00:00", "2019-05-26 10:00:00+00:00", "2019-05-26 09:00:00+00:00", "2019-05-26 08:00:00+00:00", "2019-05-26 07:00:00+00:00", "2019-05-26 06:00:00+00:00", "2019-05-26 05:00:00+00:00", "2019-05-26 04:00:00+00:00", "2019-05-26 03:00:00+00:00", "2019-05-26 02:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-26 00:00:00+00:00", "2019-05-25 23:00:00+00:00", "2019-05-25 22:00:00+00:00", "2019-05-25 21:00:00+00:00", "2019-05-25 20:00:00+00:00", "2019-05-25 19:00:00+00:00", "2019-05-25 18:00:00+00:00", "2019-05-25 17:00:00+00:00", "2019-05-25 16:00:00+00:00", "2019-05-25 15:00:00+00:00", "2019-05-25 14:00:00+00:00", "2019-05-25 13:00:00+00:00", "2019-05-25 12:00:00+00:00", "2019-05-25 11:00:00+00:00", "2019-05-25 10:00:00+00:00", "2019-05-25 09:00:00+00:00", "2019-05-25 08:00:00+00:00", "2019-05-25 07:00:00+00:00", "2019-05-25 06:00:00+00:00", "2019-05-25 05:00:00+00:00", "2019-05-25 04:00:00+00:00", "2019-05-25 03:00:00+00:00", "2019-05-25 02:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-25 00:00:00+00:00", "2019-05-24 23:00:00+00:00", "2019-05-24 22:00:00+00:00", "2019-05-24 21:00:00+00:00", "2019-05-24 20:00:00+00:00", "2019-05-24 19:00:00+00:00", "2019-05-24 18:00:00+00:00", "2019-05-24 17:00:00+00:00", "2019-05-24 16:00:00+00:00", "2019-05-24 15:00:00+00:00", "2019-05-24 14:00:00+00:00", "2019-05-24 13:00:00+00:00", "2019-05-24 12:00:00+00:00", "2019-05-24 11:00:00+00:00", "2019-05-24 10:00:00+00:00", "2019-05-24 09:00:00+00:00", "2019-05-24 08:00:00+00:00", "2019-05-24 07:00:00+00:00", "2019-05-24 06:00:00+00:00", "2019-05-24 05:00:00+00:00", "2019-05-24 04:00:00+00:00", "2019-05-24 03:00:00+00:00", "2019-05-24 02:00:00+00:00", "2019-05-24 00:00:00+00:00", "2019-05-23 23:00:00+00:00", "2019-05-23 22:00:00+00:00", "2019-05-23 21:00:00+00:00", "2019-05-23 20:00:00+00:00", "2019-05-23 19:00:00+00:00", "2019-05-23 18:00:00+00:00", "2019-05-23 17:00:00+00:00", "2019-05-23 16:00:00+00:00", "2019-05-23 15:00:00+00:00", "2019-05-23 14:00:00+00:00", "2019-05-23 13:00:00+00:00", "2019-05-23 12:00:00+00:00", "2019-05-23 11:00:00+00:00", "2019-05-23 10:00:00+00:00", "2019-05-23 09:00:00+00:00", "2019-05-23 08:00:00+00:00", "2019-05-23 07:00:00+00:00", "2019-05-23 06:00:00+00:00", "2019-05-23 05:00:00+00:00", "2019-05-23 04:00:00+00:00", "2019-05-23 03:00:00+00:00", "2019-05-23 02:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-23 00:00:00+00:00", "2019-05-22 23:00:00+00:00", "2019-05-22 22:00:00+00:00", "2019-05-22 21:00:00+00:00", "2019-05-22 20:00:00+00:00", "2019-05-22 19:00:00+00:00", "2019-05-22 18:00:00+00:00", "2019-05-22 17:00:00+00:00", "2019-05-22 16:00:00+00:00", "2019-05-22 15:00:00+00:00", "2019-05-22 14:00:00+00:00", "2019-05-22 13:00:00+00:00", "2019-05-22 12:00:00+00:00", "2019-05-22 11:00:00+00:00", "2019-05-22 10:00:00+00:00", "2019-05-22 09:00:00+00:00", "2019-05-22 08:00:00+00:00", "2019-05-22 07:00:00+00:00", "2019-05-22 06:00:00+00:00", "2019-05-22 05:00:00+00:00", "2019-05-22 04:00:00+00:00", "2019-05-22 03:00:00+00:00", "2019-05-22 02:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-22 00:00:00+00:00", "2019-05-21 23:00:00+00:00", "2019-05-21 22:00:00+00:00", "2019-05-21 21:00:00+00:00", "2019-05-21 20:00:00+00:00", "2019-05-21 19:00:00+00:00", "2019-05-21 18:00:00+00:00", "2019-05-21 17:00:00+00:00", "2019-05-21 16:00:00+00:00", "2019-05-21 15:00:00+00:00", "2019-05-21 14:00:00+00:00", "2019-05-21 13:00:00+00:00", "2019-05-21 12:00:00+00:00", "2019-05-21 11:00:00+00:00", "2019-05-21 10:00:00+00:00", "2019-05-21 09:00:00+00:00", "2019-05-21 08:00:00+00:00", "2019-05-21 07:00:00+00:00", "2019-05-21 06:00:00+00:00", "2019-05-21 05:00:00+00:00", "2019-05-21 04:00:00+00:00", "2019-05-21 03:00:00+00:00", "2019-05-21 02:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-21 00:00:00+00:00", "2019-05-20 23:00:00+00:00", "2019-05-20 22:00:00+00:00", "2019-05-20 21:00:00+00:00", "2019-05-20 20:00:00+00:00", "2019-05-20 19:00:00+00:00", "2019-05-20 18:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 17:00:00+00:00", "2019-05-18 16:00:00+00:00", "2019-05-18 15:00:00+00:00", "2019-05-18 14:00:00+00:00", "2019-05-18 13:00:00+00:00", "2019-05-18 12:00:00+00:00", "2019-05-18 11:00:00+00:00", "2019-05-18 10:00:00+00:00", "2019-05-18 09:00:00+00:00", "2019-05-18 08:00:00+00:00", "2019-05-18 07:00:00+00:00", "2019-05-18 06:00:00+00:00", "2019-05-18 05:00:00+00:00", "2019-05-18 04:00:00+00:00", "2019-05-18 03:00:00+00:00", "2019-05-18 02:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-18 00:00:00+00:00", "2019-05-17 23:00:00+00:00", "2019-05-17 22:00:00+00:00", "2019-05-17 21:00:00+00:00", "2019-05-17 20:00:00+00:00", "2019-05-17 19:00:00+00:00", "2019-05-17 18:00:00+00:00", "2019-05-17 17:00:00+00:00", "2019-05-17 16:00:00+00:00", "2019-05-17 15:00:00+00:00", "2019-05-17 14:00:00+00:00", "2019-05-17 13:00:00+00:00", "2019-05-17 12:00:00+00:00", "2019-05-17 11:00:00+00:00", "2019-05-17 10:00:00+00:00", "2019-05-17 09:00:00+00:00", "2019-05-17 08:00:00+00:00", "2019-05-17 07:00:00+00:00", "2019-05-17 06:00:00+00:00", "2019-05-17 05:00:00+00:00", "2019-05-17 04:00:00+00:00", "2019-05-17 03:00:00+00:00", "2019-05-17 02:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-17 00:00:00+00:00", "2019-05-16 23:00:00+00:00", "2019-05-16 22:00:00+00:00", "2019-05-16 21:00:00+00:00", "2019-05-16 20:00:00+00:00", "2019-05-16 19:00:00+00:00", "2019-05-16 18:00:00+00:00", "2019-05-16 17:00:00+00:00", "2019-05-16 16:00:00+00:00", "2019-05-16 15:00:00+00:00", "2019-05-16 14:00:00+00:00", "2019-05-16 13:00:00+00:00", "2019-05-16 12:00:00+00:00", "2019-05-16 11:00:00+00:00", "2019-05-16 10:00:00+00:00", "2019-05-16 09:00:00+00:00", "2019-05-16 08:00:00+00:00", "2019-05-16 07:00:00+00:00", "2019-05-16 06:00:00+00:00", "2019-05-16 05:00:00+00:00", "2019-05-16 04:00:00+00:00", "2019-05-16 03:00:00+00:00", "2019-05-16 02:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-16 00:00:00+00:00", "2019-05-15 23:00:00+00:00", "2019-05-15 22:00:00+00:00", "2019-05-15 21:00:00+00:00", "2019-05-15 20:00:00+00:00", "2019-05-15 19:00:00+00:00", "2019-05-15 18:00:00+00:00", "2019-05-15 17:00:00+00:00", "2019-05-15 16:00:00+00:00", "2019-05-15 15:00:00+00:00", "2019-05-15 14:00:00+00:00", "2019-05-15 13:00:00+00:00", "2019-05-15 12:00:00+00:00", "2019-05-15 11:00:00+00:00", "2019-05-15 10:00:00+00:00", "2019-05-15 09:00:00+00:00", "2019-05-15 08:00:00+00:00", "2019-05-15 07:00:00+00:00", "2019-05-15 06:00:00+00:00", "2019-05-15 05:00:00+00:00", "2019-05-15 04:00:00+00:00", "2019-05-15 03:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 00:00:00+00:00", "2019-05-14 23:00:00+00:00", "2019-05-14 22:00:00+00:00", "2019-05-14 21:00:00+00:00", "2019-05-14 20:00:00+00:00", "2019-05-14 19:00:00+00:00", "2019-05-14 18:00:00+00:00", "2019-05-14 17:00:00+00:00", "2019-05-14 16:00:00+00:00", "2019-05-14 15:00:00+00:00", "2019-05-14 14:00:00+00:00", "2019-05-14 13:00:00+00:00", "2019-05-14 12:00:00+00:00", "2019-05-14 11:00:00+00:00", "2019-05-14 10:00:00+00:00", "2019-05-14 09:00:00+00:00", "2019-05-14 08:00:00+00:00", "2019-05-14 07:00:00+00:00", "2019-05-14 06:00:00+00:00", "2019-05-14 05:00:00+00:00", "2019-05-14 04:00:00+00:00", "2019-05-14 03:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-14 00:00:00+00:00", "2019-05-13 23:00:00+00:00", "2019-05-13 22:00:00+00:00", "2019-05-13 21:00:00+00:00", "2019-05-13 20:00:00+00:00", "2019-05-13 19:00:00+00:00", "2019-05-13 18:00:00+00:00", "2019-05-13 17:00:00+00:00", "2019-05-13 16:00:00+00:00", "2019-05-13 15:00:00+00:00", "2019-05-13 14:00:00+00:00", "2019-05-13 13:00:00+00:00", "2019-05-13 12:00:00+00:00", "2019-05-13 11:00:00+00:00", "2019-05-13 10:00:00+00:00", "2019-05-13 09:00:00+00:00", "2019-05-13 08:00:00+00:00", "2019-05-13 07:00:00+00:00", "2019-05-13 06:00:00+00:00", "2019-05-13 05:00:00+00:00", "2019-05-13 04:00:00+00:00", "2019-05-13 03:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-13 00:00:00+00:00", "2019-05-12 23:00:00+00:00", "2019-05-12 22:00:00+00:00", "2019-05-12 21:00:00+00:00", "2019-05-12 20:00:00+00:00", "2019-05-12 19:00:00+00:00", "2019-05-12 18:00:00+00:00", "2019-05-12 17:00:00+00:00", "2019-05-12 16:00:00+00:00", "2019-05-12 15:00:00+00:00", "2019-05-12 14:00:00+00:00", "2019-05-12 13:00:00+00:00", "2019-05-12 12:00:00+00:00", "2019-05-12 11:00:00+00:00", "2019-05-12 10:00:00+00:00", "2019-05-12 09:00:00+00:00", "2019-05-12 08:00:00+00:00", "2019-05-12 07:00:00+00:00", "2019-05-12 06:00:00+00:00", "2019-05-12 05:00:00+00:00", "2019-05-12 04:00:00+00:00", "2019-05-12 03:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-12 00:00:00+00:00", "2019-05-11 23:00:00+00:00", "2019-05-11 22:00:00+00:00", "2019-05-11 21:00:00+00:00", "2019-05-11 20:00:00+00:00", "2019-05-11 19:00:00+00:00", "2019-05-11 18:00:00+00:00", "2019-05-11 17:00:00+00:00", "2019-05-11 16:00:00+00:00", "2019-05-11 15:00:00+00:00", "2019-05-11 09:00:00+00:00", "2019-05-11 08:00:00+00:00", "2019-05-11 07:00:00+00:00", "2019-05-11 06:00:00+00:00", "2019-05-11 05:00:00+00:00", "2019-05-11 04:00:00+00:00", "2019-05-11 03:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-11 00:00:00+00:00", "2019-05-10 23:00:00+00:00", "2019-05-10 22:00:00+00:00", "2019-05-10 21:00:00+00:00", "2019-05-10 20:00:00+00:00", "2019-05-10 19:00:00+00:00", "2019-05-10 18:00:00+00:00", "2019-05-10 17:00:00+00:00", "2019-05-10 16:00:00+00:00", "2019-05-10 15:00:00+00:00", "2019-05-10 14:00:00+00:00", "2019-05-10 13:00:00+00:00", "2019-05-10 12:00:00+00:00", "2019-05-10 11:00:00+00:00", "2019-05-10 10:00:00+00:00", "2019-05-10 09:00:00+00:00", "2019-05-10 08:00:00+00:00", "2019-05-10 07:00:00+00:00", "2019-05-10 06:00:00+00:00", "2019-05-10 05:00:00+00:00", "2019-05-10 04:00:00+00:00", "2019-05-10 03:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-10 00:00:00+00:00", "2019-05-09 23:00:00+00:00", "2019-05-09 22:00:00+00:00", "2019-05-09 21:00:00+00:00", "2019-05-09 20:00:00+00:00", "2019-05-09 19:00:00+00:00", "2019-05-09 18:00:00+00:00", "2019-05-09 17:00:00+00:00", "2019-05-09 16:00:00+00:00", "2019-05-09 15:00:00+00:00", "2019-05-09 14:00:00+00:00", "2019-05-09 13:00:00+00:00", "2019-05-09 12:00:00+00:00", "2019-05-09 11:00:00+00:00", "2019-05-09 10:00:00+00:00", "2019-05-09 09:00:00+00:00", "2019-05-09 08:00:00+00:00", "2019-05-09 07:00:00+00:00", "2019-05-09 06:00:00+00:00", "2019-05-09 05:00:00+00:00", "2019-05-09 04:00:00+00:00", "2019-05-09 03:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 00:00:00+00:00", "2019-05-08 23:00:00+00:00", "2019-05-08 21:00:00+00:00", "2019-05-08 20:00:00+00:00", "2019-05-08 19:00:00+00:00", "2019-05-08 18:00:00+00:00", "2019-05-08 17:00:00+00:00", "2019-05-08 16:00:00+00:00", "2019-05-08 15:00:00+00:00", "2019-05-08 14:00:00+00:00", "2019-05-08 13:00:00+00:00", "2019-05-08 12:00:00+00:00", "2019-05-08 11:00:00+00:00", "2019-05-08 10:00:00+00:00", "2019-05-08 09:00:00+00:00", "2019-05-08 08:00:00+00:00", "2019-05-08 07:00:00+00:00", "2019-05-08 06:00:00+00:00", "2019-05-08 05:00:00+00:00", "2019-05-08 04:00:00+00:00", "2019-05-08 03:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-08 00:00:00+00:00", "2019-05-07 23:00:00+00:00", "2019-05-07 21:00:00+00:00", "2019-05-07 20:00:00+00:00", "2019-05-07 19:00:00+00:00", "2019-05-07 18:00:00+00:00", "2019-05-07 17:00:00+00:00", "2019-05-07 16:00:00+00:00", "2019-05-07 15:00:00+00:00", "2019-05-07 14:00:00+00:00", "2019-05-07 13:00:00+00:00", "2019-05-07 12:00:00+00:00", "2019-05-07 11:00:00+00:00", "2019-05-07 10:00:00+00:00", "2019-05-07 09:00:00+00:00", "2019-05-07 08:00:00+00:00", "2019-05-07 07:00:00+00:00", "2019-05-07 06:00:00+00:00", "2019-05-07 04:00:00+00:00", "2019-05-07 03:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 23:00:00+00:00", "2019-05-06 22:00:00+00:00", "2019-05-06 21:00:00+00:00", "2019-05-06 20:00:00+00:00", "2019-05-06 19:00:00+00:00", "2019-05-06 18:00:00+00:00", "2019-05-06 17:00:00+00:00", "2019-05-06 16:00:00+00:00", "2019-05-06 15:00:00+00:00", "2019-05-06 14:00:00+00:00", "2019-05-06 13:00:00+00:00", "2019-05-06 12:00:00+00:00", "2019-05-06 11:00:00+00:00", "2019-05-06 10:00:00+00:00", "2019-05-06 09:00:00+00:00", "2019-05-06 08:00:00+00:00", "2019-05-06 07:00:00+00:00", "2019-05-06 06:00:00+00:00", "2019-05-06 05:00:00+00:00", "2019-05-06 04:00:00+00:00", "2019-05-06 03:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-06 00:00:00+00:00", "2019-05-05 23:00:00+00:00", "2019-05-05 22:00:00+00:00", "2019-05-05 21:00:00+00:00", "2019-05-05 20:00:00+00:00", "2019-05-05 19:00:00+00:00", "2019-05-05 18:00:00+00:00", "2019-05-05 17:00:00+00:00", "2019-05-05 16:00:00+00:00", "2019-05-05 15:00:00+00:00", "2019-05-05 14:00:00+00:00", "2019-05-05 13:00:00+00:00", "2019-05-05 12:00:00+00:00", "2019-05-05 11:00:00+00:00", "2019-05-05 10:00:00+00:00", "2019-05-05 09:00:00+00:00", "2019-05-05 08:00:00+00:00", "2019-05-05 07:00:00+00:00", "2019-05-05 06:00:00+00:00", "2019-05-05 05:00:00+00:00", "2019-05-05 04:00:00+00:00", "2019-05-05 03:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-05 00:00:00+00:00", "2019-05-04 23:00:00+00:00", "2019-05-04 22:00:00+00:00", "2019-05-04 21:00:00+00:00", "2019-05-04 20:00:00+00:00", "2019-05-04 19:00:00+00:00", "2019-05-04 18:00:00+00:00", "2019-05-04 17:00:00+00:00", "2019-05-04 16:00:00+00:00", "2019-05-04 15:00:00+00:00", "2019-05-04 14:00:00+00:00", "2019-05-04 13:00:00+00:00", "2019-05-04 12:00:00+00:00", "2019-05-04 11:00:00+00:00", "2019-05-04 10:00:00+00:00", "2019-05-04 09:00:00+00:00", "2019-05-04 08:00:00+00:00", "2019-05-04 07:00:00+00:00", "2019-05-04 06:00:00+00:00", "2019-05-04 05:00:00+00:00", "2019-05-04 04:00:00+00:00", "2019-05-04 03:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-04 00:00:00+00:00", "2019-05-03 23:00:00+00:00", "2019-05-03 22:00:00+00:00", "2019-05-03 21:00:00+00:00", "2019-05-03 20:00:00+00:00", "2019-05-03 19:00:00+00:00", "2019-05-03 18:00:00+00:00", "2019-05-03 17:00:00+00:00", "2019-05-03 16:00:00+00:00", "2019-05-03 15:00:00+00:00", "2019-05-03 14:00:00+00:00", "2019-05-03 13:00:00+00:00", "2019-05-03 12:00:00+00:00", "2019-05-03 11:00:00+00:00", "2019-05-03 10:00:00+00:00", "2019-05-03 09:00:00+00:00", "2019-05-03 08:00:00+00:00", "2019-05-03 07:00:00+00:00", "2019-05-03 06:00:00+00:00", "2019-05-03 05:00:00+00:00", "2019-05-03 04:00:00+00:00", "2019-05-03 03:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-03 00:00:00+00:00", "2019-05-02 23:00:00+00:00", "2019-05-02 22:00:00+00:00", "2019-05-02 21:00:00+00:00", "2019-05-02 20:00:00+00:00", "2019-05-02 19:00:00+00:00", "2019-05-02 18:00:00+00:00", "2019-05-02 17:00:00+00:00", "2019-05-02 16:00:00+00:00", "2019-05-02 15:00:00+00:00", "2019-05-02 14:00:00+00:00", "2019-05-02 13:00:00+00:00", "2019-05-02 12:00:00+00:00", "2019-05-02 11:00:00+00:00", "2019-05-02 10:00:00+00:00", "2019-05-02 09:00:00+00:00", "2019-05-02 08:00:00+00:00", "2019-05-02 07:00:00+00:00", "2019-05-02 06:00:00+00:00", "2019-05-02 05:00:00+00:00", "2019-05-02 04:00:00+00:00", "2019-05-02 03:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-02 00:00:00+00:00", "2019-05-01 23:00:00+00:00", "2019-05-01 22:00:00+00:00", "2019-05-01 21:00:00+00:00", "2019-05-01 20:00:00+00:00", "2019-05-01 19:00:00+00:00", "2019-05-01 18:00:00+00:00", "2019-05-01 17:00:00+00:00", "2019-05-01 16:00:00+00:00", "2019-05-01 15:00:00+00:00", "2019-05-01 14:00:00+00:00", "2019-05-01 13:00:00+00:00", "2019-05-01 12:00:00+00:00", "2019-05-01 11:00:00+00:00", "2019-05-01 10:00:00+00:00", "2019-05-01 09:00:00+00:00", "2019-05-01 08:00:00+00:00", "2019-05-01 07:00:00+00:00", "2019-05-01 06:00:00+00:00", "2019-05-01 05:00:00+00:00", "2019-05-01 04:00:00+00:00", "2019-05-01 03:00:00+00:00", "2019-05-01 00:00:00+00:00", "2019-04-30 23:00:00+00:00", "2019-04-30 22:00:00+00:00", "2019-04-30 21:00:00+00:00", "2019-04-30 20:00:00+00:00", "2019-04-30 19:00:00+00:00", "2019-04-30 18:00:00+00:00", "2019-04-30 17:00:00+00:00", "2019-04-30 16:00:00+00:00", "2019-04-30 15:00:00+00:00", "2019-04-30 14:00:00+00:00", "2019-04-30 13:00:00+00:00", "2019-04-30 12:00:00+00:00", "2019-04-30 11:00:00+00:00", "2019-04-30 10:00:00+00:00", "2019-04-30 09:00:00+00:00", "2019-04-30 08:00:00+00:00", "2019-04-30 07:00:00+00:00", "2019-04-30 06:00:00+00:00", "2019-04-30 05:00:00+00:00", "2019-04-30 04:00:00+00:00", "2019-04-30 03:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-30 00:00:00+00:00", "2019-04-29 23:00:00+00:00", "2019-04-29 22:00:00+00:00", "2019-04-29 21:00:00+00:00", "2019-04-29 20:00:00+00:00", "2019-04-29 19:00:00+00:00", "2019-04-29 18:00:00+00:00", "2019-04-29 17:00:00+00:00", "2019-04-29 16:00:00+00:00", "2019-04-29 15:00:00+00:00", "2019-04-29 14:00:00+00:00", "2019-04-29 13:00:00+00:00", "2019-04-29 12:00:00+00:00", "2019-04-29 11:00:00+00:00", "2019-04-29 10:00:00+00:00", "2019-04-29 09:00:00+00:00", "2019-04-29 08:00:00+00:00", "2019-04-29 07:00:00+00:00", "2019-04-29 06:00:00+00:00", "2019-04-29 05:00:00+00:00", "2019-04-29 04:00:00+00:00", "2019-04-29 03:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-29 00:00:00+00:00", "2019-04-28 23:00:00+00:00", "2019-04-28 22:00:00+00:00", "2019-04-28 21:00:00+00:00", "2019-04-28 20:00:00+00:00", "2019-04-28 19:00:00+00:00", "2019-04-28 18:00:00+00:00", "2019-04-28 17:00:00+00:00", "2019-04-28 16:00:00+00:00", "2019-04-28 15:00:00+00:00", "2019-04-28 14:00:00+00:00", "2019-04-28 13:00:00+00:00", "2019-04-28 12:00:00+00:00", "2019-04-28 11:00:00+00:00", "2019-04-28 10:00:00+00:00", "2019-04-28 09:00:00+00:00", "2019-04-27 13:00:00+00:00", "2019-04-27 12:00:00+00:00", "2019-04-27 11:00:00+00:00", "2019-04-27 10:00:00+00:00", "2019-04-27 09:00:00+00:00", "2019-04-27 08:00:00+00:00", "2019-04-27 07:00:00+00:00", "2019-04-27 06:00:00+00:00", "2019-04-27 05:00:00+00:00", "2019-04-27 04:00:00+00:00", "2019-04-27 03:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 00:00:00+00:00", "2019-04-26 23:00:00+00:00", "2019-04-26 22:00:00+00:00", "2019-04-26 21:00:00+00:00", "2019-04-26 20:00:00+00:00", "2019-04-26 19:00:00+00:00", "2019-04-26 18:00:00+00:00", "2019-04-26 17:00:00+00:00", "2019-04-26 16:00:00+00:00", "2019-04-26 15:00:00+00:00", "2019-04-26 14:00:00+00:00", "2019-04-26 13:00:00+00:00", "2019-04-26 12:00:00+00:00", "2019-04-26 11:00:00+00:00", "2019-04-26 10:00:00+00:00", "2019-04-26 09:00:00+00:00", "2019-04-26 08:00:00+00:00", "2019-04-26 07:00:00+00:00", "2019-04-26 06:00:00+00:00", "2019-04-26 05:00:00+00:00", "2019-04-26 04:00:00+00:00", "2019-04-26 03:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-26 00:00:00+00:00", "2019-04-25 23:00:00+00:00", "2019-04-25 22:00:00+00:00", "2019-04-25 21:00:00+00:00", "2019-04-25 20:00:00+00:00", "2019-04-25 19:00:00+00:00", "2019-04-25 18:00:00+00:00", "2019-04-25 17:00:00+00:00", "2019-04-25 16:00:00+00:00", "2019-04-25 15:00:00+00:00", "2019-04-25 14:00:00+00:00", "2019-04-25 13:00:00+00:00", "2019-04-25 12:00:00+00:00", "2019-04-25 11:00:00+00:00", "2019-04-25 10:00:00+00:00", "2019-04-25 09:00:00+00:00", "2019-04-25 08:00:00+00:00", "2019-04-25 07:00:00+00:00", "2019-04-25 06:00:00+00:00", "2019-04-25 05:00:00+00:00", "2019-04-25 04:00:00+00:00", "2019-04-25 03:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 00:00:00+00:00", "2019-04-24 23:00:00+00:00", "2019-04-24 22:00:00+00:00", "2019-04-24 21:00:00+00:00", "2019-04-24 20:00:00+00:00", "2019-04-24 19:00:00+00:00", "2019-04-24 18:00:00+00:00", "2019-04-24 17:00:00+00:00", "2019-04-24 16:00:00+00:00", "2019-04-24 15:00:00+00:00", "2019-04-24 14:00:00+00:00", "2019-04-24 13:00:00+00:00", "2019-04-24 12:00:00+00:00", "2019-04-24 11:00:00+00:00", "2019-04-24 10:00:00+00:00", "2019-04-24 09:00:00+00:00", "2019-04-24 08:00:00+00:00", "2019-04-24 07:00:00+00:00", "2019-04-24 06:00:00+00:00", "2019-04-24 05:00:00+00:00", "2019-04-24 04:00:00+00:00", "2019-04-24 03:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 00:00:00+00:00", "2019-04-23 23:00:00+00:00", "2019-04-23 22:00:00+00:00", "2019-04-23 21:00:00+00:00", "2019-04-23 20:00:00+00:00", "2019-04-23 19:00:00+00:00", "2019-04-23 18:00:00+00:00", "2019-04-23 17:00:00+00:00", "2019-04-23 16:00:00+00:00", "2019-04-23 15:00:00+00:00", "2019-04-23 14:00:00+00:00", "2019-04-23 13:00:00+00:00", "2019-04-23 12:00:00+00:00", "2019-04-23 11:00:00+00:00", "2019-04-23 10:00:00+00:00", "2019-04-23 09:00:00+00:00", "2019-04-23 08:00:00+00:00", "2019-04-23 07:00:00+00:00", "2019-04-23 06:00:00+00:00", "2019-04-23 05:00:00+00:00", "2019-04-23 04:00:00+00:00", "2019-04-23 03:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-23 00:00:00+00:00", "2019-04-22 23:00:00+00:00", "2019-04-22 22:00:00+00:00", "2019-04-22 21:00:00+00:00", "2019-04-22 20:00:00+00:00", "2019-04-22 19:00:00+00:00", "2019-04-22 18:00:00+00:00", "2019-04-22 17:00:00+00:00", "2019-04-22 16:00:00+00:00", "2019-04-22 15:00:00+00:00", "2019-04-22 14:00:00+00:00", "2019-04-22 13:00:00+00:00", "2019-04-22 12:00:00+00:00", "2019-04-22 11:00:00+00:00", "2019-04-22 10:00:00+00:00", "2019-04-22 09:00:00+00:00", "2019-04-22 08:00:00+00:00", "2019-04-22 07:00:00+00:00", "2019-04-22 06:00:00+00:00", "2019-04-22 05:00:00+00:00", "2019-04-22 04:00:00+00:00", "2019-04-22 03:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-22 00:00:00+00:00", "2019-04-21 23:00:00+00:00", "2019-04-21 22:00:00+00:00", "2019-04-21 21:00:00+00:00", "2019-04-21 20:00:00+00:00", "2019-04-21 19:00:00+00:00", "2019-04-21 18:00:00+00:00", "2019-04-21 17:00:00+00:00", "2019-04-21 16:00:00+00:00", "2019-04-21 15:00:00+00:00", "2019-04-21 14:00:00+00:00", "2019-04-21 13:00:00+00:00", "2019-04-21 12:00:00+00:00", "2019-04-21 11:00:00+00:00", "2019-04-21 10:00:00+00:00", "2019-04-21 09:00:00+00:00", "2019-04-21 08:00:00+00:00", "2019-04-21 07:00:00+00:00", "2019-04-21 06:00:00+00:00", "2019-04-21 05:00:00+00:00", "2019-04-21 04:00:00+00:00", "2019-04-21 03:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-21 00:00:00+00:00", "2019-04-20 23:00:00+00:00", "2019-04-20 22:00:00+00:00", "2019-04-20 21:00:00+00:00", "2019-04-20 20:00:00+00:00", "2019-04-20 19:00:00+00:00", "2019-04-20 18:00:00+00:00", "2019-04-20 17:00:00+00:00", "2019-04-20 16:00:00+00:00", "2019-04-20 15:00:00+00:00", "2019-04-20 14:00:00+00:00", "2019-04-20 13:00:00+00:00", "2019-04-20 12:00:00+00:00", "2019-04-20 11:00:00+00:00", "2019-04-20 10:00:00+00:00", "2019-04-20 09:00:00+00:00", "2019-04-20 08:00:00+00:00", "2019-04-20 07:00:00+00:00", "2019-04-20 06:00:00+00:00", "2019-04-20 05:00:00+00:00", "2019-04-20 04:00:00+00:00", "2019-04-20 03:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-20 00:00:00+00:00", "2019-04-19 23:00:00+00:00", "2019-04-19 22:00:00+00:00", "2019-04-19 21:00:00+00:00", "2019-04-19 20:00:00+00:00", "2019-04-19 19:00:00+00:00", "2019-04-19 18:00:00+00:00", "2019-04-19 17:00:00+00:00", "2019-04-19 16:00:00+00:00", "2019-04-19 15:00:00+00:00", "2019-04-19 14:00:00+00:00", "2019-04-19 13:00:00+00:00", "2019-04-19 12:00:00+00:00", "2019-04-19 11:00:00+00:00", "2019-04-19 10:00:00+00:00", "2019-04-19 09:00:00+00:00", "2019-04-19 08:00:00+00:00", "2019-04-19 07:00:00+00:00", "2019-04-19 06:00:00+00:00", "2019-04-19 05:00:00+00:00", "2019-04-19 04:00:00+00:00", "2019-04-19 03:00:00+00:00", "2019-04-19 02:00:00+00:00", "2019-04-19 00:00:00+00:00", "2019-04-18 23:00:00+00:00", "2019-04-18 22:00:00+00:00", "2019-04-18 21:00:00+00:00", "2019-04-18 20:00:00+00:00", "2019-04-18 19:00:00+00:00", "2019-04-18 18:00:00+00:00", "2019-04-18 17:00:00+00:00", "2019-04-18 16:00:00+00:00", "2019-04-18 15:00:00+00:00", "2019-04-18 14:00:00+00:00", "2019-04-18 13:00:00+00:00", "2019-04-18 12:00:00+00:00", "2019-04-18 11:00:00+00:00", "2019-04-18 10:00:00+00:00", "2019-04-18 09:00:00+00:00", "2019-04-18 08:00:00+00:00", "2019-04-18 07:00:00+00:00", "2019-04-18 06:00:00+00:00", "2019-04-18 05:00:00+00:00", "2019-04-18 04:00:00+00:00", "2019-04-18 03:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-18 00:00:00+00:00", "2019-04-17 23:00:00+00:00", "2019-04-17 22:00:00+00:00", "2019-04-17 21:00:00+00:00", "2019-04-17 20:00:00+00:00", "2019-04-17 19:00:00+00:00", "2019-04-17 18:00:00+00:00", "2019-04-17 17:00:00+00:00", "2019-04-17 16:00:00+00:00", "2019-04-17 15:00:00+00:00", "2019-04-17 14:00:00+00:00", "2019-04-17 13:00:00+00:00", "2019-04-17 12:00:00+00:00", "2019-04-17 11:00:00+00:00", "2019-04-17 10:00:00+00:00", "2019-04-17 09:00:00+00:00", "2019-04-17 08:00:00+00:00", "2019-04-17 07:00:00+00:00", "2019-04-17 06:00:00+00:00", "2019-04-17 05:00:00+00:00", "2019-04-17 04:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 00:00:00+00:00", "2019-04-16 23:00:00+00:00", "2019-04-16 22:00:00+00:00", "2019-04-16 21:00:00+00:00", "2019-04-16 20:00:00+00:00", "2019-04-16 19:00:00+00:00", "2019-04-16 18:00:00+00:00", "2019-04-16 17:00:00+00:00", "2019-04-16 15:00:00+00:00", "2019-04-16 14:00:00+00:00", "2019-04-16 13:00:00+00:00", "2019-04-16 12:00:00+00:00", "2019-04-16 11:00:00+00:00", "2019-04-16 10:00:00+00:00", "2019-04-16 09:00:00+00:00", "2019-04-16 08:00:00+00:00", "2019-04-16 07:00:00+00:00", "2019-04-16 06:00:00+00:00", "2019-04-16 05:00:00+00:00", "2019-04-16 04:00:00+00:00", "2019-04-16 03:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 00:00:00+00:00", "2019-04-15 23:00:00+00:00", "2019-04-15 22:00:00+00:00", "2019-04-15 21:00:00+00:00", "2019-04-15 20:00:00+00:00", "2019-04-15 19:00:00+00:00", "2019-04-15 18:00:00+00:00", "2019-04-15 17:00:00+00:00", "2019-04-15 16:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-15 00:00:00+00:00", "2019-04-14 23:00:00+00:00", "2019-04-14 22:00:00+00:00", "2019-04-14 21:00:00+00:00", "2019-04-14 20:00:00+00:00", "2019-04-14 19:00:00+00:00", "2019-04-14 18:00:00+00:00", "2019-04-14 17:00:00+00:00", "2019-04-14 16:00:00+00:00", "2019-04-14 15:00:00+00:00", "2019-04-14 14:00:00+00:00", "2019-04-14 13:00:00+00:00", "2019-04-14 12:00:00+00:00", "2019-04-14 11:00:00+00:00", "2019-04-14 10:00:00+00:00", "2019-04-14 09:00:00+00:00", "2019-04-14 08:00:00+00:00", "2019-04-14 07:00:00+00:00", "2019-04-14 06:00:00+00:00", "2019-04-14 05:00:00+00:00", "2019-04-14 04:00:00+00:00", "2019-04-14 03:00:00+00:00", "2019-04-14 02:00:00+00:00", "2019-04-14 01:00:00+00:00", "2019-04-14 00:00:00+00:00", "2019-04-13 23:00:00+00:00", "2019-04-13 22:00:00+00:00", "2019-04-13 21:00:00+00:00", "2019-04-13 20:00:00+00:00", "2019-04-13 19:00:00+00:00", "2019-04-13 18:00:00+00:00", "2019-04-13 17:00:00+00:00", "2019-04-13 16:00:00+00:00", "2019-04-13 15:00:00+00:00", "2019-04-13 14:00:00+00:00", "2019-04-13 13:00:00+00:00", "2019-04-13 12:00:00+00:00", "2019-04-13 11:00:00+00:00", "2019-04-13 10:00:00+00:00", "2019-04-13 09:00:00+00:00", "2019-04-13 08:00:00+00:00", "2019-04-13 07:00:00+00:00", "2019-04-13 06:00:00+00:00", "2019-04-13 05:00:00+00:00", "2019-04-13 04:00:00+00:00", "2019-04-13 03:00:00+00:00", "2019-04-13 02:00:00+00:00", "2019-04-13 01:00:00+00:00", "2019-04-13 00:00:00+00:00", "2019-04-12 23:00:00+00:00", "2019-04-12 22:00:00+00:00", "2019-04-12 21:00:00+00:00", "2019-04-12 20:00:00+00:00", "2019-04-12 19:00:00+00:00", "2019-04-12 18:00:00+00:00", "2019-04-12 17:00:00+00:00", "2019-04-12 16:00:00+00:00", "2019-04-12 15:00:00+00:00", "2019-04-12 14:00:00+00:00", "2019-04-12 13:00:00+00:00", "2019-04-12 12:00:00+00:00", "2019-04-12 11:00:00+00:00", "2019-04-12 10:00:00+00:00", "2019-04-12 09:00:00+00:00", "2019-04-12 08:00:00+00:00", "2019-04-12 07:00:00+00:00", "2019-04-12 06:00:00+00:00", "2019-04-12 05:00:00+00:00", "2019-04-12 04:00:00+00:00", "2019-04-12 03:00:00+00:00", "2019-04-12 00:00:00+00:00", "2019-04-11 23:00:00+00:00", "2019-04-11 22:00:00+00:00", "2019-04-11 21:00:00+00:00", "2019-04-11 20:00:00+00:00", "2019-04-11 19:00:00+00:00", "2019-04-11 18:00:00+00:00", "2019-04-11 17:00:00+00:00", "2019-04-11 16:00:00+00:00", "2019-04-11 15:00:00+00:00", "2019-04-11 14:00:00+00:00", "2019-04-11 13:00:00+00:00", "2019-04-11 12:00:00+00:00", "2019-04-11 11:00:00+00:00", "2019-04-11 10:00:00+00:00", "2019-04-11 09:00:00+00:00", "2019-04-11 08:00:00+00:00", "2019-04-11 07:00:00+00:00", "2019-04-11 06:00:00+00:00", "2019-04-11 05:00:00+00:00", "2019-04-11 04:00:00+00:00", "2019-04-11 03:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 00:00:00+00:00", "2019-04-10 23:00:00+00:00", "2019-04-10 22:00:00+00:00", "2019-04-10 21:00:00+00:00", "2019-04-10 20:00:00+00:00", "2019-04-10 19:00:00+00:00", "2019-04-10 18:00:00+00:00", "2019-04-10 17:00:00+00:00", "2019-04-10 16:00:00+00:00", "2019-04-10 15:00:00+00:00", "2019-04-10 14:00:00+00:00", "2019-04-10 13:00:00+00:00", "2019-04-10 12:00:00+00:00", "2019-04-10 11:00:00+00:00", "2019-04-10 10:00:00+00:00", "2019-04-10 09:00:00+00:00", "2019-04-10 08:00:00+00:00", "2019-04-10 07:00:00+00:00", "2019-04-10 06:00:00+00:00", "2019-04-10 05:00:00+00:00", "2019-04-10 04:00:00+00:00", "2019-04-10 03:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-10 00:00:00+00:00", "2019-04-09 23:00:00+00:00", "2019-04-09 22:00:00+00:00", "2019-04-09 21:00:00+00:00", "2019-04-09 20:00:00+00:00", "2019-04-09 19:00:00+00:00", "2019-04-09 18:00:00+00:00", "2019-04-09 17:00:00+00:00", "2019-04-09 16:00:00+00:00", "2019-04-09 15:00:00+00:00", "2019-04-09 14:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00",
+// 					},
+// 					"c367804c2f44cd55a157c97d086a1b146f7a1f5949e1fbff4a01762ff17466d8ffc389ce5dbf62437b7d1041aba3aa33919ba40b7528f92542538127a1fe73b0": {
+// 						"2019-06-18 06:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-15 01:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-13 01:00:00+00:00", "2019-06-12 01:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-08 01:00:00+00:00", "2019-06-06 01:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-24 01:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 01:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 01:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-01 02:00:00+00:00", "2019-05-01 01:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-28 02:00:00+00:00", "2019-04-28 01:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 01:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 01:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 01:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-19 01:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 01:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 01:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-12 02:00:00+00:00", "2019-04-12 01:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 01:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00", "2019-04-09 01:00:00+00:00",
+// 					},
+// 					"6e2143717dc23a5087b440b39ee01182896c37449603628e1e2f67c3e80b7eefe9a84fdb3a664a7106120dd9fcc26a5ba3460610b41a1fa085f61fee971e1b1b": {
+// 						"2019-06-21 00:00:00+00:00", "2019-06-20 23:00:00+00:00", "2019-06-20 22:00:00+00:00", "2019-06-20 21:00:00+00:00", "2019-06-20 20:00:00+00:00", "2019-06-20 19:00:00+00:00", "2019-06-20 18:00:00+00:00", "2019-06-20 17:00:00+00:00", "2019-06-20 16:00:00+00:00", "2019-06-20 15:00:00+00:00", "2019-06-19 13:00:00+00:00", "2019-06-19 12:00:00+00:00", "2019-06-19 11:00:00+00:00", "2019-06-19 00:00:00+00:00", "2019-06-18 23:00:00+00:00", "2019-06-18 22:00:00+00:00", "2019-06-18 21:00:00+00:00", "2019-06-18 11:00:00+00:00", "2019-06-18 10:00:00+00:00", "2019-06-18 09:00:00+00:00", "2019-06-18 08:00:00+00:00", "2019-06-18 07:00:00+00:00", "2019-06-18 06:00:00+00:00", "2019-06-18 05:00:00+00:00", "2019-06-18 04:00:00+00:00", "2019-06-18 03:00:00+00:00", "2019-06-18 02:00:00+00:00", "2019-06-18 00:00:00+00:00", "2019-06-17 23:00:00+00:00", "2019-06-17 22:00:00+00:00", "2019-06-17 21:00:00+00:00", "2019-06-17 20:00:00+00:00", "2019-06-17 19:00:00+00:00", "2019-06-17 18:00:00+00:00", "2019-06-17 17:00:00+00:00", "2019-06-17 16:00:00+00:00", "2019-06-17 15:00:00+00:00", "2019-06-17 14:00:00+00:00", "2019-06-17 13:00:00+00:00", "2019-06-17 12:00:00+00:00", "2019-06-17 11:00:00+00:00", "2019-06-17 10:00:00+00:00", "2019-06-17 09:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-17 00:00:00+00:00", "2019-06-16 23:00:00+00:00", "2019-06-16 21:00:00+00:00", "2019-06-16 20:00:00+00:00", "2019-06-16 19:00:00+00:00", "2019-06-16 18:00:00+00:00", "2019-06-16 17:00:00+00:00", "2019-06-16 16:00:00+00:00", "2019-06-16 15:00:00+00:00", "2019-06-16 14:00:00+00:00", "2019-06-16 13:00:00+00:00", "2019-06-16 12:00:00+00:00", "2019-06-16 11:00:00+00:00", "2019-06-16 10:00:00+00:00", "2019-06-16 09:00:00+00:00", "2019-06-16 08:00:00+00:00", "2019-06-16 07:00:00+00:00", "2019-06-16 06:00:00+00:00", "2019-06-16 05:00:00+00:00", "2019-06-16 04:00:00+00:00", "2019-06-16 03:00:00+00:00", "2019-06-16 02:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-16 00:00:00+00:00", "2019-06-15 23:00:00+00:00", "2019-06-15 22:00:00+00:00", "2019-06-15 21:00:00+00:00", "2019-06-15 20:00:00+00:00", "2019-06-15 19:00:00+00:00", "2019-06-15 18:00:00+00:00", "2019-06-15 17:00:00+00:00", "2019-06-15 16:00:00+00:00", "2019-06-15 15:00:00+00:00", "2019-06-15 14:00:00+00:00", "2019-06-15 13:00:00+00:00", "2019-06-15 12:00:00+00:00", "2019-06-15 11:00:00+00:00", "2019-06-15 10:00:00+00:00", "2019-06-15 09:00:00+00:00", "2019-06-15 08:00:00+00:00", "2019-06-15 07:00:00+00:00", "2019-06-15 06:00:00+00:00", "2019-06-15 05:00:00+00:00", "2019-06-15 04:00:00+00:00", "2019-06-15 00:00:00+00:00", "2019-06-14 23:00:00+00:00", "2019-06-14 22:00:00+00:00", "2019-06-14 21:00:00+00:00", "2019-06-14 20:00:00+00:00", "2019-06-14 19:00:00+00:00", "2019-06-14 18:00:00+00:00", "2019-06-14 17:00:00+00:00", "2019-06-14 16:00:00+00:00", "2019-06-14 15:00:00+00:00", "2019-06-14 14:00:00+00:00", "2019-06-14 13:00:00+00:00", "2019-06-14 12:00:00+00:00", "2019-06-14 11:00:00+00:00", "2019-06-14 10:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-14 08:00:00+00:00", "2019-06-14 07:00:00+00:00", "2019-06-14 06:00:00+00:00", "2019-06-14 05:00:00+00:00", "2019-06-14 04:00:00+00:00", "2019-06-14 03:00:00+00:00", "2019-06-14 02:00:00+00:00", "2019-06-14 00:00:00+00:00", "2019-06-13 23:00:00+00:00", "2019-06-13 22:00:00+00:00", "2019-06-13 21:00:00+00:00", "2019-06-13 20:00:00+00:00", "2019-06-13 19:00:00+00:00", "2019-06-13 18:00:00+00:00", "2019-06-13 17:00:00+00:00", "2019-06-13 16:00:00+00:00", "2019-06-13 15:00:00+00:00", "2019-06-13 14:00:00+00:00", "2019-06-13 13:00:00+00:00", "2019-06-13 12:00:00+00:00", "2019-06-13 11:00:00+00:00", "2019-06-13 10:00:00+00:00", "2019-06-13 09:00:00+00:00", "2019-06-13 08:00:00+00:00", "2019-06-13 07:00:00+00:00", "2019-06-13 06:00:00+00:00", "2019-06-13 05:00:00+00:00", "2019-06-13 04:00:00+00:00", "2019-06-13 03:00:00+00:00", "2019-06-13 02:00:00+00:00", "2019-06-13 00:00:00+00:00", "2019-06-12 23:00:00+00:00", "2019-06-12 21:00:00+00:00", "2019-06-12 20:00:00+00:00", "2019-06-12 19:00:00+00:00", "2019-06-12 18:00:00+00:00", "2019-06-12 17:00:00+00:00", "2019-06-12 16:00:00+00:00", "2019-06-12 15:00:00+00:00", "2019-06-12 14:00:00+00:00", "2019-06-12 13:00:00+00:00", "2019-06-12 12:00:00+00:00", "2019-06-12 11:00:00+00:00", "2019-06-12 10:00:00+00:00", "2019-06-12 09:00:00+00:00", "2019-06-12 08:00:00+00:00", "2019-06-12 07:00:00+00:00", "2019-06-12 06:00:00+00:00", "2019-06-12 05:00:00+00:00", "2019-06-12 04:00:00+00:00", "2019-06-12 03:00:00+00:00", "2019-06-12 00:00:00+00:00", "2019-06-11 23:00:00+00:00", "2019-06-11 22:00:00+00:00", "2019-06-11 21:00:00+00:00", "2019-06-11 20:00:00+00:00", "2019-06-11 19:00:00+00:00", "2019-06-11 18:00:00+00:00", "2019-06-11 17:00:00+00:00", "2019-06-11 16:00:00+00:00", "2019-06-11 15:00:00+00:00", "2019-06-11 14:00:00+00:00", "2019-06-11 13:00:00+00:00", "2019-06-11 12:00:00+00:00", "2019-06-11 11:00:00+00:00", "2019-06-11 10:00:00+00:00", "2019-06-11 09:00:00+00:00", "2019-06-11 08:00:00+00:00", "2019-06-11 07:00:00+00:00", "2019-06-11 06:00:00+00:00", "2019-06-11 05:00:00+00:00", "2019-06-11 04:00:00+00:00", "2019-06-11 03:00:00+00:00", "2019-06-11 02:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-11 00:00:00+00:00", "2019-06-10 23:00:00+00:00", "2019-06-10 22:00:00+00:00", "2019-06-10 21:00:00+00:00", "2019-06-10 20:00:00+00:00", "2019-06-10 19:00:00+00:00", "2019-06-10 18:00:00+00:00", "2019-06-10 17:00:00+00:00", "2019-06-10 16:00:00+00:00", "2019-06-10 15:00:00+00:00", "2019-06-10 14:00:00+00:00", "2019-06-10 13:00:00+00:00", "2019-06-10 12:00:00+00:00", "2019-06-10 11:00:00+00:00", "2019-06-10 10:00:00+00:00", "2019-06-10 09:00:00+00:00", "2019-06-10 08:00:00+00:00", "2019-06-10 07:00:00+00:00", "2019-06-10 06:00:00+00:00", "2019-06-10 05:00:00+00:00", "2019-06-10 04:00:00+00:00", "2019-06-10 03:00:00+00:00", "2019-06-10 02:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-10 00:00:00+00:00", "2019-06-09 23:00:00+00:00", "2019-06-09 21:00:00+00:00", "2019-06-09 20:00:00+00:00", "2019-06-09 19:00:00+00:00", "2019-06-09 18:00:00+00:00", "2019-06-09 17:00:00+00:00", "2019-06-09 16:00:00+00:00", "2019-06-09 15:00:00+00:00", "2019-06-09 14:00:00+00:00", "2019-06-09 13:00:00+00:00", "2019-06-09 12:00:00+00:00", "2019-06-09 11:00:00+00:00", "2019-06-09 10:00:00+00:00", "2019-06-09 09:00:00+00:00", "2019-06-09 08:00:00+00:00", "2019-06-09 07:00:00+00:00", "2019-06-09 06:00:00+00:00", "2019-06-09 05:00:00+00:00", "2019-06-09 04:00:00+00:00", "2019-06-09 03:00:00+00:00", "2019-06-09 02:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-09 00:00:00+00:00", "2019-06-08 23:00:00+00:00", "2019-06-08 21:00:00+00:00", "2019-06-08 20:00:00+00:00", "2019-06-08 19:00:00+00:00", "2019-06-08 18:00:00+00:00", "2019-06-08 17:00:00+00:00", "2019-06-08 16:00:00+00:00", "2019-06-08 15:00:00+00:00", "2019-06-08 14:00:00+00:00", "2019-06-08 13:00:00+00:00", "2019-06-08 12:00:00+00:00", "2019-06-08 11:00:00+00:00", "2019-06-08 10:00:00+00:00", "2019-06-08 09:00:00+00:00", "2019-06-08 08:00:00+00:00", "2019-06-08 07:00:00+00:00", "2019-06-08 06:00:00+00:00", "2019-06-08 05:00:00+00:00", "2019-06-08 04:00:00+00:00", "2019-06-08 03:00:00+00:00", "2019-06-08 02:00:00+00:00", "2019-06-08 00:00:00+00:00", "2019-06-07 23:00:00+00:00", "2019-06-07 21:00:00+00:00", "2019-06-07 20:00:00+00:00", "2019-06-07 19:00:00+00:00", "2019-06-07 18:00:00+00:00", "2019-06-07 17:00:00+00:00", "2019-06-07 16:00:00+00:00", "2019-06-07 15:00:00+00:00", "2019-06-07 14:00:00+00:00", "2019-06-07 13:00:00+00:00", "2019-06-07 12:00:00+00:00", "2019-06-07 11:00:00+00:00", "2019-06-07 10:00:00+00:00", "2019-06-07 09:00:00+00:00", "2019-06-07 08:00:00+00:00", "2019-06-07 07:00:00+00:00", "2019-06-07 06:00:00+00:00", "2019-06-07 05:00:00+00:00", "2019-06-07 04:00:00+00:00", "2019-06-07 03:00:00+00:00", "2019-06-07 02:00:00+00:00", "2019-06-07 01:00:00+00:00", "2019-06-07 00:00:00+00:00", "2019-06-06 23:00:00+00:00", "2019-06-06 22:00:00+00:00", "2019-06-06 21:00:00+00:00", "2019-06-06 20:00:00+00:00", "2019-06-06 19:00:00+00:00", "2019-06-06 18:00:00+00:00", "2019-06-06 17:00:00+00:00", "2019-06-06 16:00:00+00:00", "2019-06-06 15:00:00+00:00", "2019-06-06 14:00:00+00:00", "2019-06-06 13:00:00+00:00", "2019-06-06 12:00:00+00:00", "2019-06-06 11:00:00+00:00", "2019-06-06 10:00:00+00:00", "2019-06-06 09:00:00+00:00", "2019-06-06 08:00:00+00:00", "2019-06-06 07:00:00+00:00", "2019-06-06 06:00:00+00:00", "2019-06-06 05:00:00+00:00", "2019-06-06 04:00:00+00:00", "2019-06-06 03:00:00+00:00", "2019-06-06 02:00:00+00:00", "2019-06-06 00:00:00+00:00", "2019-06-05 23:00:00+00:00", "2019-06-05 22:00:00+00:00", "2019-06-05 21:00:00+00:00", "2019-06-05 20:00:00+00:00", "2019-06-05 19:00:00+00:00", "2019-06-05 18:00:00+00:00", "2019-06-05 17:00:00+00:00", "2019-06-05 16:00:00+00:00", "2019-06-05 15:00:00+00:00", "2019-06-05 14:00:00+00:00", "2019-06-05 13:00:00+00:00", "2019-06-05 12:00:00+00:00", "2019-06-05 11:00:00+00:00", "2019-06-05 10:00:00+00:00", "2019-06-05 09:00:00+00:00", "2019-06-05 08:00:00+00:00", "2019-06-05 07:00:00+00:00", "2019-06-05 06:00:00+00:00", "2019-06-05 05:00:00+00:00", "2019-06-05 04:00:00+00:00", "2019-06-05 03:00:00+00:00", "2019-06-05 02:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-05 00:00:00+00:00", "2019-06-04 23:00:00+00:00", "2019-06-04 22:00:00+00:00", "2019-06-04 21:00:00+00:00", "2019-06-04 20:00:00+00:00", "2019-06-04 19:00:00+00:00", "2019-06-04 18:00:00+00:00", "2019-06-04 17:00:00+00:00", "2019-06-04 16:00:00+00:00", "2019-06-04 15:00:00+00:00", "2019-06-04 14:00:00+00:00", "2019-06-04 13:00:00+00:00", "2019-06-04 12:00:00+00:00", "2019-06-04 11:00:00+00:00", "2019-06-04 10:00:00+00:00", "2019-06-04 09:00:00+00:00", "2019-06-04 08:00:00+00:00", "2019-06-04 07:00:00+00:00", "2019-06-04 06:00:00+00:00", "2019-06-04 05:00:00+00:00", "2019-06-04 04:00:00+00:00", "2019-06-04 03:00:00+00:00", "2019-06-04 02:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-04 00:00:00+00:00", "2019-06-03 23:00:00+00:00", "2019-06-03 22:00:00+00:00", "2019-06-03 21:00:00+00:00", "2019-06-03 20:00:00+00:00", "2019-06-03 19:00:00+00:00", "2019-06-03 18:00:00+00:00", "2019-06-03 17:00:00+00:00", "2019-06-03 16:00:00+00:00", "2019-06-03 15:00:00+00:00", "2019-06-03 14:00:00+00:00", "2019-06-03 13:00:00+00:00", "2019-06-03 12:00:00+00:00", "2019-06-03 11:00:00+00:00", "2019-06-03 10:00:00+00:00", "2019-06-03 09:00:00+00:00", "2019-06-03 08:00:00+00:00", "2019-06-03 07:00:00+00:00", "2019-06-03 06:00:00+00:00", "2019-06-03 05:00:00+00:00", "2019-06-03 04:00:00+00:00", "2019-06-03 03:00:00+00:00", "2019-06-03 02:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-03 00:00:00+00:00", "2019-06-02 23:00:00+00:00", "2019-06-02 22:00:00+00:00", "2019-06-02 21:00:00+00:00", "2019-06-02 20:00:00+00:00", "2019-06-02 19:00:00+00:00", "2019-06-02 18:00:00+00:00", "2019-06-02 17:00:00+00:00", "2019-06-02 16:00:00+00:00", "2019-06-02 15:00:00+00:00", "2019-06-02 14:00:00+00:00", "2019-06-02 13:00:00+00:00", "2019-06-02 12:00:00+00:00", "2019-06-02 11:00:00+00:00", "2019-06-02 10:00:00+00:00", "2019-06-02 09:00:00+00:00", "2019-06-02 08:00:00+00:00", "2019-06-02 07:00:00+00:00", "2019-06-02 06:00:00+00:00", "2019-06-02 05:00:00+00:00", "2019-06-02 04:00:00+00:00", "2019-06-02 03:00:00+00:00", "2019-06-02 02:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-02 00:00:00+00:00", "2019-06-01 23:00:00+00:00", "2019-06-01 22:00:00+00:00", "2019-06-01 21:00:00+00:00", "2019-06-01 20:00:00+00:00", "2019-06-01 19:00:00+00:00", "2019-06-01 18:00:00+00:00", "2019-06-01 17:00:00+00:00", "2019-06-01 16:00:00+00:00", "2019-06-01 15:00:00+00:00", "2019-06-01 14:00:00+00:00", "2019-06-01 13:00:00+00:00", "2019-06-01 12:00:00+00:00", "2019-06-01 11:00:00+00:00", "2019-06-01 10:00:00+00:00", "2019-06-01 09:00:00+00:00", "2019-06-01 08:00:00+00:00", "2019-06-01 07:00:00+00:00", "2019-06-01 06:00:00+00:00", "2019-06-01 05:00:00+00:00", "2019-06-01 04:00:00+00:00", "2019-06-01 03:00:00+00:00", "2019-06-01 02:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-06-01 00:00:00+00:00", "2019-05-31 23:00:00+00:00", "2019-05-31 22:00:00+00:00", "2019-05-31 21:00:00+00:00", "2019-05-31 20:00:00+00:00", "2019-05-31 19:00:00+00:00", "2019-05-31 18:00:00+00:00", "2019-05-31 17:00:00+00:00", "2019-05-31 16:00:00+00:00", "2019-05-31 15:00:00+00:00", "2019-05-31 14:00:00+00:00", "2019-05-31 13:00:00+00:00", "2019-05-31 12:00:00+00:00", "2019-05-31 11:00:00+00:00", "2019-05-31 10:00:00+00:00", "2019-05-31 09:00:00+00:00", "2019-05-31 08:00:00+00:00", "2019-05-31 07:00:00+00:00", "2019-05-31 06:00:00+00:00", "2019-05-31 05:00:00+00:00", "2019-05-31 04:00:00+00:00", "2019-05-31 03:00:00+00:00", "2019-05-31 02:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-31 00:00:00+00:00", "2019-05-30 23:00:00+00:00", "2019-05-30 22:00:00+00:00", "2019-05-30 21:00:00+00:00", "2019-05-30 20:00:00+00:00", "2019-05-30 19:00:00+00:00", "2019-05-30 18:00:00+00:00", "2019-05-30 17:00:00+00:00", "2019-05-30 16:00:00+00:00", "2019-05-30 15:00:00+00:00", "2019-05-30 14:00:00+00:00", "2019-05-30 13:00:00+00:00", "2019-05-30 12:00:00+00:00", "2019-05-30 11:00:00+00:00", "2019-05-30 10:00:00+00:00", "2019-05-30 09:00:00+00:00", "2019-05-30 08:00:00+00:00", "2019-05-30 07:00:00+00:00", "2019-05-30 06:00:00+00:00", "2019-05-30 05:00:00+00:00", "2019-05-30 04:00:00+00:00", "2019-05-30 03:00:00+00:00", "2019-05-30 02:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-30 00:00:00+00:00", "2019-05-29 23:00:00+00:00", "2019-05-29 22:00:00+00:00", "2019-05-29 21:00:00+00:00", "2019-05-29 20:00:00+00:00", "2019-05-29 19:00:00+00:00", "2019-05-29 18:00:00+00:00", "2019-05-29 17:00:00+00:00", "2019-05-29 16:00:00+00:00", "2019-05-29 15:00:00+00:00", "2019-05-29 14:00:00+00:00", "2019-05-29 13:00:00+00:00", "2019-05-29 12:00:00+00:00", "2019-05-29 11:00:00+00:00", "2019-05-29 10:00:00+00:00", "2019-05-29 09:00:00+00:00", "2019-05-29 08:00:00+00:00", "2019-05-29 07:00:00+00:00", "2019-05-29 06:00:00+00:00", "2019-05-29 05:00:00+00:00", "2019-05-29 04:00:00+00:00", "2019-05-29 03:00:00+00:00", "2019-05-29 02:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-29 00:00:00+00:00", "2019-05-28 23:00:00+00:00", "2019-05-28 21:00:00+00:00", "2019-05-28 20:00:00+00:00", "2019-05-28 19:00:00+00:00", "2019-05-28 18:00:00+00:00", "2019-05-28 17:00:00+00:00", "2019-05-28 16:00:00+00:00", "2019-05-28 15:00:00+00:00", "2019-05-28 14:00:00+00:00", "2019-05-28 13:00:00+00:00", "2019-05-28 12:00:00+00:00", "2019-05-28 11:00:00+00:00", "2019-05-28 10:00:00+00:00", "2019-05-28 09:00:00+00:00", "2019-05-28 08:00:00+00:00", "2019-05-28 07:00:00+00:00", "2019-05-28 06:00:00+00:00", "2019-05-28 05:00:00+00:00", "2019-05-28 04:00:00+00:00", "2019-05-28 03:00:00+00:00", "2019-05-28 02:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-28 00:00:00+00:00", "2019-05-27 23:00:00+00:00", "2019-05-27 22:00:00+00:00", "2019-05-27 21:00:00+00:00", "2019-05-27 20:00:00+00:00", "2019-05-27 19:00:00+00:00", "2019-05-27 18:00:00+00:00", "2019-05-27 17:00:00+00:00", "2019-05-27 16:00:00+00:00", "2019-05-27 15:00:00+00:00", "2019-05-27 14:00:00+00:00", "2019-05-27 13:00:00+00:00", "2019-05-27 12:00:00+00:00", "2019-05-27 11:00:00+00:00", "2019-05-27 10:00:00+00:00", "2019-05-27 09:00:00+00:00", "2019-05-27 08:00:00+00:00", "2019-05-27 07:00:00+00:00", "2019-05-27 06:00:00+00:00", "2019-05-27 05:00:00+00:00", "2019-05-27 04:00:00+00:00", "2019-05-27 03:00:00+00:00", "2019-05-27 02:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-27 00:00:00+00:00", "2019-05-26 23:00:00+00:00", "2019-05-26 22:00:00+00:00", "2019-05-26 21:00:00+00:00", "2019-05-26 20:00:00+00:00", "2019-05-26 19:00:00+00:00", "2019-05-26 18:00:00+00:00", "2019-05-26 17:00:00+00:00", "2019-05-26 16:00:00+00:00", "2019-05-26 15:00:00+00:00", "2019-05-26 14:00:00+00:00", "2019-05-26 13:00:00+00:00", "2019-05-26 12:00:00+00:00", "2019-05-26 11:00:00+00:00", "2019-05-26 10:00:00+00:00", "2019-05-26 09:00:00+00:00", "2019-05-26 08:00:00+00:00", "2019-05-26 07:00:00+00:00", "2019-05-26 06:00:00+00:00", "2019-05-26 05:00:00+00:00", "2019-05-26 04:00:00+00:00", "2019-05-26 03:00:00+00:00", "2019-05-26 02:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-26 00:00:00+00:00", "2019-05-25 23:00:00+00:00", "2019-05-25 22:00:00+00:00", "2019-05-25 21:00:00+00:00", "2019-05-25 20:00:00+00:00", "2019-05-25 19:00:00+00:00", "2019-05-25 18:00:00+00:00", "2019-05-25 17:00:00+00:00", "2019-05-25 16:00:00+00:00", "2019-05-25 15:00:00+00:00", "2019-05-25 14:00:00+00:00", "2019-05-25 13:00:00+00:00", "2019-05-25 12:00:00+00:00", "2019-05-25 11:00:00+00:00", "2019-05-25 10:00:00+00:00", "2019-05-25 09:00:00+00:00", "2019-05-25 08:00:00+00:00", "2019-05-25 07:00:00+00:00", "2019-05-25 06:00:00+00:00", "2019-05-25 05:00:00+00:00", "2019-05-25 04:00:00+00:00", "2019-05-25 03:00:00+00:00", "2019-05-25 02:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-25 00:00:00+00:00", "2019-05-24 23:00:00+00:00", "2019-05-24 22:00:00+00:00", "2019-05-24 21:00:00+00:00", "2019-05-24 20:00:00+00:00", "2019-05-24 19:00:00+00:00", "2019-05-24 18:00:00+00:00", "2019-05-24 17:00:00+00:00", "2019-05-24 16:00:00+00:00", "2019-05-24 15:00:00+00:00", "2019-05-24 14:00:00+00:00", "2019-05-24 13:00:00+00:00", "2019-05-24 12:00:00+00:00", "2019-05-24 11:00:00+00:00", "2019-05-24 10:00:00+00:00", "2019-05-24 09:00:00+00:00", "2019-05-24 08:00:00+00:00", "2019-05-24 07:00:00+00:00", "2019-05-24 06:00:00+00:00", "2019-05-24 05:00:00+00:00", "2019-05-24 04:00:00+00:00", "2019-05-24 03:00:00+00:00", "2019-05-24 02:00:00+00:00", "2019-05-24 00:00:00+00:00", "2019-05-23 23:00:00+00:00", "2019-05-23 22:00:00+00:00", "2019-05-23 21:00:00+00:00", "2019-05-23 20:00:00+00:00", "2019-05-23 19:00:00+00:00", "2019-05-23 18:00:00+00:00", "2019-05-23 17:00:00+00:00", "2019-05-23 16:00:00+00:00", "2019-05-23 15:00:00+00:00", "2019-05-23 14:00:00+00:00", "2019-05-23 13:00:00+00:00", "2019-05-23 12:00:00+00:00", "2019-05-23 11:00:00+00:00", "2019-05-23 10:00:00+00:00", "2019-05-23 09:00:00+00:00", "2019-05-23 08:00:00+00:00", "2019-05-23 07:00:00+00:00", "2019-05-23 06:00:00+00:00", "2019-05-23 05:00:00+00:00", "2019-05-23 04:00:00+00:00", "2019-05-23 03:00:00+00:00", "2019-05-23 02:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-23 00:00:00+00:00", "2019-05-22 23:00:00+00:00", "2019-05-22 22:00:00+00:00", "2019-05-22 21:00:00+00:00", "2019-05-22 20:00:00+00:00", "2019-05-22 19:00:00+00:00", "2019-05-22 18:00:00+00:00", "2019-05-22 17:00:00+00:00", "2019-05-22 16:00:00+00:00", "2019-05-22 15:00:00+00:00", "2019-05-22 14:00:00+00:00", "2019-05-22 13:00:00+00:00", "2019-05-22 12:00:00+00:00", "2019-05-22 11:00:00+00:00", "2019-05-22 10:00:00+00:00", "2019-05-22 09:00:00+00:00", "2019-05-22 08:00:00+00:00", "2019-05-22 07:00:00+00:00", "2019-05-22 06:00:00+00:00", "2019-05-22 05:00:00+00:00", "2019-05-22 04:00:00+00:00", "2019-05-22 03:00:00+00:00", "2019-05-22 02:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-22 00:00:00+00:00", "2019-05-21 23:00:00+00:00", "2019-05-21 22:00:00+00:00", "2019-05-21 21:00:00+00:00", "2019-05-21 20:00:00+00:00", "2019-05-21 19:00:00+00:00", "2019-05-21 18:00:00+00:00", "2019-05-21 17:00:00+00:00", "2019-05-21 16:00:00+00:00", "2019-05-21 15:00:00+00:00", "2019-05-21 14:00:00+00:00", "2019-05-21 13:00:00+00:00", "2019-05-21 12:00:00+00:00", "2019-05-21 11:00:00+00:00", "2019-05-21 10:00:00+00:00", "2019-05-21 09:00:00+00:00", "2019-05-21 08:00:00+00:00", "2019-05-21 07:00:00+00:00", "2019-05-21 06:00:00+00:00", "2019-05-21 05:00:00+00:00", "2019-05-21 04:00:00+00:00", "2019-05-21 03:00:00+00:00", "2019-05-21 02:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-21 00:00:00+00:00", "2019-05-20 23:00:00+00:00", "2019-05-20 22:00:00+00:00", "2019-05-20 21:00:00+00:00", "2019-05-20 20:00:00+00:00", "2019-05-20 19:00:00+00:00", "2019-05-20 18:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 17:00:00+00:00", "2019-05-18 16:00:00+00:00", "2019-05-18 15:00:00+00:00", "2019-05-18 14:00:00+00:00", "2019-05-18 13:00:00+00:00", "2019-05-18 12:00:00+00:00", "2019-05-18 11:00:00+00:00", "2019-05-18 10:00:00+00:00", "2019-05-18 09:00:00+00:00", "2019-05-18 08:00:00+00:00", "2019-05-18 07:00:00+00:00", "2019-05-18 06:00:00+00:00", "2019-05-18 05:00:00+00:00", "2019-05-18 04:00:00+00:00", "2019-05-18 03:00:00+00:00", "2019-05-18 02:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-18 00:00:00+00:00", "2019-05-17 23:00:00+00:00", "2019-05-17 22:00:00+00:00", "2019-05-17 21:00:00+00:00", "2019-05-17 20:00:00+00:00", "2019-05-17 19:00:00+00:00", "2019-05-17 18:00:00+00:00", "2019-05-17 17:00:00+00:00", "2019-05-17 16:00:00+00:00", "2019-05-17 15:00:00+00:00", "2019-05-17 14:00:00+00:00", "2019-05-17 13:00:00+00:00", "2019-05-17 12:00:00+00:00", "2019-05-17 11:00:00+00:00", "2019-05-17 10:00:00+00:00", "2019-05-17 09:00:00+00:00", "2019-05-17 08:00:00+00:00", "2019-05-17 07:00:00+00:00", "2019-05-17 06:00:00+00:00", "2019-05-17 05:00:00+00:00", "2019-05-17 04:00:00+00:00", "2019-05-17 03:00:00+00:00", "2019-05-17 02:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-17 00:00:00+00:00", "2019-05-16 23:00:00+00:00", "2019-05-16 22:00:00+00:00", "2019-05-16 21:00:00+00:00", "2019-05-16 20:00:00+00:00", "2019-05-16 19:00:00+00:00", "2019-05-16 18:00:00+00:00", "2019-05-16 17:00:00+00:00", "2019-05-16 16:00:00+00:00", "2019-05-16 15:00:00+00:00", "2019-05-16 14:00:00+00:00", "2019-05-16 13:00:00+00:00", "2019-05-16 12:00:00+00:00", "2019-05-16 11:00:00+00:00", "2019-05-16 10:00:00+00:00", "2019-05-16 09:00:00+00:00", "2019-05-16 08:00:00+00:00", "2019-05-16 07:00:00+00:00", "2019-05-16 06:00:00+00:00", "2019-05-16 05:00:00+00:00", "2019-05-16 04:00:00+00:00", "2019-05-16 03:00:00+00:00", "2019-05-16 02:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-16 00:00:00+00:00", "2019-05-15 23:00:00+00:00", "2019-05-15 22:00:00+00:00", "2019-05-15 21:00:00+00:00", "2019-05-15 20:00:00+00:00", "2019-05-15 19:00:00+00:00", "2019-05-15 18:00:00+00:00", "2019-05-15 17:00:00+00:00", "2019-05-15 16:00:00+00:00", "2019-05-15 15:00:00+00:00", "2019-05-15 14:00:00+00:00", "2019-05-15 13:00:00+00:00", "2019-05-15 12:00:00+00:00", "2019-05-15 11:00:00+00:00", "2019-05-15 10:00:00+00:00", "2019-05-15 09:00:00+00:00", "2019-05-15 08:00:00+00:00", "2019-05-15 07:00:00+00:00", "2019-05-15 06:00:00+00:00", "2019-05-15 05:00:00+00:00", "2019-05-15 04:00:00+00:00", "2019-05-15 03:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 00:00:00+00:00", "2019-05-14 23:00:00+00:00", "2019-05-14 22:00:00+00:00", "2019-05-14 21:00:00+00:00", "2019-05-14 20:00:00+00:00", "2019-05-14 19:00:00+00:00", "2019-05-14 18:00:00+00:00", "2019-05-14 17:00:00+00:00", "2019-05-14 16:00:00+00:00", "2019-05-14 15:00:00+00:00", "2019-05-14 14:00:00+00:00", "2019-05-14 13:00:00+00:00", "2019-05-14 12:00:00+00:00", "2019-05-14 11:00:00+00:00", "2019-05-14 10:00:00+00:00", "2019-05-14 09:00:00+00:00", "2019-05-14 08:00:00+00:00", "2019-05-14 07:00:00+00:00", "2019-05-14 06:00:00+00:00", "2019-05-14 05:00:00+00:00", "2019-05-14 04:00:00+00:00", "2019-05-14 03:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-14 00:00:00+00:00", "2019-05-13 23:00:00+00:00", "2019-05-13 22:00:00+00:00", "2019-05-13 21:00:00+00:00", "2019-05-13 20:00:00+00:00", "2019-05-13 19:00:00+00:00", "2019-05-13 18:00:00+00:00", "2019-05-13 17:00:00+00:00", "2019-05-13 16:00:00+00:00", "2019-05-13 15:00:00+00:00", "2019-05-13 14:00:00+00:00", "2019-05-13 13:00:00+00:00", "2019-05-13 12:00:00+00:00", "2019-05-13 11:00:00+00:00", "2019-05-13 10:00:00+00:00", "2019-05-13 09:00:00+00:00", "2019-05-13 08:00:00+00:00", "2019-05-13 07:00:00+00:00", "2019-05-13 06:00:00+00:00", "2019-05-13 05:00:00+00:00", "2019-05-13 04:00:00+00:00", "2019-05-13 03:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-13 00:00:00+00:00", "2019-05-12 23:00:00+00:00", "2019-05-12 22:00:00+00:00", "2019-05-12 21:00:00+00:00", "2019-05-12 20:00:00+00:00", "2019-05-12 19:00:00+00:00", "2019-05-12 18:00:00+00:00", "2019-05-12 17:00:00+00:00", "2019-05-12 16:00:00+00:00", "2019-05-12 15:00:00+00:00", "2019-05-12 14:00:00+00:00", "2019-05-12 13:00:00+00:00", "2019-05-12 12:00:00+00:00", "2019-05-12 11:00:00+00:00", "2019-05-12 10:00:00+00:00", "2019-05-12 09:00:00+00:00", "2019-05-12 08:00:00+00:00", "2019-05-12 07:00:00+00:00", "2019-05-12 06:00:00+00:00", "2019-05-12 05:00:00+00:00", "2019-05-12 04:00:00+00:00", "2019-05-12 03:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-12 00:00:00+00:00", "2019-05-11 23:00:00+00:00", "2019-05-11 22:00:00+00:00", "2019-05-11 21:00:00+00:00", "2019-05-11 20:00:00+00:00", "2019-05-11 19:00:00+00:00", "2019-05-11 18:00:00+00:00", "2019-05-11 17:00:00+00:00", "2019-05-11 16:00:00+00:00", "2019-05-11 15:00:00+00:00", "2019-05-11 09:00:00+00:00", "2019-05-11 08:00:00+00:00", "2019-05-11 07:00:00+00:00", "2019-05-11 06:00:00+00:00", "2019-05-11 05:00:00+00:00", "2019-05-11 04:00:00+00:00", "2019-05-11 03:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-11 00:00:00+00:00", "2019-05-10 23:00:00+00:00", "2019-05-10 22:00:00+00:00", "2019-05-10 21:00:00+00:00", "2019-05-10 20:00:00+00:00", "2019-05-10 19:00:00+00:00", "2019-05-10 18:00:00+00:00", "2019-05-10 17:00:00+00:00", "2019-05-10 16:00:00+00:00", "2019-05-10 15:00:00+00:00", "2019-05-10 14:00:00+00:00", "2019-05-10 13:00:00+00:00", "2019-05-10 12:00:00+00:00", "2019-05-10 11:00:00+00:00", "2019-05-10 10:00:00+00:00", "2019-05-10 09:00:00+00:00", "2019-05-10 08:00:00+00:00", "2019-05-10 07:00:00+00:00", "2019-05-10 06:00:00+00:00", "2019-05-10 05:00:00+00:00", "2019-05-10 04:00:00+00:00", "2019-05-10 03:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-10 00:00:00+00:00", "2019-05-09 23:00:00+00:00", "2019-05-09 22:00:00+00:00", "2019-05-09 21:00:00+00:00", "2019-05-09 20:00:00+00:00", "2019-05-09 19:00:00+00:00", "2019-05-09 18:00:00+00:00", "2019-05-09 17:00:00+00:00", "2019-05-09 16:00:00+00:00", "2019-05-09 15:00:00+00:00", "2019-05-09 14:00:00+00:00", "2019-05-09 13:00:00+00:00", "2019-05-09 12:00:00+00:00", "2019-05-09 11:00:00+00:00", "2019-05-09 10:00:00+00:00", "2019-05-09 09:00:00+00:00", "2019-05-09 08:00:00+00:00", "2019-05-09 07:00:00+00:00", "2019-05-09 06:00:00+00:00", "2019-05-09 05:00:00+00:00", "2019-05-09 04:00:00+00:00", "2019-05-09 03:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 00:00:00+00:00", "2019-05-08 23:00:00+00:00", "2019-05-08 21:00:00+00:00", "2019-05-08 20:00:00+00:00", "2019-05-08 19:00:00+00:00", "2019-05-08 18:00:00+00:00", "2019-05-08 17:00:00+00:00", "2019-05-08 16:00:00+00:00", "2019-05-08 15:00:00+00:00", "2019-05-08 14:00:00+00:00", "2019-05-08 13:00:00+00:00", "2019-05-08 12:00:00+00:00", "2019-05-08 11:00:00+00:00", "2019-05-08 10:00:00+00:00", "2019-05-08 09:00:00+00:00", "2019-05-08 08:00:00+00:00", "2019-05-08 07:00:00+00:00", "2019-05-08 06:00:00+00:00", "2019-05-08 05:00:00+00:00", "2019-05-08 04:00:00+00:00", "2019-05-08 03:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-08 00:00:00+00:00", "2019-05-07 23:00:00+00:00", "2019-05-07 21:00:00+00:00", "2019-05-07 20:00:00+00:00", "2019-05-07 19:00:00+00:00", "2019-05-07 18:00:00+00:00", "2019-05-07 17:00:00+00:00", "2019-05-07 16:00:00+00:00", "2019-05-07 15:00:00+00:00", "2019-05-07 14:00:00+00:00", "2019-05-07 13:00:00+00:00", "2019-05-07 12:00:00+00:00", "2019-05-07 11:00:00+00:00", "2019-05-07 10:00:00+00:00", "2019-05-07 09:00:00+00:00", "2019-05-07 08:00:00+00:00", "2019-05-07 07:00:00+00:00", "2019-05-07 06:00:00+00:00", "2019-05-07 04:00:00+00:00", "2019-05-07 03:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 23:00:00+00:00", "2019-05-06 22:00:00+00:00", "2019-05-06 21:00:00+00:00", "2019-05-06 20:00:00+00:00", "2019-05-06 19:00:00+00:00", "2019-05-06 18:00:00+00:00", "2019-05-06 17:00:00+00:00", "2019-05-06 16:00:00+00:00", "2019-05-06 15:00:00+00:00", "2019-05-06 14:00:00+00:00", "2019-05-06 13:00:00+00:00", "2019-05-06 12:00:00+00:00", "2019-05-06 11:00:00+00:00", "2019-05-06 10:00:00+00:00", "2019-05-06 09:00:00+00:00", "2019-05-06 08:00:00+00:00", "2019-05-06 07:00:00+00:00", "2019-05-06 06:00:00+00:00", "2019-05-06 05:00:00+00:00", "2019-05-06 04:00:00+00:00", "2019-05-06 03:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-06 00:00:00+00:00", "2019-05-05 23:00:00+00:00", "2019-05-05 22:00:00+00:00", "2019-05-05 21:00:00+00:00", "2019-05-05 20:00:00+00:00", "2019-05-05 19:00:00+00:00", "2019-05-05 18:00:00+00:00", "2019-05-05 17:00:00+00:00", "2019-05-05 16:00:00+00:00", "2019-05-05 15:00:00+00:00", "2019-05-05 14:00:00+00:00", "2019-05-05 13:00:00+00:00", "2019-05-05 12:00:00+00:00", "2019-05-05 11:00:00+00:00", "2019-05-05 10:00:00+00:00", "2019-05-05 09:00:00+00:00", "2019-05-05 08:00:00+00:00", "2019-05-05 07:00:00+00:00", "2019-05-05 06:00:00+00:00", "2019-05-05 05:00:00+00:00", "2019-05-05 04:00:00+00:00", "2019-05-05 03:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-05 00:00:00+00:00", "2019-05-04 23:00:00+00:00", "2019-05-04 22:00:00+00:00", "2019-05-04 21:00:00+00:00", "2019-05-04 20:00:00+00:00", "2019-05-04 19:00:00+00:00", "2019-05-04 18:00:00+00:00", "2019-05-04 17:00:00+00:00", "2019-05-04 16:00:00+00:00", "2019-05-04 15:00:00+00:00", "2019-05-04 14:00:00+00:00", "2019-05-04 13:00:00+00:00", "2019-05-04 12:00:00+00:00", "2019-05-04 11:00:00+00:00", "2019-05-04 10:00:00+00:00", "2019-05-04 09:00:00+00:00", "2019-05-04 08:00:00+00:00", "2019-05-04 07:00:00+00:00", "2019-05-04 06:00:00+00:00", "2019-05-04 05:00:00+00:00", "2019-05-04 04:00:00+00:00", "2019-05-04 03:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-04 00:00:00+00:00", "2019-05-03 23:00:00+00:00", "2019-05-03 22:00:00+00:00", "2019-05-03 21:00:00+00:00", "2019-05-03 20:00:00+00:00", "2019-05-03 19:00:00+00:00", "2019-05-03 18:00:00+00:00", "2019-05-03 17:00:00+00:00", "2019-05-03 16:00:00+00:00", "2019-05-03 15:00:00+00:00", "2019-05-03 14:00:00+00:00", "2019-05-03 13:00:00+00:00", "2019-05-03 12:00:00+00:00", "2019-05-03 11:00:00+00:00", "2019-05-03 10:00:00+00:00", "2019-05-03 09:00:00+00:00", "2019-05-03 08:00:00+00:00", "2019-05-03 07:00:00+00:00", "2019-05-03 06:00:00+00:00", "2019-05-03 05:00:00+00:00", "2019-05-03 04:00:00+00:00", "2019-05-03 03:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-03 00:00:00+00:00", "2019-05-02 23:00:00+00:00", "2019-05-02 22:00:00+00:00", "2019-05-02 21:00:00+00:00", "2019-05-02 20:00:00+00:00", "2019-05-02 19:00:00+00:00", "2019-05-02 18:00:00+00:00", "2019-05-02 17:00:00+00:00", "2019-05-02 16:00:00+00:00", "2019-05-02 15:00:00+00:00", "2019-05-02 14:00:00+00:00", "2019-05-02 13:00:00+00:00", "2019-05-02 12:00:00+00:00", "2019-05-02 11:00:00+00:00", "2019-05-02 10:00:00+00:00", "2019-05-02 09:00:00+00:00", "2019-05-02 08:00:00+00:00", "2019-05-02 07:00:00+00:00", "2019-05-02 06:00:00+00:00", "2019-05-02 05:00:00+00:00", "2019-05-02 04:00:00+00:00", "2019-05-02 03:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-02 00:00:00+00:00", "2019-05-01 23:00:00+00:00", "2019-05-01 22:00:00+00:00", "2019-05-01 21:00:00+00:00", "2019-05-01 20:00:00+00:00", "2019-05-01 19:00:00+00:00", "2019-05-01 18:00:00+00:00", "2019-05-01 17:00:00+00:00", "2019-05-01 16:00:00+00:00", "2019-05-01 15:00:00+00:00", "2019-05-01 14:00:00+00:00", "2019-05-01 13:00:00+00:00", "2019-05-01 12:00:00+00:00", "2019-05-01 11:00:00+00:00", "2019-05-01 10:00:00+00:00", "2019-05-01 09:00:00+00:00", "2019-05-01 08:00:00+00:00", "2019-05-01 07:00:00+00:00", "2019-05-01 06:00:00+00:00", "2019-05-01 05:00:00+00:00", "2019-05-01 04:00:00+00:00", "2019-05-01 03:00:00+00:00", "2019-05-01 00:00:00+00:00", "2019-04-30 23:00:00+00:00", "2019-04-30 22:00:00+00:00", "2019-04-30 21:00:00+00:00", "2019-04-30 20:00:00+00:00", "2019-04-30 19:00:00+00:00", "2019-04-30 18:00:00+00:00", "2019-04-30 17:00:00+00:00", "2019-04-30 16:00:00+00:00", "2019-04-30 15:00:00+00:00", "2019-04-30 14:00:00+00:00", "2019-04-30 13:00:00+00:00", "2019-04-30 12:00:00+00:00", "2019-04-30 11:00:00+00:00", "2019-04-30 10:00:00+00:00", "2019-04-30 09:00:00+00:00", "2019-04-30 08:00:00+00:00", "2019-04-30 07:00:00+00:00", "2019-04-30 06:00:00+00:00", "2019-04-30 05:00:00+00:00", "2019-04-30 04:00:00+00:00", "2019-04-30 03:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-30 00:00:00+00:00", "2019-04-29 23:00:00+00:00", "2019-04-29 22:00:00+00:00", "2019-04-29 21:00:00+00:00", "2019-04-29 20:00:00+00:00", "2019-04-29 19:00:00+00:00", "2019-04-29 18:00:00+00:00", "2019-04-29 17:00:00+00:00", "2019-04-29 16:00:00+00:00", "2019-04-29 15:00:00+00:00", "2019-04-29 14:00:00+00:00", "2019-04-29 13:00:00+00:00", "2019-04-29 12:00:00+00:00", "2019-04-29 11:00:00+00:00", "2019-04-29 10:00:00+00:00", "2019-04-29 09:00:00+00:00", "2019-04-29 08:00:00+00:00", "2019-04-29 07:00:00+00:00", "2019-04-29 06:00:00+00:00", "2019-04-29 05:00:00+00:00", "2019-04-29 04:00:00+00:00", "2019-04-29 03:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-29 00:00:00+00:00", "2019-04-28 23:00:00+00:00", "2019-04-28 22:00:00+00:00", "2019-04-28 21:00:00+00:00", "2019-04-28 20:00:00+00:00", "2019-04-28 19:00:00+00:00", "2019-04-28 18:00:00+00:00", "2019-04-28 17:00:00+00:00", "2019-04-28 16:00:00+00:00", "2019-04-28 15:00:00+00:00", "2019-04-28 14:00:00+00:00", "2019-04-28 13:00:00+00:00", "2019-04-28 12:00:00+00:00", "2019-04-28 11:00:00+00:00", "2019-04-28 10:00:00+00:00", "2019-04-28 09:00:00+00:00", "2019-04-27 13:00:00+00:00", "2019-04-27 12:00:00+00:00", "2019-04-27 11:00:00+00:00", "2019-04-27 10:00:00+00:00", "2019-04-27 09:00:00+00:00", "2019-04-27 08:00:00+00:00", "2019-04-27 07:00:00+00:00", "2019-04-27 06:00:00+00:00", "2019-04-27 05:00:00+00:00", "2019-04-27 04:00:00+00:00", "2019-04-27 03:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 00:00:00+00:00", "2019-04-26 23:00:00+00:00", "2019-04-26 22:00:00+00:00", "2019-04-26 21:00:00+00:00", "2019-04-26 20:00:00+00:00", "2019-04-26 19:00:00+00:00", "2019-04-26 18:00:00+00:00", "2019-04-26 17:00:00+00:00", "2019-04-26 16:00:00+00:00", "2019-04-26 15:00:00+00:00", "2019-04-26 14:00:00+00:00", "2019-04-26 13:00:00+00:00", "2019-04-26 12:00:00+00:00", "2019-04-26 11:00:00+00:00", "2019-04-26 10:00:00+00:00", "2019-04-26 09:00:00+00:00", "2019-04-26 08:00:00+00:00", "2019-04-26 07:00:00+00:00", "2019-04-26 06:00:00+00:00", "2019-04-26 05:00:00+00:00", "2019-04-26 04:00:00+00:00", "2019-04-26 03:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-26 00:00:00+00:00", "2019-04-25 23:00:00+00:00", "2019-04-25 22:00:00+00:00", "2019-04-25 21:00:00+00:00", "2019-04-25 20:00:00+00:00", "2019-04-25 19:00:00+00:00", "2019-04-25 18:00:00+00:00", "2019-04-25 17:00:00+00:00", "2019-04-25 16:00:00+00:00", "2019-04-25 15:00:00+00:00", "2019-04-25 14:00:00+00:00", "2019-04-25 13:00:00+00:00", "2019-04-25 12:00:00+00:00", "2019-04-25 11:00:00+00:00", "2019-04-25 10:00:00+00:00", "2019-04-25 09:00:00+00:00", "2019-04-25 08:00:00+00:00", "2019-04-25 07:00:00+00:00", "2019-04-25 06:00:00+00:00", "2019-04-25 05:00:00+00:00", "2019-04-25 04:00:00+00:00", "2019-04-25 03:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 00:00:00+00:00", "2019-04-24 23:00:00+00:00", "2019-04-24 22:00:00+00:00", "2019-04-24 21:00:00+00:00", "2019-04-24 20:00:00+00:00", "2019-04-24 19:00:00+00:00", "2019-04-24 18:00:00+00:00", "2019-04-24 17:00:00+00:00", "2019-04-24 16:00:00+00:00", "2019-04-24 15:00:00+00:00", "2019-04-24 14:00:00+00:00", "2019-04-24 13:00:00+00:00", "2019-04-24 12:00:00+00:00", "2019-04-24 11:00:00+00:00", "2019-04-24 10:00:00+00:00", "2019-04-24 09:00:00+00:00", "2019-04-24 08:00:00+00:00", "2019-04-24 07:00:00+00:00", "2019-04-24 06:00:00+00:00", "2019-04-24 05:00:00+00:00", "2019-04-24 04:00:00+00:00", "2019-04-24 03:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 00:00:00+00:00", "2019-04-23 23:00:00+00:00", "2019-04-23 22:00:00+00:00", "2019-04-23 21:00:00+00:00", "2019-04-23 20:00:00+00:00", "2019-04-23 19:00:00+00:00", "2019-04-23 18:00:00+00:00", "2019-04-23 17:00:00+00:00", "2019-04-23 16:00:00+00:00", "2019-04-23 15:00:00+00:00", "2019-04-23 14:00:00+00:00", "2019-04-23 13:00:00+00:00", "2019-04-23 12:00:00+00:00", "2019-04-23 11:00:00+00:00", "2019-04-23 10:00:00+00:00", "2019-04-23 09:00:00+00:00", "2019-04-23 08:00:00+00:00", "2019-04-23 07:00:00+00:00", "2019-04-23 06:00:00+00:00", "2019-04-23 05:00:00+00:00", "2019-04-23 04:00:00+00:00", "2019-04-23 03:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-23 00:00:00+00:00", "2019-04-22 23:00:00+00:00", "2019-04-22 22:00:00+00:00", "2019-04-22 21:00:00+00:00", "2019-04-22 20:00:00+00:00", "2019-04-22 19:00:00+00:00", "2019-04-22 18:00:00+00:00", "2019-04-22 17:00:00+00:00", "2019-04-22 16:00:00+00:00", "2019-04-22 15:00:00+00:00", "2019-04-22 14:00:00+00:00", "2019-04-22 13:00:00+00:00", "2019-04-22 12:00:00+00:00", "2019-04-22 11:00:00+00:00", "2019-04-22 10:00:00+00:00", "2019-04-22 09:00:00+00:00", "2019-04-22 08:00:00+00:00", "2019-04-22 07:00:00+00:00", "2019-04-22 06:00:00+00:00", "2019-04-22 05:00:00+00:00", "2019-04-22 04:00:00+00:00", "2019-04-22 03:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-22 00:00:00+00:00", "2019-04-21 23:00:00+00:00", "2019-04-21 22:00:00+00:00", "2019-04-21 21:00:00+00:00", "2019-04-21 20:00:00+00:00", "2019-04-21 19:00:00+00:00", "2019-04-21 18:00:00+00:00", "2019-04-21 17:00:00+00:00", "2019-04-21 16:00:00+00:00", "2019-04-21 15:00:00+00:00", "2019-04-21 14:00:00+00:00", "2019-04-21 13:00:00+00:00", "2019-04-21 12:00:00+00:00", "2019-04-21 11:00:00+00:00", "2019-04-21 10:00:00+00:00", "2019-04-21 09:00:00+00:00", "2019-04-21 08:00:00+00:00", "2019-04-21 07:00:00+00:00", "2019-04-21 06:00:00+00:00", "2019-04-21 05:00:00+00:00", "2019-04-21 04:00:00+00:00", "2019-04-21 03:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-21 00:00:00+00:00", "2019-04-20 23:00:00+00:00", "2019-04-20 22:00:00+00:00", "2019-04-20 21:00:00+00:00", "2019-04-20 20:00:00+00:00", "2019-04-20 19:00:00+00:00", "2019-04-20 18:00:00+00:00", "2019-04-20 17:00:00+00:00", "2019-04-20 16:00:00+00:00", "2019-04-20 15:00:00+00:00", "2019-04-20 14:00:00+00:00", "2019-04-20 13:00:00+00:00", "2019-04-20 12:00:00+00:00", "2019-04-20 11:00:00+00:00", "2019-04-20 10:00:00+00:00", "2019-04-20 09:00:00+00:00", "2019-04-20 08:00:00+00:00", "2019-04-20 07:00:00+00:00", "2019-04-20 06:00:00+00:00", "2019-04-20 05:00:00+00:00", "2019-04-20 04:00:00+00:00", "2019-04-20 03:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-20 00:00:00+00:00", "2019-04-19 23:00:00+00:00", "2019-04-19 22:00:00+00:00", "2019-04-19 21:00:00+00:00", "2019-04-19 20:00:00+00:00", "2019-04-19 19:00:00+00:00", "2019-04-19 18:00:00+00:00", "2019-04-19 17:00:00+00:00", "2019-04-19 16:00:00+00:00", "2019-04-19 15:00:00+00:00", "2019-04-19 14:00:00+00:00", "2019-04-19 13:00:00+00:00", "2019-04-19 12:00:00+00:00", "2019-04-19 11:00:00+00:00", "2019-04-19 10:00:00+00:00", "2019-04-19 09:00:00+00:00", "2019-04-19 08:00:00+00:00", "2019-04-19 07:00:00+00:00", "2019-04-19 06:00:00+00:00", "2019-04-19 05:00:00+00:00", "2019-04-19 04:00:00+00:00", "2019-04-19 03:00:00+00:00", "2019-04-19 02:00:00+00:00", "2019-04-19 00:00:00+00:00", "2019-04-18 23:00:00+00:00", "2019-04-18 22:00:00+00:00", "2019-04-18 21:00:00+00:00", "2019-04-18 20:00:00+00:00", "2019-04-18 19:00:00+00:00", "2019-04-18 18:00:00+00:00", "2019-04-18 17:00:00+00:00", "2019-04-18 16:00:00+00:00", "2019-04-18 15:00:00+00:00", "2019-04-18 14:00:00+00:00", "2019-04-18 13:00:00+00:00", "2019-04-18 12:00:00+00:00", "2019-04-18 11:00:00+00:00", "2019-04-18 10:00:00+00:00", "2019-04-18 09:00:00+00:00", "2019-04-18 08:00:00+00:00", "2019-04-18 07:00:00+00:00", "2019-04-18 06:00:00+00:00", "2019-04-18 05:00:00+00:00", "2019-04-18 04:00:00+00:00", "2019-04-18 03:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-18 00:00:00+00:00", "2019-04-17 23:00:00+00:00", "2019-04-17 22:00:00+00:00", "2019-04-17 21:00:00+00:00", "2019-04-17 20:00:00+00:00", "2019-04-17 19:00:00+00:00", "2019-04-17 18:00:00+00:00", "2019-04-17 17:00:00+00:00", "2019-04-17 16:00:00+00:00", "2019-04-17 15:00:00+00:00", "2019-04-17 14:00:00+00:00", "2019-04-17 13:00:00+00:00", "2019-04-17 12:00:00+00:00", "2019-04-17 11:00:00+00:00", "2019-04-17 10:00:00+00:00", "2019-04-17 09:00:00+00:00", "2019-04-17 08:00:00+00:00", "2019-04-17 07:00:00+00:00", "2019-04-17 06:00:00+00:00", "2019-04-17 05:00:00+00:00", "2019-04-17 04:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 00:00:00+00:00", "2019-04-16 23:00:00+00:00", "2019-04-16 22:00:00+00:00", "2019-04-16 21:00:00+00:00", "2019-04-16 20:00:00+00:00", "2019-04-16 19:00:00+00:00", "2019-04-16 18:00:00+00:00", "2019-04-16 17:00:00+00:00", "2019-04-16 15:00:00+00:00", "2019-04-16 14:00:00+00:00", "2019-04-16 13:00:00+00:00", "2019-04-16 12:00:00+00:00", "2019-04-16 11:00:00+00:00", "2019-04-16 10:00:00+00:00", "2019-04-16 09:00:00+00:00", "2019-04-16 08:00:00+00:00", "2019-04-16 07:00:00+00:00", "2019-04-16 06:00:00+00:00", "2019-04-16 05:00:00+00:00", "2019-04-16 04:00:00+00:00", "2019-04-16 03:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 00:00:00+00:00", "2019-04-15 23:00:00+00:00", "2019-04-15 22:00:00+00:00", "2019-04-15 21:00:00+00:00", "2019-04-15 20:00:00+00:00", "2019-04-15 19:00:00+00:00", "2019-04-15 18:00:00+00:00", "2019-04-15 17:00:00+00:00", "2019-04-15 16:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-15 00:00:00+00:00", "2019-04-14 23:00:00+00:00", "2019-04-14 22:00:00+00:00", "2019-04-14 21:00:00+00:00", "2019-04-14 20:00:00+00:00", "2019-04-14 19:00:00+00:00", "2019-04-14 18:00:00+00:00", "2019-04-14 17:00:00+00:00", "2019-04-14 16:00:00+00:00", "2019-04-14 15:00:00+00:00", "2019-04-14 14:00:00+00:00", "2019-04-14 13:00:00+00:00", "2019-04-14 12:00:00+00:00", "2019-04-14 11:00:00+00:00", "2019-04-14 10:00:00+00:00", "2019-04-14 09:00:00+00:00", "2019-04-14 08:00:00+00:00", "2019-04-14 07:00:00+00:00", "2019-04-14 06:00:00+00:00", "2019-04-14 05:00:00+00:00", "2019-04-14 04:00:00+00:00", "2019-04-14 03:00:00+00:00", "2019-04-14 02:00:00+00:00", "2019-04-14 01:00:00+00:00", "2019-04-14 00:00:00+00:00", "2019-04-13 23:00:00+00:00", "2019-04-13 22:00:00+00:00", "2019-04-13 21:00:00+00:00", "2019-04-13 20:00:00+00:00", "2019-04-13 19:00:00+00:00", "2019-04-13 18:00:00+00:00", "2019-04-13 17:00:00+00:00", "2019-04-13 16:00:00+00:00", "2019-04-13 15:00:00+00:00", "2019-04-13 14:00:00+00:00", "2019-04-13 13:00:00+00:00", "2019-04-13 12:00:00+00:00", "2019-04-13 11:00:00+00:00", "2019-04-13 10:00:00+00:00", "2019-04-13 09:00:00+00:00", "2019-04-13 08:00:00+00:00", "2019-04-13 07:00:00+00:00", "2019-04-13 06:00:00+00:00", "2019-04-13 05:00:00+00:00", "2019-04-13 04:00:00+00:00", "2019-04-13 03:00:00+00:00", "2019-04-13 02:00:00+00:00", "2019-04-13 01:00:00+00:00", "2019-04-13 00:00:00+00:00", "2019-04-12 23:00:00+00:00", "2019-04-12 22:00:00+00:00", "2019-04-12 21:00:00+00:00", "2019-04-12 20:00:00+00:00", "2019-04-12 19:00:00+00:00", "2019-04-12 18:00:00+00:00", "2019-04-12 17:00:00+00:00", "2019-04-12 16:00:00+00:00", "2019-04-12 15:00:00+00:00", "2019-04-12 14:00:00+00:00", "2019-04-12 13:00:00+00:00", "2019-04-12 12:00:00+00:00", "2019-04-12 11:00:00+00:00", "2019-04-12 10:00:00+00:00", "2019-04-12 09:00:00+00:00", "2019-04-12 08:00:00+00:00", "2019-04-12 07:00:00+00:00", "2019-04-12 06:00:00+00:00", "2019-04-12 05:00:00+00:00", "2019-04-12 04:00:00+00:00", "2019-04-12 03:00:00+00:00", "2019-04-12 00:00:00+00:00", "2019-04-11 23:00:00+00:00", "2019-04-11 22:00:00+00:00", "2019-04-11 21:00:00+00:00", "2019-04-11 20:00:00+00:00", "2019-04-11 19:00:00+00:00", "2019-04-11 18:00:00+00:00", "2019-04-11 17:00:00+00:00", "2019-04-11 16:00:00+00:00", "2019-04-11 15:00:00+00:00", "2019-04-11 14:00:00+00:00", "2019-04-11 13:00:00+00:00", "2019-04-11 12:00:00+00:00", "2019-04-11 11:00:00+00:00", "2019-04-11 10:00:00+00:00", "2019-04-11 09:00:00+00:00", "2019-04-11 08:00:00+00:00", "2019-04-11 07:00:00+00:00", "2019-04-11 06:00:00+00:00", "2019-04-11 05:00:00+00:00", "2019-04-11 04:00:00+00:00", "2019-04-11 03:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 00:00:00+00:00", "2019-04-10 23:00:00+00:00", "2019-04-10 22:00:00+00:00", "2019-04-10 21:00:00+00:00", "2019-04-10 20:00:00+00:00", "2019-04-10 19:00:00+00:00", "2019-04-10 18:00:00+00:00", "2019-04-10 17:00:00+00:00", "2019-04-10 16:00:00+00:00", "2019-04-10 15:00:00+00:00", "2019-04-10 14:00:00+00:00", "2019-04-10 13:00:00+00:00", "2019-04-10 12:00:00+00:00", "2019-04-10 11:00:00+00:00", "2019-04-10 10:00:00+00:00", "2019-04-10 09:00:00+00:00", "2019-04-10 08:00:00+00:00", "2019-04-10 07:00:00+00:00", "2019-04-10 06:00:00+00:00", "2019-04-10 05:00:00+00:00", "2019-04-10 04:00:00+00:00", "2019-04-10 03:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-10 00:00:00+00:00", "2019-04-09 23:00:00+00:00", "2019-04-09 22:00:00+00:00", "2019-04-09 21:00:00+00:00", "2019-04-09 20:00:00+00:00", "2019-04-09 19:00:00+00:00", "2019-04-09 18:00:00+00:00", "2019-04-09 17:00:00+00:00", "2019-04-09 16:00:00+00:00", "2019-04-09 15:00:00+00:00", "2019-04-09 14:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00",
+// 					},
+// 				},
+// 				[][]interface{}{
+// 					{"pm25", "BETR801"},
+// 					{"pm25", "London Westminster"},
+// 					{"no2", "FR04014"},
+// 					{"no2", "BETR801"},
+// 					{"no2", "London Westminster"},
+// 				},
+// 			},
+// 		},
+// 	}
+// 	for _, test := range groupByTests {
+// 		output, err := test.arg1.GroupBy(test.arg2...)
+// 		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}, GroupBy{}), cmpopts.EquateNaNs()) || err != nil {
+// 			t.Fatalf("expected:\n%v,\ngot:\n%v,\nerror:\n%v\n", test.expected, output, err)
+// 		}
+// 	}
+// }
+
+func BenchmarkDataFrameGroupByFunc(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
 	}
+	keyFn := func(row map[string]interface{}) string {
+		return fmt.Sprint(row["Team"])
+	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.Pivot("Sex", "Height")
+		testDf.GroupByFunc(keyFn)
 	}
 }
 
-func TestDataFramePivot(t *testing.T) {
-	type pivotTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     string
-		expected DataFrame
+func TestDataFrameGroupByFunc(t *testing.T) {
+	testDf, err := NewDataFrame(
+		[][]interface{}{{1, 2, 3, 5, 6}, {12.0, 20.0, 30.0, 60.0, 40.0}},
+		[]string{"Number", "Score"},
+		[]string{"Number"},
+	)
+	if err != nil {
+		t.Fatal(err)
 	}
-	pivotTests := []pivotTest{
+	parity := func(row map[string]interface{}) string {
+		if row["Number"].(int)%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	gb, err := testDf.GroupByFunc(parity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, err := gb.Agg([]string{"Score"}, Mean)
+	expected := DataFrame{
+		[]Series{
+			{
+				[]interface{}{"even", "odd"},
+				IndexData{
+					[]Index{{1, []interface{}{"even"}}, {0, []interface{}{"odd"}}},
+					[]string{"group"},
+				},
+				"group",
+				"string",
+			},
+			{
+				[]interface{}{30.0, 34.0},
+				IndexData{
+					[]Index{{1, []interface{}{"even"}}, {0, []interface{}{"odd"}}},
+					[]string{"group"},
+				},
+				"Score",
+				"float64",
+			},
+		},
+		IndexData{
+			[]Index{{1, []interface{}{"even"}}, {0, []interface{}{"odd"}}},
+			[]string{"group"},
+		},
+		[]string{"group", "Score"},
+	}
+	if !cmp.Equal(output, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", expected, output, err)
+	}
+}
+
+func TestDataFramePipe(t *testing.T) {
+	type pipeTest struct {
+		arg1          DataFrame
+		arg2          []func(DataFrame) (DataFrame, error)
+		expected      DataFrame
+		expectedError error
+	}
+	calledAfterError := false
+	pipeTests := []pipeTest{
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfpivot1.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			"Sex",
-			"Height",
-			DataFrame{
-				[]Series{
-					{
-						[]interface{}{172.0, 180.0, math.NaN()},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Male",
-						"float64",
-					},
-					{
-						[]interface{}{math.NaN(), math.NaN(), 165.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-							[]string{"Name"},
-						},
-						"Female",
-						"float64",
-					},
-				},
-				IndexData{
-					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
-					[]string{"Name"},
-				},
-				[]string{"Male", "Female"},
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			[]func(DataFrame) (DataFrame, error){
+				func(df DataFrame) (DataFrame, error) { return df.ColAdd("Age", 2.0) },
+				func(df DataFrame) (DataFrame, error) { return df.ColMul("Age", 2.0) },
+				func(df DataFrame) (DataFrame, error) { return df.NewDerivedCol("AgeCopy", "Age") },
 			},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {42.0, 58.0, 48.0}, {"Male", "Male", "Female"}, {42.0, 58.0, 48.0}}, []string{"Name", "Age", "Sex", "AgeCopy"}, []string{"Name"}),
+			nil,
 		},
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfpivot2.csv", []string{"Time"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			"Fruit",
-			"Color",
-			DataFrame{
-				[]Series{
-					{
-						[]interface{}{"Red", math.NaN()},
-						IndexData{
-							[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
-							[]string{"Time"},
-						},
-						"Apple",
-						"string",
-					},
-					{
-						[]interface{}{"Yellow", "Yellow"},
-						IndexData{
-							[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
-							[]string{"Time"},
-						},
-						"Banana",
-						"string",
-					},
-					{
-						[]interface{}{math.NaN(), "Red"},
-						IndexData{
-							[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
-							[]string{"Time"},
-						},
-						"Cherry",
-						"string",
-					},
-				},
-				IndexData{
-					[]Index{{0, []interface{}{"12:00"}}, {1, []interface{}{"12:01"}}},
-					[]string{"Time"},
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 27.0, 22.0}, {"Male", "Male", "Female"}}, []string{"Name", "Age", "Sex"}, []string{"Name"}),
+			[]func(DataFrame) (DataFrame, error){
+				func(df DataFrame) (DataFrame, error) { return df.ColAdd("Age", 2.0) },
+				func(df DataFrame) (DataFrame, error) { return df.ColAdd("Sex", 2.0) },
+				func(df DataFrame) (DataFrame, error) {
+					calledAfterError = true
+					return df, nil
 				},
-				[]string{"Apple", "Banana", "Cherry"},
+			},
+			DataFrame{},
+			fmt.Errorf("function 1 in pipe failed: cannot add, column data type is not float64 or int"),
+		},
+	}
+
+	for _, test := range pipeTests {
+		output, err := test.arg1.Pipe(test.arg2...)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || (fmt.Sprint(err) != fmt.Sprint(test.expectedError)) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+	if calledAfterError {
+		t.Fatalf("expected Pipe to stop at the first error")
+	}
+}
+
+func TestDataFrameInfo(t *testing.T) {
+	type infoTest struct {
+		arg1     DataFrame
+		expected [][]string
+	}
+	infoTests := []infoTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), math.NaN()}, {true, false, true}}, []string{"Name", "Age", "Score", "Active"}, []string{"Name"}),
+			[][]string{
+				{"0", "Name", "3", "non-null", "string"},
+				{"1", "Age", "3", "non-null", "int"},
+				{"2", "Score", "1", "non-null", "float64"},
+				{"3", "Active", "3", "non-null", "bool"},
 			},
 		},
 	}
 
-	for _, test := range pivotTests {
-		output, err := test.arg1.Pivot(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+	for _, test := range infoTests {
+		output := test.arg1.Info()
+		lines := strings.Split(output, "\n")
+		for _, expectedFields := range test.expected {
+			found := false
+			for _, line := range lines {
+				fields := strings.Fields(line)
+				if len(fields) > len(expectedFields) && cmp.Equal(fields[:len(expectedFields)], expectedFields) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("expected a line starting with %v, got %v", expectedFields, output)
+			}
 		}
 	}
 }
 
-func BenchmarkDataFramePivotTable(b *testing.B) {
+func TestDataFrameMemoryUsage(t *testing.T) {
+	type memoryUsageTest struct {
+		arg1     DataFrame
+		expected map[string]int
+	}
+	memoryUsageTests := []memoryUsageTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), math.NaN()}, {true, false, true}}, []string{"Name", "Age", "Score", "Active"}, []string{"Name"}),
+			map[string]int{"Name": 32 + 5 + 32 + 7 + 32 + 7, "Age": 3 * 24, "Score": 3 * 24, "Active": 3 * 17},
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{19, 27}, {true, false}}, []string{"Value", "Value"}, nil),
+			map[string]int{"Value": 2*24 + 2*17},
+		},
+	}
+
+	for _, test := range memoryUsageTests {
+		output := test.arg1.MemoryUsage()
+		if !cmp.Equal(output, test.expected) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkDataFrameMemOptimize(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
@@ -3672,373 +12124,479 @@ func BenchmarkDataFramePivotTable(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.PivotTable("Team", "Height", "Salary", Mean)
+		testDf.MemOptimize()
 	}
 }
 
-func TestDataFramePivotTable(t *testing.T) {
-	type pivotTableTest struct {
+func TestDataFrameMemOptimize(t *testing.T) {
+	type memOptimizeTest struct {
 		arg1     DataFrame
-		arg2     string
-		arg3     string
-		arg4     string
-		arg5     StatsFunc
 		expected DataFrame
 	}
-	pivotTableTests := []pivotTableTest{
+	memOptimizeTests := []memOptimizeTest{
 		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/testdfpivottable1.csv", []string{"Name"})
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
 				if err != nil {
 					t.Error(err)
 				}
 				return newDf
-			}(),
-			"Team",
-			"Height",
-			"Salary",
-			Mean,
+			}([][]interface{}{{1.0, 2.0, 3.0, 4.0}, {19.0, math.NaN(), 22.0, 25.0}, {1.5, 2.0, math.NaN(), 3.0}, {"Celtics", "Celtics", "Lakers", "Celtics"}, {1e+300, 2.0, 3.0, 4.0}, {math.NaN(), math.NaN(), math.NaN(), math.NaN()}}, []string{"Id", "Age", "Score", "Team", "Big", "Missing"}, []string{"Id"}),
 			DataFrame{
 				[]Series{
 					{
-						[]interface{}{math.NaN(), 1500000.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
-						},
-						"5-11",
-						"float64",
-					},
-					{
-						[]interface{}{6912869.0, math.NaN()},
-						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
-						},
-						"5-9",
-						"float64",
-					},
-					{
-						[]interface{}{5000000.0, 958633.333},
-						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
-						},
-						"6-10",
-						"float64",
-					},
-					{
-						[]interface{}{math.NaN(), 1140240.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
-						},
-						"6-11",
-						"float64",
-					},
-					{
-						[]interface{}{4777348.5, math.NaN()},
-						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
-						},
-						"6-2",
-						"float64",
-					},
-					{
-						[]interface{}{math.NaN(), 2697445.0},
-						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
-						},
-						"6-3",
-						"float64",
-					},
-					{
-						[]interface{}{3431040.0, 817107.5},
-						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
-						},
-						"6-4",
-						"float64",
-					},
-					{
-						[]interface{}{1148640.0, math.NaN()},
+						[]interface{}{1.0, 2.0, 3.0, 4.0},
 						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
+							[]Index{{0, []interface{}{1.0}}, {1, []interface{}{2.0}}, {2, []interface{}{3.0}}, {3, []interface{}{4.0}}},
+							[]string{"Id"},
 						},
-						"6-5",
+						"Id",
 						"float64",
 					},
 					{
-						[]interface{}{4272978.5, math.NaN()},
+						[]interface{}{19, NA, 22, 25},
 						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
+							[]Index{{0, []interface{}{1.0}}, {1, []interface{}{2.0}}, {2, []interface{}{3.0}}, {3, []interface{}{4.0}}},
+							[]string{"Id"},
 						},
-						"6-6",
-						"float64",
+						"Age",
+						"int",
 					},
 					{
-						[]interface{}{3425510.0, 1467660.0},
+						[]interface{}{1.5, 2.0, math.NaN(), 3.0},
 						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
+							[]Index{{0, []interface{}{1.0}}, {1, []interface{}{2.0}}, {2, []interface{}{3.0}}, {3, []interface{}{4.0}}},
+							[]string{"Id"},
 						},
-						"6-7",
+						"Score",
 						"float64",
 					},
 					{
-						[]interface{}{1170960.0, 7330732.5},
+						[]interface{}{"Celtics", "Celtics", "Lakers", "Celtics"},
 						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
+							[]Index{{0, []interface{}{1.0}}, {1, []interface{}{2.0}}, {2, []interface{}{3.0}}, {3, []interface{}{4.0}}},
+							[]string{"Id"},
 						},
-						"6-8",
-						"float64",
+						"Team",
+						"string",
 					},
 					{
-						[]interface{}{7284630.0, math.NaN()},
+						[]interface{}{1e+300, 2.0, 3.0, 4.0},
 						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
+							[]Index{{0, []interface{}{1.0}}, {1, []interface{}{2.0}}, {2, []interface{}{3.0}}, {3, []interface{}{4.0}}},
+							[]string{"Id"},
 						},
-						"6-9",
+						"Big",
 						"float64",
 					},
 					{
-						[]interface{}{2391067.5, 19689000.0},
+						[]interface{}{math.NaN(), math.NaN(), math.NaN(), math.NaN()},
 						IndexData{
-							[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-							[]string{"Team"},
+							[]Index{{0, []interface{}{1.0}}, {1, []interface{}{2.0}}, {2, []interface{}{3.0}}, {3, []interface{}{4.0}}},
+							[]string{"Id"},
 						},
-						"7-0",
+						"Missing",
 						"float64",
 					},
 				},
 				IndexData{
-					[]Index{{0, []interface{}{"Boston Celtics"}}, {1, []interface{}{"Brooklyn Nets"}}},
-					[]string{"Team"},
+					[]Index{{0, []interface{}{1.0}}, {1, []interface{}{2.0}}, {2, []interface{}{3.0}}, {3, []interface{}{4.0}}},
+					[]string{"Id"},
+				},
+				[]string{"Id", "Age", "Score", "Team", "Big", "Missing"},
+			},
+		},
+	}
+
+	for _, test := range memOptimizeTests {
+		output := test.arg1.MemOptimize()
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func TestDataFrameDtypes(t *testing.T) {
+	type dtypesTest struct {
+		arg1     DataFrame
+		expected map[string]string
+	}
+	dtypesTests := []dtypesTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.5, math.NaN(), 3.0}, {true, false, true}}, []string{"Name", "Age", "Score", "Active"}, []string{"Name"}),
+			map[string]string{"Name": "string", "Age": "int", "Score": "float64", "Active": "bool"},
+		},
+		{
+			func() DataFrame {
+				newDf, err := ReadCsv("testfiles/testcsvtypes1.csv", nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}(),
+			map[string]string{"Name": "string", "Age": "int", "Member": "bool", "Score": "float64"},
+		},
+	}
+
+	for _, test := range dtypesTests {
+		output := test.arg1.Dtypes()
+		if !cmp.Equal(output, test.expected) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func TestDataFrameSchema(t *testing.T) {
+	type schemaTest struct {
+		arg1     DataFrame
+		expected []ColumnSchema
+	}
+	schemaTests := []schemaTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.5, math.NaN(), 3.0}, {true, false, true}}, []string{"Name", "Age", "Score", "Active"}, []string{"Name"}),
+			[]ColumnSchema{
+				{"Name", "string", false},
+				{"Age", "int", true},
+				{"Score", "float64", true},
+				{"Active", "bool", false},
+			},
+		},
+	}
+
+	for _, test := range schemaTests {
+		output := test.arg1.Schema()
+		if !cmp.Equal(output, test.expected) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkDataFrameHash(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Hash()
+	}
+}
+
+func TestDataFrameHash(t *testing.T) {
+	newTestDf := func(data [][]interface{}) DataFrame {
+		newDf, err := NewDataFrame(data, []string{"Name", "Age", "Score"}, []string{"Name"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return newDf
+	}
+
+	type hashTest struct {
+		arg1     DataFrame
+		arg2     DataFrame
+		expected bool
+	}
+	hashTests := []hashTest{
+		{
+			newTestDf([][]interface{}{{"Avery", "Bradley"}, {19, NA}, {1.5, math.NaN()}}),
+			newTestDf([][]interface{}{{"Avery", "Bradley"}, {19, NA}, {1.5, math.NaN()}}),
+			true,
+		},
+		{
+			newTestDf([][]interface{}{{"Avery", "Bradley"}, {19, NA}, {1.5, math.NaN()}}),
+			newTestDf([][]interface{}{{"Avery", "Bradley"}, {19, NA}, {1.5, 2.5}}),
+			false,
+		},
+		{
+			newTestDf([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {1.5, 2.5}}),
+			newTestDf([][]interface{}{{"Avery", "Bradley"}, {"19", "27"}, {1.5, 2.5}}),
+			false,
+		},
+		{
+			newTestDf([][]interface{}{{"Avery", "Bradley"}, {19, 27}, {1.5, 2.5}}),
+			newTestDf([][]interface{}{{"Bradley", "Avery"}, {19, 27}, {1.5, 2.5}}),
+			false,
+		},
+	}
+
+	for _, test := range hashTests {
+		hash1, err := test.arg1.Hash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash2, err := test.arg2.Hash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (hash1 == hash2) != test.expected {
+			t.Fatalf("expected equal hashes to be %v, got %v and %v", test.expected, hash1, hash2)
+		}
+	}
+}
+
+func TestDataFrameLenEmpty(t *testing.T) {
+	type lenEmptyTest struct {
+		arg1          DataFrame
+		expectedLen   int
+		expectedEmpty bool
+	}
+	lenEmptyTests := []lenEmptyTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}}, []string{"Name", "Age"}, []string{"Name"}),
+			3,
+			false,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{}, {}}, []string{"Name", "Age"}, nil),
+			0,
+			true,
+		},
+		{
+			DataFrame{index: CreateRangeIndex(3)},
+			3,
+			true,
+		},
+		{
+			DataFrame{},
+			0,
+			true,
+		},
+	}
+
+	for _, test := range lenEmptyTests {
+		if test.arg1.Len() != test.expectedLen || test.arg1.Empty() != test.expectedEmpty {
+			t.Fatalf("expected length %v and empty %v, got %v and %v", test.expectedLen, test.expectedEmpty, test.arg1.Len(), test.arg1.Empty())
+		}
+
+		// these used to index the first series, and panicked on an empty DataFrame
+		test.arg1.Tail(5)
+		if err := test.arg1.SortByIndex(true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if records := test.arg1.GetRecords(); len(records) != test.expectedLen {
+			t.Fatalf("expected %v records, got %v", test.expectedLen, len(records))
+		}
+	}
+}
+
+func TestDataFrameEmptyFrame(t *testing.T) {
+	df := new(DataFrame)
+
+	// none of these should panic on a DataFrame without series
+	df.Print()
+	df.PrintRange(0, 5)
+	df.Head(5)
+	df.Tail(5)
+	if err := df.SortByIndex(true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if records := df.GetRecords(); len(records) != 0 {
+		t.Fatalf("expected no records, got %v", records)
+	}
+
+	merged, err := df.MergeDfsHorizontally(DataFrame{})
+	if err != nil || !merged.Empty() {
+		t.Fatalf("expected an empty DataFrame, got %v, error %v", merged, err)
+	}
+
+	target, err := NewDataFrame([][]interface{}{{"Avery", "Bradley"}, {19, 26}}, []string{"Name", "Age"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := DataFrame{
+		[]Series{
+			{
+				[]interface{}{"Avery", "Bradley"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
 				},
-				[]string{"5-11", "5-9", "6-10", "6-11", "6-2", "6-3", "6-4", "6-5", "6-6", "6-7", "6-8", "6-9", "7-0"},
+				"Name",
+				"string",
 			},
-		},
-		{
-			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/airquality.csv", []string{"Time"})
-				if err != nil {
-					t.Error(err)
-				}
-				return newDf
-			}(),
-			"location",
-			"parameter",
-			"value",
-			Mean,
-			DataFrame{
-				[]Series{
-					{
-						[]interface{}{26.951, 29.374, 29.740},
-						IndexData{
-							[]Index{{0, []interface{}{"BETR801"}}, {1, []interface{}{"FR04014"}}, {2, []interface{}{"London Westminster"}}},
-							[]string{"location"},
-						},
-						"no2",
-						"float64",
-					},
-					{
-						[]interface{}{23.169, math.NaN(), 13.444},
-						IndexData{
-							[]Index{{0, []interface{}{"BETR801"}}, {1, []interface{}{"FR04014"}}, {2, []interface{}{"London Westminster"}}},
-							[]string{"location"},
-						},
-						"pm25",
-						"float64",
-					},
-				},
+			{
+				[]interface{}{19, 26},
 				IndexData{
-					[]Index{{0, []interface{}{"BETR801"}}, {1, []interface{}{"FR04014"}}, {2, []interface{}{"London Westminster"}}},
-					[]string{"location"},
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
 				},
-				[]string{"no2", "pm25"},
+				"Age",
+				"int",
 			},
 		},
+		IndexData{
+			[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+			[]string{""},
+		},
+		[]string{"Name", "Age"},
 	}
-
-	for _, test := range pivotTableTests {
-		output, err := test.arg1.PivotTable(test.arg2, test.arg3, test.arg4, test.arg5)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
-		}
+	merged, err = df.MergeDfsHorizontally(target)
+	if !cmp.Equal(merged, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", expected, merged, err)
 	}
 }
 
-func BenchmarkDataFrameMelt(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/airquality.csv", []string{"Name"})
-	if err != nil {
-		b.Error(err)
-	}
-	dfPivoted, err := testDf.PivotTable("location", "parameter", "value", Mean)
+func BenchmarkDataFrameValues(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
 		b.Error(err)
 	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		dfPivoted.Melt("parameter", "value")
+		testDf.Values(true)
 	}
 }
 
-func TestDataFrameMelt(t *testing.T) {
-	type meltTest struct {
-		arg1     DataFrame
-		arg2     string
-		arg3     string
-		expected DataFrame
+func TestDataFrameValues(t *testing.T) {
+	type valuesTest struct {
+		arg1     [][]interface{}
+		arg2     []string
+		arg3     []string
+		rowMajor [][]interface{}
 	}
-	meltTests := []meltTest{
+	valuesTests := []valuesTest{
+		{
+			[][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.5, math.NaN(), 3.0}, {true, false, true}},
+			[]string{"Name", "Age", "Score", "Active"},
+			[]string{"Name"},
+			[][]interface{}{{"Avery", 19, 1.5, true}, {"Bradley", NA, math.NaN(), false}, {"Candice", 22, 3.0, true}},
+		},
+		{
+			[][]interface{}{{1, 2}},
+			[]string{"Number"},
+			nil,
+			[][]interface{}{{1}, {2}},
+		},
+		{
+			[][]interface{}{{}, {}},
+			[]string{"Name", "Age"},
+			nil,
+			[][]interface{}{},
+		},
+	}
+
+	for _, test := range valuesTests {
+		testDf, err := NewDataFrame(test.arg1, test.arg2, test.arg3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output := testDf.Values(false)
+		if !cmp.Equal(output, test.arg1, cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.arg1, output)
+		}
+		output = testDf.Values(true)
+		if !cmp.Equal(output, test.rowMajor, cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.rowMajor, output)
+		}
+	}
+
+	testDf, err := NewDataFrame([][]interface{}{{1, 2}}, []string{"Number"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDf.Values(false)[0][0] = 100
+	if testDf.series[0].data[0] != 1 {
+		t.Fatalf("expected Values to return a copy, got %v", testDf.series[0].data)
+	}
+}
+
+func TestDataFrameIterRows(t *testing.T) {
+	type iterRowsTest struct {
+		arg1 DataFrame
+	}
+	iterRowsTests := []iterRowsTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}, {1.5, math.NaN(), 3.0}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+		},
 		{
 			func() DataFrame {
-				newDf, err := ReadCsv("./testfiles/airquality.csv", []string{"Time"})
+				newDf, err := ReadCsv("testfiles/testdfpivot2.csv", []string{"Time", "Fruit"})
 				if err != nil {
 					t.Error(err)
 				}
-				dfPivoted, _ := newDf.PivotTable("location", "parameter", "value", Mean)
-				return dfPivoted
+				return newDf
 			}(),
-			"parameter",
-			"value",
-			DataFrame{
-				[]Series{
-					{
-						[]interface{}{"BETR801", "BETR801", "FR04014", "FR04014", "London Westminster", "London Westminster"},
-						IndexData{
-							[]Index{
-								{0, []interface{}{"BETR801"}},
-								{1, []interface{}{"BETR801"}},
-								{2, []interface{}{"FR04014"}},
-								{3, []interface{}{"FR04014"}},
-								{4, []interface{}{"London Westminster"}},
-								{5, []interface{}{"London Westminster"}},
-							},
-							[]string{"location"},
-						},
-						"location",
-						"string",
-					},
-					{
-						[]interface{}{"no2", "pm25", "no2", "pm25", "no2", "pm25"},
-						IndexData{
-							[]Index{
-								{0, []interface{}{"BETR801"}},
-								{1, []interface{}{"BETR801"}},
-								{2, []interface{}{"FR04014"}},
-								{3, []interface{}{"FR04014"}},
-								{4, []interface{}{"London Westminster"}},
-								{5, []interface{}{"London Westminster"}},
-							},
-							[]string{"location"},
-						},
-						"parameter",
-						"string",
-					},
-					{
-						[]interface{}{26.951, 23.169, 29.374, math.NaN(), 29.740, 13.444},
-						IndexData{
-							[]Index{
-								{0, []interface{}{"BETR801"}},
-								{1, []interface{}{"BETR801"}},
-								{2, []interface{}{"FR04014"}},
-								{3, []interface{}{"FR04014"}},
-								{4, []interface{}{"London Westminster"}},
-								{5, []interface{}{"London Westminster"}},
-							},
-							[]string{"location"},
-						},
-						"value",
-						"float64",
-					},
-				},
-				IndexData{
-					[]Index{
-						{0, []interface{}{"BETR801"}},
-						{1, []interface{}{"BETR801"}},
-						{2, []interface{}{"FR04014"}},
-						{3, []interface{}{"FR04014"}},
-						{4, []interface{}{"London Westminster"}},
-						{5, []interface{}{"London Westminster"}},
-					},
-					[]string{"location"},
-				},
-				[]string{"location", "parameter", "value"},
-			},
 		},
 	}
-	for _, test := range meltTests {
-		output, err := test.arg1.Melt(test.arg2, test.arg3)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
-			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+
+	for _, test := range iterRowsTests {
+		done := make(chan struct{})
+		output := make([]map[string]interface{}, 0)
+		for i, row := range iterRowsCollect(test.arg1.IterRows(done)) {
+			if !cmp.Equal(row.Index, test.arg1.index.index[i].value, cmpopts.EquateNaNs()) {
+				t.Fatalf("expected index %v, got %v", test.arg1.index.index[i].value, row.Index)
+			}
+			output = append(output, row.Values)
+		}
+		close(done)
+
+		expected := test.arg1.GetRecords()
+		if !cmp.Equal(output, expected, cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", expected, output)
 		}
 	}
 }
 
-// func TestDataFrameGroupBy(t *testing.T) {
-// 	type groupByTest struct {
-// 		arg1     DataFrame
-// 		arg2     []string
-// 		expected *GroupBy
-// 	}
-// 	groupByTests := []groupByTest{
-// 		{
-// 			func() DataFrame {
-// 				df, err := ReadCsv("./testfiles/airquality.csv", []string{"date.utc"})
-// 				if err != nil {
-// 					t.Error(err)
-// 				}
-// 				return *df
-// 			}(),
-// 			[]string{"parameter", "location"},
-// 			&GroupBy{
-// 				func() *DataFrame {
-// 					df, err := ReadCsv("./testfiles/airquality.csv", []string{"date.utc"})
-// 					if err != nil {
-// 						t.Error(err)
-// 					}
-// 					return df
-// 				}(),
-// 				map[string][]interface{}{
-// 					"57b88dc64ef3f516950d6b78f19ab0df836341799c104fb6b23dddf99fe44d2690d4a9b1a7c7e7831493fa9421ffe8021dd4e3e6c43c753c587d5d1df4d9e377": {
-// 						"2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-15 01:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-13 01:00:00+00:00", "2019-06-12 01:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-24 01:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 01:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 01:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-01 02:00:00+00:00", "2019-05-01 01:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-28 02:00:00+00:00", "2019-04-28 01:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 01:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 01:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-19 01:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 01:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 01:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-12 02:00:00+00:00", "2019-04-12 01:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 01:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00", "2019-04-09 01:00:00+00:00",
-// 					},
-// 					"4f014d3c9993903073a9e5e6a32a2cb8773f0c285c4900f44e81a309ebb21399ec0998fdea2dc8ae3ce9fdfdaf49cdc12956783e512aaa0c7c145bf311335127": {
-// 						"2019-06-21 00:00:00+00:00", "2019-06-20 23:00:00+00:00", "2019-06-20 22:00:00+00:00", "2019-06-20 21:00:00+00:00", "2019-06-20 20:00:00+00:00", "2019-06-20 19:00:00+00:00", "2019-06-20 18:00:00+00:00", "2019-06-20 17:00:00+00:00", "2019-06-20 16:00:00+00:00", "2019-06-20 15:00:00+00:00", "2019-06-20 14:00:00+00:00", "2019-06-20 13:00:00+00:00", "2019-06-19 10:00:00+00:00", "2019-06-19 09:00:00+00:00", "2019-06-18 22:00:00+00:00", "2019-06-18 21:00:00+00:00", "2019-06-18 20:00:00+00:00", "2019-06-18 19:00:00+00:00", "2019-06-18 08:00:00+00:00", "2019-06-18 07:00:00+00:00", "2019-06-18 06:00:00+00:00", "2019-06-18 05:00:00+00:00", "2019-06-18 04:00:00+00:00", "2019-06-18 03:00:00+00:00", "2019-06-18 02:00:00+00:00", "2019-06-18 01:00:00+00:00", "2019-06-18 00:00:00+00:00", "2019-06-17 23:00:00+00:00", "2019-06-17 22:00:00+00:00", "2019-06-17 21:00:00+00:00", "2019-06-17 20:00:00+00:00", "2019-06-17 19:00:00+00:00", "2019-06-17 18:00:00+00:00", "2019-06-17 17:00:00+00:00", "2019-06-17 16:00:00+00:00", "2019-06-17 15:00:00+00:00", "2019-06-17 14:00:00+00:00", "2019-06-17 13:00:00+00:00", "2019-06-17 12:00:00+00:00", "2019-06-17 11:00:00+00:00", "2019-06-17 10:00:00+00:00", "2019-06-17 09:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-17 00:00:00+00:00", "2019-06-16 23:00:00+00:00", "2019-06-16 22:00:00+00:00", "2019-06-16 21:00:00+00:00", "2019-06-16 20:00:00+00:00", "2019-06-16 19:00:00+00:00", "2019-06-16 18:00:00+00:00", "2019-06-16 17:00:00+00:00", "2019-06-16 16:00:00+00:00", "2019-06-16 15:00:00+00:00", "2019-06-16 14:00:00+00:00", "2019-06-16 13:00:00+00:00", "2019-06-16 12:00:00+00:00", "2019-06-16 11:00:00+00:00", "2019-06-16 10:00:00+00:00", "2019-06-16 09:00:00+00:00", "2019-06-16 08:00:00+00:00", "2019-06-16 07:00:00+00:00", "2019-06-16 06:00:00+00:00", "2019-06-16 05:00:00+00:00", "2019-06-16 04:00:00+00:00", "2019-06-16 03:00:00+00:00", "2019-06-16 02:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-16 00:00:00+00:00", "2019-06-15 23:00:00+00:00", "2019-06-15 22:00:00+00:00", "2019-06-15 21:00:00+00:00", "2019-06-15 20:00:00+00:00", "2019-06-15 19:00:00+00:00", "2019-06-15 18:00:00+00:00", "2019-06-15 17:00:00+00:00", "2019-06-15 16:00:00+00:00", "2019-06-15 15:00:00+00:00", "2019-06-15 14:00:00+00:00", "2019-06-15 13:00:00+00:00", "2019-06-15 12:00:00+00:00", "2019-06-15 11:00:00+00:00", "2019-06-15 10:00:00+00:00", "2019-06-15 09:00:00+00:00", "2019-06-15 08:00:00+00:00", "2019-06-15 07:00:00+00:00", "2019-06-15 06:00:00+00:00", "2019-06-15 02:00:00+00:00", "2019-06-15 01:00:00+00:00", "2019-06-15 00:00:00+00:00", "2019-06-14 23:00:00+00:00", "2019-06-14 22:00:00+00:00", "2019-06-14 21:00:00+00:00", "2019-06-14 20:00:00+00:00", "2019-06-14 19:00:00+00:00", "2019-06-14 18:00:00+00:00", "2019-06-14 17:00:00+00:00", "2019-06-14 16:00:00+00:00", "2019-06-14 15:00:00+00:00", "2019-06-14 14:00:00+00:00", "2019-06-14 13:00:00+00:00", "2019-06-14 12:00:00+00:00", "2019-06-14 11:00:00+00:00", "2019-06-14 10:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-14 08:00:00+00:00", "2019-06-14 07:00:00+00:00", "2019-06-14 06:00:00+00:00", "2019-06-14 05:00:00+00:00", "2019-06-14 04:00:00+00:00", "2019-06-14 03:00:00+00:00", "2019-06-14 02:00:00+00:00", "2019-06-14 01:00:00+00:00", "2019-06-14 00:00:00+00:00", "2019-06-13 23:00:00+00:00", "2019-06-13 22:00:00+00:00", "2019-06-13 21:00:00+00:00", "2019-06-13 20:00:00+00:00", "2019-06-13 19:00:00+00:00", "2019-06-13 18:00:00+00:00", "2019-06-13 17:00:00+00:00", "2019-06-13 16:00:00+00:00", "2019-06-13 15:00:00+00:00", "2019-06-13 14:00:00+00:00", "2019-06-13 13:00:00+00:00", "2019-06-13 12:00:00+00:00", "2019-06-13 11:00:00+00:00", "2019-06-13 10:00:00+00:00", "2019-06-13 09:00:00+00:00", "2019-06-13 08:00:00+00:00", "2019-06-13 07:00:00+00:00", "2019-06-13 06:00:00+00:00", "2019-06-13 05:00:00+00:00", "2019-06-13 04:00:00+00:00", "2019-06-13 03:00:00+00:00", "2019-06-13 02:00:00+00:00", "2019-06-13 01:00:00+00:00", "2019-06-13 00:00:00+00:00", "2019-06-12 23:00:00+00:00", "2019-06-12 22:00:00+00:00", "2019-06-12 21:00:00+00:00", "2019-06-12 20:00:00+00:00", "2019-06-12 19:00:00+00:00", "2019-06-12 18:00:00+00:00", "2019-06-12 17:00:00+00:00", "2019-06-12 16:00:00+00:00", "2019-06-12 15:00:00+00:00", "2019-06-12 14:00:00+00:00", "2019-06-12 13:00:00+00:00", "2019-06-12 12:00:00+00:00", "2019-06-12 11:00:00+00:00", "2019-06-12 10:00:00+00:00", "2019-06-12 09:00:00+00:00", "2019-06-12 08:00:00+00:00", "2019-06-12 07:00:00+00:00", "2019-06-12 06:00:00+00:00", "2019-06-12 05:00:00+00:00", "2019-06-12 04:00:00+00:00", "2019-06-12 03:00:00+00:00", "2019-06-12 02:00:00+00:00", "2019-06-12 01:00:00+00:00", "2019-06-12 00:00:00+00:00", "2019-06-11 23:00:00+00:00", "2019-06-11 22:00:00+00:00", "2019-06-11 21:00:00+00:00", "2019-06-11 20:00:00+00:00", "2019-06-11 19:00:00+00:00", "2019-06-11 18:00:00+00:00", "2019-06-11 17:00:00+00:00", "2019-06-11 16:00:00+00:00", "2019-06-11 15:00:00+00:00", "2019-06-11 14:00:00+00:00", "2019-06-11 13:00:00+00:00", "2019-06-11 12:00:00+00:00", "2019-06-11 11:00:00+00:00", "2019-06-11 10:00:00+00:00", "2019-06-11 09:00:00+00:00", "2019-06-11 08:00:00+00:00", "2019-06-11 07:00:00+00:00", "2019-06-11 06:00:00+00:00", "2019-06-11 05:00:00+00:00", "2019-06-11 04:00:00+00:00", "2019-06-11 03:00:00+00:00", "2019-06-11 02:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-11 00:00:00+00:00", "2019-06-10 23:00:00+00:00", "2019-06-10 22:00:00+00:00", "2019-06-10 21:00:00+00:00", "2019-06-10 20:00:00+00:00", "2019-06-10 19:00:00+00:00", "2019-06-10 18:00:00+00:00", "2019-06-10 17:00:00+00:00", "2019-06-10 16:00:00+00:00", "2019-06-10 15:00:00+00:00", "2019-06-10 14:00:00+00:00", "2019-06-10 13:00:00+00:00", "2019-06-10 12:00:00+00:00", "2019-06-10 11:00:00+00:00", "2019-06-10 10:00:00+00:00", "2019-06-10 09:00:00+00:00", "2019-06-10 08:00:00+00:00", "2019-06-10 07:00:00+00:00", "2019-06-10 06:00:00+00:00", "2019-06-10 05:00:00+00:00", "2019-06-10 04:00:00+00:00", "2019-06-10 03:00:00+00:00", "2019-06-10 02:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-10 00:00:00+00:00", "2019-06-09 23:00:00+00:00", "2019-06-09 22:00:00+00:00", "2019-06-09 21:00:00+00:00", "2019-06-09 20:00:00+00:00", "2019-06-09 19:00:00+00:00", "2019-06-09 18:00:00+00:00", "2019-06-09 17:00:00+00:00", "2019-06-09 16:00:00+00:00", "2019-06-09 15:00:00+00:00", "2019-06-09 14:00:00+00:00", "2019-06-09 13:00:00+00:00", "2019-06-09 12:00:00+00:00", "2019-06-09 11:00:00+00:00", "2019-06-09 10:00:00+00:00", "2019-06-09 09:00:00+00:00", "2019-06-09 08:00:00+00:00", "2019-06-09 07:00:00+00:00", "2019-06-09 06:00:00+00:00", "2019-06-09 05:00:00+00:00", "2019-06-09 04:00:00+00:00", "2019-06-09 03:00:00+00:00", "2019-06-09 02:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-09 00:00:00+00:00", "2019-06-08 23:00:00+00:00", "2019-06-08 22:00:00+00:00", "2019-06-08 21:00:00+00:00", "2019-06-08 18:00:00+00:00", "2019-06-08 17:00:00+00:00", "2019-06-08 16:00:00+00:00", "2019-06-08 15:00:00+00:00", "2019-06-08 14:00:00+00:00", "2019-06-08 13:00:00+00:00", "2019-06-08 12:00:00+00:00", "2019-06-08 11:00:00+00:00", "2019-06-08 10:00:00+00:00", "2019-06-08 09:00:00+00:00", "2019-06-08 08:00:00+00:00", "2019-06-08 07:00:00+00:00", "2019-06-08 06:00:00+00:00", "2019-06-08 05:00:00+00:00", "2019-06-08 04:00:00+00:00", "2019-06-08 03:00:00+00:00", "2019-06-08 02:00:00+00:00", "2019-06-08 01:00:00+00:00", "2019-06-08 00:00:00+00:00", "2019-06-07 23:00:00+00:00", "2019-06-07 22:00:00+00:00", "2019-06-07 21:00:00+00:00", "2019-06-07 20:00:00+00:00", "2019-06-07 19:00:00+00:00", "2019-06-07 18:00:00+00:00", "2019-06-07 17:00:00+00:00", "2019-06-07 16:00:00+00:00", "2019-06-07 15:00:00+00:00", "2019-06-07 14:00:00+00:00", "2019-06-07 13:00:00+00:00", "2019-06-07 12:00:00+00:00", "2019-06-07 11:00:00+00:00", "2019-06-07 10:00:00+00:00", "2019-06-07 09:00:00+00:00", "2019-06-07 08:00:00+00:00", "2019-06-07 07:00:00+00:00", "2019-06-07 06:00:00+00:00", "2019-06-06 14:00:00+00:00", "2019-06-06 13:00:00+00:00", "2019-06-06 12:00:00+00:00", "2019-06-06 11:00:00+00:00", "2019-06-06 10:00:00+00:00", "2019-06-06 09:00:00+00:00", "2019-06-06 08:00:00+00:00", "2019-06-06 07:00:00+00:00", "2019-06-06 06:00:00+00:00", "2019-06-06 05:00:00+00:00", "2019-06-06 04:00:00+00:00", "2019-06-06 03:00:00+00:00", "2019-06-06 02:00:00+00:00", "2019-06-06 01:00:00+00:00", "2019-06-06 00:00:00+00:00", "2019-06-05 23:00:00+00:00", "2019-06-05 22:00:00+00:00", "2019-06-05 21:00:00+00:00", "2019-06-05 20:00:00+00:00", "2019-06-05 19:00:00+00:00", "2019-06-05 18:00:00+00:00", "2019-06-05 17:00:00+00:00", "2019-06-05 16:00:00+00:00", "2019-06-05 15:00:00+00:00", "2019-06-05 14:00:00+00:00", "2019-06-05 13:00:00+00:00", "2019-06-05 12:00:00+00:00", "2019-06-05 11:00:00+00:00", "2019-06-05 10:00:00+00:00", "2019-06-05 09:00:00+00:00", "2019-06-05 08:00:00+00:00", "2019-06-05 07:00:00+00:00", "2019-06-05 06:00:00+00:00", "2019-06-05 05:00:00+00:00", "2019-06-05 04:00:00+00:00", "2019-06-05 03:00:00+00:00", "2019-06-05 02:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-05 00:00:00+00:00", "2019-06-04 23:00:00+00:00", "2019-06-04 22:00:00+00:00", "2019-06-04 21:00:00+00:00", "2019-06-04 20:00:00+00:00", "2019-06-04 19:00:00+00:00", "2019-06-04 18:00:00+00:00", "2019-06-04 17:00:00+00:00", "2019-06-04 16:00:00+00:00", "2019-06-04 15:00:00+00:00", "2019-06-04 14:00:00+00:00", "2019-06-04 13:00:00+00:00", "2019-06-04 12:00:00+00:00", "2019-06-04 11:00:00+00:00", "2019-06-04 10:00:00+00:00", "2019-06-04 09:00:00+00:00", "2019-06-04 08:00:00+00:00", "2019-06-04 07:00:00+00:00", "2019-06-04 06:00:00+00:00", "2019-06-04 05:00:00+00:00", "2019-06-04 04:00:00+00:00", "2019-06-04 03:00:00+00:00", "2019-06-04 02:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-04 00:00:00+00:00", "2019-06-03 23:00:00+00:00", "2019-06-03 22:00:00+00:00", "2019-06-03 21:00:00+00:00", "2019-06-03 20:00:00+00:00", "2019-06-03 19:00:00+00:00", "2019-06-03 18:00:00+00:00", "2019-06-03 17:00:00+00:00", "2019-06-03 16:00:00+00:00", "2019-06-03 15:00:00+00:00", "2019-06-03 14:00:00+00:00", "2019-06-03 13:00:00+00:00", "2019-06-03 12:00:00+00:00", "2019-06-03 11:00:00+00:00", "2019-06-03 10:00:00+00:00", "2019-06-03 09:00:00+00:00", "2019-06-03 08:00:00+00:00", "2019-06-03 07:00:00+00:00", "2019-06-03 06:00:00+00:00", "2019-06-03 05:00:00+00:00", "2019-06-03 04:00:00+00:00", "2019-06-03 03:00:00+00:00", "2019-06-03 02:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-03 00:00:00+00:00", "2019-06-02 23:00:00+00:00", "2019-06-02 22:00:00+00:00", "2019-06-02 21:00:00+00:00", "2019-06-02 20:00:00+00:00", "2019-06-02 19:00:00+00:00", "2019-06-02 18:00:00+00:00", "2019-06-02 17:00:00+00:00", "2019-06-02 16:00:00+00:00", "2019-06-02 15:00:00+00:00", "2019-06-02 14:00:00+00:00", "2019-06-02 13:00:00+00:00", "2019-06-02 12:00:00+00:00", "2019-06-02 11:00:00+00:00", "2019-06-02 10:00:00+00:00", "2019-06-02 09:00:00+00:00", "2019-06-02 08:00:00+00:00", "2019-06-02 07:00:00+00:00", "2019-06-02 06:00:00+00:00", "2019-06-02 05:00:00+00:00", "2019-06-02 04:00:00+00:00", "2019-06-02 03:00:00+00:00", "2019-06-02 02:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-02 00:00:00+00:00", "2019-06-01 23:00:00+00:00", "2019-06-01 22:00:00+00:00", "2019-06-01 21:00:00+00:00", "2019-06-01 20:00:00+00:00", "2019-06-01 19:00:00+00:00", "2019-06-01 18:00:00+00:00", "2019-06-01 17:00:00+00:00", "2019-06-01 16:00:00+00:00", "2019-06-01 15:00:00+00:00", "2019-06-01 14:00:00+00:00", "2019-06-01 13:00:00+00:00", "2019-06-01 12:00:00+00:00", "2019-06-01 11:00:00+00:00", "2019-06-01 10:00:00+00:00", "2019-06-01 09:00:00+00:00", "2019-06-01 08:00:00+00:00", "2019-06-01 07:00:00+00:00", "2019-06-01 06:00:00+00:00", "2019-06-01 02:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-06-01 00:00:00+00:00", "2019-05-31 23:00:00+00:00", "2019-05-31 22:00:00+00:00", "2019-05-31 21:00:00+00:00", "2019-05-31 20:00:00+00:00", "2019-05-31 19:00:00+00:00", "2019-05-31 18:00:00+00:00", "2019-05-31 17:00:00+00:00", "2019-05-31 16:00:00+00:00", "2019-05-31 15:00:00+00:00", "2019-05-31 14:00:00+00:00", "2019-05-31 13:00:00+00:00", "2019-05-31 12:00:00+00:00", "2019-05-31 11:00:00+00:00", "2019-05-31 10:00:00+00:00", "2019-05-31 09:00:00+00:00", "2019-05-31 08:00:00+00:00", "2019-05-31 07:00:00+00:00", "2019-05-31 06:00:00+00:00", "2019-05-31 05:00:00+00:00", "2019-05-31 04:00:00+00:00", "2019-05-31 03:00:00+00:00", "2019-05-31 02:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-31 00:00:00+00:00", "2019-05-30 23:00:00+00:00", "2019-05-30 22:00:00+00:00", "2019-05-30 21:00:00+00:00", "2019-05-30 20:00:00+00:00", "2019-05-30 19:00:00+00:00", "2019-05-30 18:00:00+00:00", "2019-05-30 17:00:00+00:00", "2019-05-30 16:00:00+00:00", "2019-05-30 15:00:00+00:00", "2019-05-30 14:00:00+00:00", "2019-05-30 13:00:00+00:00", "2019-05-30 12:00:00+00:00", "2019-05-30 11:00:00+00:00", "2019-05-30 10:00:00+00:00", "2019-05-30 09:00:00+00:00", "2019-05-30 08:00:00+00:00", "2019-05-30 07:00:00+00:00", "2019-05-30 06:00:00+00:00", "2019-05-30 05:00:00+00:00", "2019-05-30 04:00:00+00:00", "2019-05-30 03:00:00+00:00", "2019-05-30 02:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-30 00:00:00+00:00", "2019-05-29 23:00:00+00:00", "2019-05-29 22:00:00+00:00", "2019-05-29 21:00:00+00:00", "2019-05-29 20:00:00+00:00", "2019-05-29 19:00:00+00:00", "2019-05-29 18:00:00+00:00", "2019-05-29 17:00:00+00:00", "2019-05-29 16:00:00+00:00", "2019-05-29 15:00:00+00:00", "2019-05-29 14:00:00+00:00", "2019-05-29 13:00:00+00:00", "2019-05-29 12:00:00+00:00", "2019-05-29 11:00:00+00:00", "2019-05-29 10:00:00+00:00", "2019-05-29 09:00:00+00:00", "2019-05-29 08:00:00+00:00", "2019-05-29 07:00:00+00:00", "2019-05-29 06:00:00+00:00", "2019-05-29 05:00:00+00:00", "2019-05-29 04:00:00+00:00", "2019-05-29 03:00:00+00:00", "2019-05-29 02:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-29 00:00:00+00:00", "2019-05-28 23:00:00+00:00", "2019-05-28 22:00:00+00:00", "2019-05-28 21:00:00+00:00", "2019-05-28 20:00:00+00:00", "2019-05-28 19:00:00+00:00", "2019-05-28 18:00:00+00:00", "2019-05-28 17:00:00+00:00", "2019-05-28 16:00:00+00:00", "2019-05-28 15:00:00+00:00", "2019-05-28 14:00:00+00:00", "2019-05-28 13:00:00+00:00", "2019-05-28 12:00:00+00:00", "2019-05-28 11:00:00+00:00", "2019-05-28 10:00:00+00:00", "2019-05-28 09:00:00+00:00", "2019-05-28 08:00:00+00:00", "2019-05-28 07:00:00+00:00", "2019-05-28 06:00:00+00:00", "2019-05-28 05:00:00+00:00", "2019-05-28 04:00:00+00:00", "2019-05-28 03:00:00+00:00", "2019-05-28 02:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-28 00:00:00+00:00", "2019-05-27 23:00:00+00:00", "2019-05-27 22:00:00+00:00", "2019-05-27 21:00:00+00:00", "2019-05-27 20:00:00+00:00", "2019-05-27 19:00:00+00:00", "2019-05-27 18:00:00+00:00", "2019-05-27 17:00:00+00:00", "2019-05-27 16:00:00+00:00", "2019-05-27 15:00:00+00:00", "2019-05-27 14:00:00+00:00", "2019-05-27 13:00:00+00:00", "2019-05-27 12:00:00+00:00", "2019-05-27 11:00:00+00:00", "2019-05-27 10:00:00+00:00", "2019-05-27 09:00:00+00:00", "2019-05-27 08:00:00+00:00", "2019-05-27 07:00:00+00:00", "2019-05-27 06:00:00+00:00", "2019-05-27 05:00:00+00:00", "2019-05-27 04:00:00+00:00", "2019-05-27 03:00:00+00:00", "2019-05-27 02:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-27 00:00:00+00:00", "2019-05-26 23:00:00+00:00", "2019-05-26 22:00:00+00:00", "2019-05-26 21:00:00+00:00", "2019-05-26 20:00:00+00:00", "2019-05-26 19:00:00+00:00", "2019-05-26 18:00:00+00:00", "2019-05-26 17:00:00+00:00", "2019-05-26 16:00:00+00:00", "2019-05-26 15:00:00+00:00", "2019-05-26 14:00:00+00:00", "2019-05-26 13:00:00+00:00", "2019-05-26 12:00:00+00:00", "2019-05-26 11:00:00+00:00", "2019-05-26 10:00:00+00:00", "2019-05-26 09:00:00+00:00", "2019-05-26 08:00:00+00:00", "2019-05-26 07:00:00+00:00", "2019-05-26 06:00:00+00:00", "2019-05-26 05:00:00+00:00", "2019-05-26 04:00:00+00:00", "2019-05-26 03:00:00+00:00", "2019-05-26 02:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-26 00:00:00+00:00", "2019-05-25 23:00:00+00:00", "2019-05-25 22:00:00+00:00", "2019-05-25 21:00:00+00:00", "2019-05-25 20:00:00+00:00", "2019-05-25 19:00:00+00:00", "2019-05-25 18:00:00+00:00", "2019-05-25 17:00:00+00:00", "2019-05-25 16:00:00+00:00", "2019-05-25 15:00:00+00:00", "2019-05-25 14:00:00+00:00", "2019-05-25 13:00:00+00:00", "2019-05-25 12:00:00+00:00", "2019-05-25 11:00:00+00:00", "2019-05-25 10:00:00+00:00", "2019-05-25 09:00:00+00:00", "2019-05-25 08:00:00+00:00", "2019-05-25 07:00:00+00:00", "2019-05-25 06:00:00+00:00", "2019-05-25 02:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-25 00:00:00+00:00", "2019-05-24 23:00:00+00:00", "2019-05-24 22:00:00+00:00", "2019-05-24 21:00:00+00:00", "2019-05-24 20:00:00+00:00", "2019-05-24 19:00:00+00:00", "2019-05-24 18:00:00+00:00", "2019-05-24 17:00:00+00:00", "2019-05-24 16:00:00+00:00", "2019-05-24 15:00:00+00:00", "2019-05-24 14:00:00+00:00", "2019-05-24 13:00:00+00:00", "2019-05-24 12:00:00+00:00", "2019-05-24 11:00:00+00:00", "2019-05-24 10:00:00+00:00", "2019-05-24 09:00:00+00:00", "2019-05-24 08:00:00+00:00", "2019-05-24 07:00:00+00:00", "2019-05-24 06:00:00+00:00", "2019-05-24 05:00:00+00:00", "2019-05-24 04:00:00+00:00", "2019-05-24 03:00:00+00:00", "2019-05-24 02:00:00+00:00", "2019-05-24 01:00:00+00:00", "2019-05-24 00:00:00+00:00", "2019-05-23 23:00:00+00:00", "2019-05-23 22:00:00+00:00", "2019-05-23 21:00:00+00:00", "2019-05-23 20:00:00+00:00", "2019-05-23 19:00:00+00:00", "2019-05-23 18:00:00+00:00", "2019-05-23 17:00:00+00:00", "2019-05-23 16:00:00+00:00", "2019-05-23 15:00:00+00:00", "2019-05-23 14:00:00+00:00", "2019-05-23 13:00:00+00:00", "2019-05-23 12:00:00+00:00", "2019-05-23 11:00:00+00:00", "2019-05-23 10:00:00+00:00", "2019-05-23 09:00:00+00:00", "2019-05-23 08:00:00+00:00", "2019-05-23 07:00:00+00:00", "2019-05-23 06:00:00+00:00", "2019-05-23 05:00:00+00:00", "2019-05-23 04:00:00+00:00", "2019-05-23 03:00:00+00:00", "2019-05-23 02:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-23 00:00:00+00:00", "2019-05-22 23:00:00+00:00", "2019-05-22 22:00:00+00:00", "2019-05-22 21:00:00+00:00", "2019-05-22 20:00:00+00:00", "2019-05-22 19:00:00+00:00", "2019-05-22 18:00:00+00:00", "2019-05-22 17:00:00+00:00", "2019-05-22 16:00:00+00:00", "2019-05-22 15:00:00+00:00", "2019-05-22 14:00:00+00:00", "2019-05-22 13:00:00+00:00", "2019-05-22 12:00:00+00:00", "2019-05-22 11:00:00+00:00", "2019-05-22 10:00:00+00:00", "2019-05-22 09:00:00+00:00", "2019-05-22 08:00:00+00:00", "2019-05-22 07:00:00+00:00", "2019-05-22 06:00:00+00:00", "2019-05-22 05:00:00+00:00", "2019-05-22 04:00:00+00:00", "2019-05-22 03:00:00+00:00", "2019-05-22 02:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-22 00:00:00+00:00", "2019-05-21 23:00:00+00:00", "2019-05-21 22:00:00+00:00", "2019-05-21 21:00:00+00:00", "2019-05-21 20:00:00+00:00", "2019-05-21 19:00:00+00:00", "2019-05-21 18:00:00+00:00", "2019-05-21 17:00:00+00:00", "2019-05-21 16:00:00+00:00", "2019-05-21 15:00:00+00:00", "2019-05-21 14:00:00+00:00", "2019-05-21 13:00:00+00:00", "2019-05-21 12:00:00+00:00", "2019-05-21 11:00:00+00:00", "2019-05-21 10:00:00+00:00", "2019-05-21 09:00:00+00:00", "2019-05-21 08:00:00+00:00", "2019-05-21 07:00:00+00:00", "2019-05-21 06:00:00+00:00", "2019-05-21 05:00:00+00:00", "2019-05-21 04:00:00+00:00", "2019-05-21 03:00:00+00:00", "2019-05-21 02:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-21 00:00:00+00:00", "2019-05-20 23:00:00+00:00", "2019-05-20 22:00:00+00:00", "2019-05-20 21:00:00+00:00", "2019-05-20 20:00:00+00:00", "2019-05-20 19:00:00+00:00", "2019-05-20 18:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 17:00:00+00:00", "2019-05-18 16:00:00+00:00", "2019-05-18 15:00:00+00:00", "2019-05-18 14:00:00+00:00", "2019-05-18 13:00:00+00:00", "2019-05-18 12:00:00+00:00", "2019-05-18 11:00:00+00:00", "2019-05-18 10:00:00+00:00", "2019-05-18 09:00:00+00:00", "2019-05-18 08:00:00+00:00", "2019-05-18 07:00:00+00:00", "2019-05-18 06:00:00+00:00", "2019-05-18 05:00:00+00:00", "2019-05-18 04:00:00+00:00", "2019-05-18 03:00:00+00:00", "2019-05-18 02:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-18 00:00:00+00:00", "2019-05-17 23:00:00+00:00", "2019-05-17 22:00:00+00:00", "2019-05-17 21:00:00+00:00", "2019-05-17 20:00:00+00:00", "2019-05-17 19:00:00+00:00", "2019-05-17 18:00:00+00:00", "2019-05-17 17:00:00+00:00", "2019-05-17 16:00:00+00:00", "2019-05-17 15:00:00+00:00", "2019-05-17 14:00:00+00:00", "2019-05-17 13:00:00+00:00", "2019-05-17 12:00:00+00:00", "2019-05-17 11:00:00+00:00", "2019-05-17 10:00:00+00:00", "2019-05-17 09:00:00+00:00", "2019-05-17 08:00:00+00:00", "2019-05-17 07:00:00+00:00", "2019-05-17 06:00:00+00:00", "2019-05-17 05:00:00+00:00", "2019-05-17 04:00:00+00:00", "2019-05-17 03:00:00+00:00", "2019-05-17 02:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-17 00:00:00+00:00", "2019-05-16 23:00:00+00:00", "2019-05-16 22:00:00+00:00", "2019-05-16 21:00:00+00:00", "2019-05-16 20:00:00+00:00", "2019-05-16 19:00:00+00:00", "2019-05-16 18:00:00+00:00", "2019-05-16 17:00:00+00:00", "2019-05-16 16:00:00+00:00", "2019-05-16 15:00:00+00:00", "2019-05-16 14:00:00+00:00", "2019-05-16 13:00:00+00:00", "2019-05-16 12:00:00+00:00", "2019-05-16 11:00:00+00:00", "2019-05-16 10:00:00+00:00", "2019-05-16 09:00:00+00:00", "2019-05-16 08:00:00+00:00", "2019-05-16 07:00:00+00:00", "2019-05-16 05:00:00+00:00", "2019-05-16 04:00:00+00:00", "2019-05-16 03:00:00+00:00", "2019-05-16 02:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-16 00:00:00+00:00", "2019-05-15 23:00:00+00:00", "2019-05-15 22:00:00+00:00", "2019-05-15 21:00:00+00:00", "2019-05-15 20:00:00+00:00", "2019-05-15 19:00:00+00:00", "2019-05-15 18:00:00+00:00", "2019-05-15 17:00:00+00:00", "2019-05-15 16:00:00+00:00", "2019-05-15 15:00:00+00:00", "2019-05-15 14:00:00+00:00", "2019-05-15 13:00:00+00:00", "2019-05-15 12:00:00+00:00", "2019-05-15 11:00:00+00:00", "2019-05-15 10:00:00+00:00", "2019-05-15 09:00:00+00:00", "2019-05-15 08:00:00+00:00", "2019-05-15 07:00:00+00:00", "2019-05-15 06:00:00+00:00", "2019-05-15 05:00:00+00:00", "2019-05-15 04:00:00+00:00", "2019-05-15 03:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 01:00:00+00:00", "2019-05-15 00:00:00+00:00", "2019-05-14 23:00:00+00:00", "2019-05-14 22:00:00+00:00", "2019-05-14 21:00:00+00:00", "2019-05-14 20:00:00+00:00", "2019-05-14 19:00:00+00:00", "2019-05-14 18:00:00+00:00", "2019-05-14 17:00:00+00:00", "2019-05-14 16:00:00+00:00", "2019-05-14 15:00:00+00:00", "2019-05-14 14:00:00+00:00", "2019-05-14 13:00:00+00:00", "2019-05-14 12:00:00+00:00", "2019-05-14 11:00:00+00:00", "2019-05-14 10:00:00+00:00", "2019-05-14 09:00:00+00:00", "2019-05-14 08:00:00+00:00", "2019-05-14 07:00:00+00:00", "2019-05-14 06:00:00+00:00", "2019-05-14 05:00:00+00:00", "2019-05-14 04:00:00+00:00", "2019-05-14 03:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-14 00:00:00+00:00", "2019-05-13 23:00:00+00:00", "2019-05-13 22:00:00+00:00", "2019-05-13 21:00:00+00:00", "2019-05-13 20:00:00+00:00", "2019-05-13 19:00:00+00:00", "2019-05-13 18:00:00+00:00", "2019-05-13 17:00:00+00:00", "2019-05-13 16:00:00+00:00", "2019-05-13 15:00:00+00:00", "2019-05-13 14:00:00+00:00", "2019-05-13 13:00:00+00:00", "2019-05-13 12:00:00+00:00", "2019-05-13 11:00:00+00:00", "2019-05-13 10:00:00+00:00", "2019-05-13 09:00:00+00:00", "2019-05-13 08:00:00+00:00", "2019-05-13 07:00:00+00:00", "2019-05-13 06:00:00+00:00", "2019-05-13 05:00:00+00:00", "2019-05-13 04:00:00+00:00", "2019-05-13 03:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-13 00:00:00+00:00", "2019-05-12 23:00:00+00:00", "2019-05-12 22:00:00+00:00", "2019-05-12 21:00:00+00:00", "2019-05-12 20:00:00+00:00", "2019-05-12 19:00:00+00:00", "2019-05-12 18:00:00+00:00", "2019-05-12 17:00:00+00:00", "2019-05-12 16:00:00+00:00", "2019-05-12 15:00:00+00:00", "2019-05-12 14:00:00+00:00", "2019-05-12 13:00:00+00:00", "2019-05-12 12:00:00+00:00", "2019-05-12 11:00:00+00:00", "2019-05-12 10:00:00+00:00", "2019-05-12 09:00:00+00:00", "2019-05-12 08:00:00+00:00", "2019-05-12 07:00:00+00:00", "2019-05-12 06:00:00+00:00", "2019-05-12 05:00:00+00:00", "2019-05-12 04:00:00+00:00", "2019-05-12 03:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-12 00:00:00+00:00", "2019-05-11 23:00:00+00:00", "2019-05-11 22:00:00+00:00", "2019-05-11 21:00:00+00:00", "2019-05-11 20:00:00+00:00", "2019-05-11 19:00:00+00:00", "2019-05-11 18:00:00+00:00", "2019-05-11 17:00:00+00:00", "2019-05-11 16:00:00+00:00", "2019-05-11 15:00:00+00:00", "2019-05-11 14:00:00+00:00", "2019-05-11 13:00:00+00:00", "2019-05-11 12:00:00+00:00", "2019-05-11 11:00:00+00:00", "2019-05-11 10:00:00+00:00", "2019-05-11 09:00:00+00:00", "2019-05-11 08:00:00+00:00", "2019-05-11 07:00:00+00:00", "2019-05-11 06:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-11 00:00:00+00:00", "2019-05-10 23:00:00+00:00", "2019-05-10 22:00:00+00:00", "2019-05-10 21:00:00+00:00", "2019-05-10 20:00:00+00:00", "2019-05-10 19:00:00+00:00", "2019-05-10 18:00:00+00:00", "2019-05-10 17:00:00+00:00", "2019-05-10 16:00:00+00:00", "2019-05-10 15:00:00+00:00", "2019-05-10 14:00:00+00:00", "2019-05-10 13:00:00+00:00", "2019-05-10 12:00:00+00:00", "2019-05-10 11:00:00+00:00", "2019-05-10 10:00:00+00:00", "2019-05-10 09:00:00+00:00", "2019-05-10 08:00:00+00:00", "2019-05-10 07:00:00+00:00", "2019-05-10 06:00:00+00:00", "2019-05-10 05:00:00+00:00", "2019-05-10 04:00:00+00:00", "2019-05-10 03:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-10 00:00:00+00:00", "2019-05-09 23:00:00+00:00", "2019-05-09 22:00:00+00:00", "2019-05-09 21:00:00+00:00", "2019-05-09 20:00:00+00:00", "2019-05-09 19:00:00+00:00", "2019-05-09 18:00:00+00:00", "2019-05-09 17:00:00+00:00", "2019-05-09 16:00:00+00:00", "2019-05-09 15:00:00+00:00", "2019-05-09 14:00:00+00:00", "2019-05-09 13:00:00+00:00", "2019-05-09 12:00:00+00:00", "2019-05-09 11:00:00+00:00", "2019-05-09 10:00:00+00:00", "2019-05-09 09:00:00+00:00", "2019-05-09 08:00:00+00:00", "2019-05-09 07:00:00+00:00", "2019-05-09 06:00:00+00:00", "2019-05-09 05:00:00+00:00", "2019-05-09 04:00:00+00:00", "2019-05-09 03:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 01:00:00+00:00", "2019-05-09 00:00:00+00:00", "2019-05-08 23:00:00+00:00", "2019-05-08 22:00:00+00:00", "2019-05-08 21:00:00+00:00", "2019-05-08 20:00:00+00:00", "2019-05-08 19:00:00+00:00", "2019-05-08 18:00:00+00:00", "2019-05-08 17:00:00+00:00", "2019-05-08 16:00:00+00:00", "2019-05-08 15:00:00+00:00", "2019-05-08 14:00:00+00:00", "2019-05-08 13:00:00+00:00", "2019-05-08 12:00:00+00:00", "2019-05-08 11:00:00+00:00", "2019-05-08 10:00:00+00:00", "2019-05-08 09:00:00+00:00", "2019-05-08 08:00:00+00:00", "2019-05-08 07:00:00+00:00", "2019-05-08 06:00:00+00:00", "2019-05-08 05:00:00+00:00", "2019-05-08 04:00:00+00:00", "2019-05-08 03:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-08 00:00:00+00:00", "2019-05-07 23:00:00+00:00", "2019-05-07 22:00:00+00:00", "2019-05-07 21:00:00+00:00", "2019-05-07 20:00:00+00:00", "2019-05-07 19:00:00+00:00", "2019-05-07 18:00:00+00:00", "2019-05-07 17:00:00+00:00", "2019-05-07 16:00:00+00:00", "2019-05-07 15:00:00+00:00", "2019-05-07 14:00:00+00:00", "2019-05-07 13:00:00+00:00", "2019-05-07 12:00:00+00:00", "2019-05-07 11:00:00+00:00", "2019-05-07 10:00:00+00:00", "2019-05-07 09:00:00+00:00", "2019-05-07 08:00:00+00:00", "2019-05-07 07:00:00+00:00", "2019-05-07 06:00:00+00:00", "2019-05-07 05:00:00+00:00", "2019-05-07 04:00:00+00:00", "2019-05-07 03:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-07 00:00:00+00:00", "2019-05-06 23:00:00+00:00", "2019-05-06 22:00:00+00:00", "2019-05-06 21:00:00+00:00", "2019-05-06 20:00:00+00:00", "2019-05-06 19:00:00+00:00", "2019-05-06 18:00:00+00:00", "2019-05-06 17:00:00+00:00", "2019-05-06 16:00:00+00:00", "2019-05-06 15:00:00+00:00", "2019-05-06 14:00:00+00:00", "2019-05-06 13:00:00+00:00", "2019-05-06 12:00:00+00:00", "2019-05-06 11:00:00+00:00", "2019-05-06 10:00:00+00:00", "2019-05-06 09:00:00+00:00", "2019-05-06 08:00:00+00:00", "2019-05-06 07:00:00+00:00", "2019-05-06 06:00:00+00:00", "2019-05-06 05:00:00+00:00", "2019-05-06 04:00:00+00:00", "2019-05-06 03:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-06 00:00:00+00:00", "2019-05-05 23:00:00+00:00", "2019-05-05 22:00:00+00:00", "2019-05-05 21:00:00+00:00", "2019-05-05 20:00:00+00:00", "2019-05-05 19:00:00+00:00", "2019-05-05 18:00:00+00:00", "2019-05-05 17:00:00+00:00", "2019-05-05 16:00:00+00:00", "2019-05-05 15:00:00+00:00", "2019-05-05 14:00:00+00:00", "2019-05-05 13:00:00+00:00", "2019-05-05 12:00:00+00:00", "2019-05-05 11:00:00+00:00", "2019-05-05 10:00:00+00:00", "2019-05-05 09:00:00+00:00", "2019-05-05 08:00:00+00:00", "2019-05-05 07:00:00+00:00", "2019-05-05 06:00:00+00:00", "2019-05-05 05:00:00+00:00", "2019-05-05 04:00:00+00:00", "2019-05-05 03:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-05 00:00:00+00:00", "2019-05-04 23:00:00+00:00", "2019-05-04 22:00:00+00:00", "2019-05-04 21:00:00+00:00", "2019-05-04 20:00:00+00:00", "2019-05-04 19:00:00+00:00", "2019-05-04 18:00:00+00:00", "2019-05-04 17:00:00+00:00", "2019-05-04 16:00:00+00:00", "2019-05-04 15:00:00+00:00", "2019-05-04 14:00:00+00:00", "2019-05-04 13:00:00+00:00", "2019-05-04 12:00:00+00:00", "2019-05-04 11:00:00+00:00", "2019-05-04 10:00:00+00:00", "2019-05-04 09:00:00+00:00", "2019-05-04 08:00:00+00:00", "2019-05-04 07:00:00+00:00", "2019-05-04 06:00:00+00:00", "2019-05-04 05:00:00+00:00", "2019-05-04 04:00:00+00:00", "2019-05-04 03:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-04 00:00:00+00:00", "2019-05-03 23:00:00+00:00", "2019-05-03 22:00:00+00:00", "2019-05-03 21:00:00+00:00", "2019-05-03 20:00:00+00:00", "2019-05-03 19:00:00+00:00", "2019-05-03 18:00:00+00:00", "2019-05-03 17:00:00+00:00", "2019-05-03 16:00:00+00:00", "2019-05-03 15:00:00+00:00", "2019-05-03 14:00:00+00:00", "2019-05-03 13:00:00+00:00", "2019-05-03 12:00:00+00:00", "2019-05-03 11:00:00+00:00", "2019-05-03 10:00:00+00:00", "2019-05-03 09:00:00+00:00", "2019-05-03 08:00:00+00:00", "2019-05-03 07:00:00+00:00", "2019-05-03 06:00:00+00:00", "2019-05-03 05:00:00+00:00", "2019-05-03 04:00:00+00:00", "2019-05-03 03:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-03 00:00:00+00:00", "2019-05-02 23:00:00+00:00", "2019-05-02 22:00:00+00:00", "2019-05-02 21:00:00+00:00", "2019-05-02 20:00:00+00:00", "2019-05-02 19:00:00+00:00", "2019-05-02 18:00:00+00:00", "2019-05-02 17:00:00+00:00", "2019-05-02 16:00:00+00:00", "2019-05-02 15:00:00+00:00", "2019-05-02 14:00:00+00:00", "2019-05-02 13:00:00+00:00", "2019-05-02 12:00:00+00:00", "2019-05-02 11:00:00+00:00", "2019-05-02 10:00:00+00:00", "2019-05-02 09:00:00+00:00", "2019-05-02 08:00:00+00:00", "2019-05-02 07:00:00+00:00", "2019-05-02 06:00:00+00:00", "2019-05-02 05:00:00+00:00", "2019-05-02 04:00:00+00:00", "2019-05-02 03:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-02 00:00:00+00:00", "2019-05-01 23:00:00+00:00", "2019-05-01 22:00:00+00:00", "2019-05-01 21:00:00+00:00", "2019-05-01 20:00:00+00:00", "2019-05-01 19:00:00+00:00", "2019-05-01 18:00:00+00:00", "2019-05-01 17:00:00+00:00", "2019-05-01 16:00:00+00:00", "2019-05-01 15:00:00+00:00", "2019-05-01 14:00:00+00:00", "2019-05-01 13:00:00+00:00", "2019-05-01 12:00:00+00:00", "2019-05-01 11:00:00+00:00", "2019-05-01 10:00:00+00:00", "2019-05-01 09:00:00+00:00", "2019-05-01 08:00:00+00:00", "2019-05-01 07:00:00+00:00", "2019-05-01 06:00:00+00:00", "2019-05-01 05:00:00+00:00", "2019-05-01 04:00:00+00:00", "2019-05-01 03:00:00+00:00", "2019-05-01 02:00:00+00:00", "2019-05-01 01:00:00+00:00", "2019-05-01 00:00:00+00:00", "2019-04-30 23:00:00+00:00", "2019-04-30 22:00:00+00:00", "2019-04-30 21:00:00+00:00", "2019-04-30 20:00:00+00:00", "2019-04-30 19:00:00+00:00", "2019-04-30 18:00:00+00:00", "2019-04-30 17:00:00+00:00", "2019-04-30 16:00:00+00:00", "2019-04-30 15:00:00+00:00", "2019-04-30 14:00:00+00:00", "2019-04-30 13:00:00+00:00", "2019-04-30 12:00:00+00:00", "2019-04-30 11:00:00+00:00", "2019-04-30 10:00:00+00:00", "2019-04-30 09:00:00+00:00", "2019-04-30 08:00:00+00:00", "2019-04-30 07:00:00+00:00", "2019-04-30 06:00:00+00:00", "2019-04-30 05:00:00+00:00", "2019-04-30 04:00:00+00:00", "2019-04-30 03:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-30 00:00:00+00:00", "2019-04-29 23:00:00+00:00", "2019-04-29 22:00:00+00:00", "2019-04-29 21:00:00+00:00", "2019-04-29 20:00:00+00:00", "2019-04-29 19:00:00+00:00", "2019-04-29 18:00:00+00:00", "2019-04-29 17:00:00+00:00", "2019-04-29 16:00:00+00:00", "2019-04-29 15:00:00+00:00", "2019-04-29 14:00:00+00:00", "2019-04-29 13:00:00+00:00", "2019-04-29 12:00:00+00:00", "2019-04-29 11:00:00+00:00", "2019-04-29 10:00:00+00:00", "2019-04-29 09:00:00+00:00", "2019-04-29 08:00:00+00:00", "2019-04-29 07:00:00+00:00", "2019-04-29 06:00:00+00:00", "2019-04-29 05:00:00+00:00", "2019-04-29 04:00:00+00:00", "2019-04-29 03:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-29 00:00:00+00:00", "2019-04-28 23:00:00+00:00", "2019-04-28 22:00:00+00:00", "2019-04-28 21:00:00+00:00", "2019-04-28 20:00:00+00:00", "2019-04-28 19:00:00+00:00", "2019-04-28 18:00:00+00:00", "2019-04-28 17:00:00+00:00", "2019-04-28 16:00:00+00:00", "2019-04-28 15:00:00+00:00", "2019-04-28 14:00:00+00:00", "2019-04-28 13:00:00+00:00", "2019-04-28 12:00:00+00:00", "2019-04-28 11:00:00+00:00", "2019-04-28 10:00:00+00:00", "2019-04-28 09:00:00+00:00", "2019-04-28 08:00:00+00:00", "2019-04-28 07:00:00+00:00", "2019-04-28 06:00:00+00:00", "2019-04-28 05:00:00+00:00", "2019-04-28 04:00:00+00:00", "2019-04-28 03:00:00+00:00", "2019-04-28 02:00:00+00:00", "2019-04-28 01:00:00+00:00", "2019-04-28 00:00:00+00:00", "2019-04-27 23:00:00+00:00", "2019-04-27 22:00:00+00:00", "2019-04-27 21:00:00+00:00", "2019-04-27 20:00:00+00:00", "2019-04-27 19:00:00+00:00", "2019-04-27 18:00:00+00:00", "2019-04-27 17:00:00+00:00", "2019-04-27 16:00:00+00:00", "2019-04-27 15:00:00+00:00", "2019-04-27 14:00:00+00:00", "2019-04-27 13:00:00+00:00", "2019-04-27 12:00:00+00:00", "2019-04-27 11:00:00+00:00", "2019-04-27 10:00:00+00:00", "2019-04-27 09:00:00+00:00", "2019-04-27 08:00:00+00:00", "2019-04-27 07:00:00+00:00", "2019-04-27 06:00:00+00:00", "2019-04-27 05:00:00+00:00", "2019-04-27 04:00:00+00:00", "2019-04-27 03:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 01:00:00+00:00", "2019-04-27 00:00:00+00:00", "2019-04-26 23:00:00+00:00", "2019-04-26 22:00:00+00:00", "2019-04-26 21:00:00+00:00", "2019-04-26 20:00:00+00:00", "2019-04-26 19:00:00+00:00", "2019-04-26 18:00:00+00:00", "2019-04-26 17:00:00+00:00", "2019-04-26 16:00:00+00:00", "2019-04-26 15:00:00+00:00", "2019-04-26 14:00:00+00:00", "2019-04-26 13:00:00+00:00", "2019-04-26 12:00:00+00:00", "2019-04-26 11:00:00+00:00", "2019-04-26 10:00:00+00:00", "2019-04-26 09:00:00+00:00", "2019-04-26 08:00:00+00:00", "2019-04-26 07:00:00+00:00", "2019-04-26 06:00:00+00:00", "2019-04-26 05:00:00+00:00", "2019-04-26 04:00:00+00:00", "2019-04-26 03:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-26 00:00:00+00:00", "2019-04-25 23:00:00+00:00", "2019-04-25 22:00:00+00:00", "2019-04-25 21:00:00+00:00", "2019-04-25 20:00:00+00:00", "2019-04-25 19:00:00+00:00", "2019-04-25 18:00:00+00:00", "2019-04-25 17:00:00+00:00", "2019-04-25 16:00:00+00:00", "2019-04-25 15:00:00+00:00", "2019-04-25 14:00:00+00:00", "2019-04-25 13:00:00+00:00", "2019-04-25 12:00:00+00:00", "2019-04-25 11:00:00+00:00", "2019-04-25 10:00:00+00:00", "2019-04-25 09:00:00+00:00", "2019-04-25 08:00:00+00:00", "2019-04-25 07:00:00+00:00", "2019-04-25 06:00:00+00:00", "2019-04-25 05:00:00+00:00", "2019-04-25 04:00:00+00:00", "2019-04-25 03:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 01:00:00+00:00", "2019-04-25 00:00:00+00:00", "2019-04-24 23:00:00+00:00", "2019-04-24 22:00:00+00:00", "2019-04-24 21:00:00+00:00", "2019-04-24 20:00:00+00:00", "2019-04-24 19:00:00+00:00", "2019-04-24 18:00:00+00:00", "2019-04-24 17:00:00+00:00", "2019-04-24 16:00:00+00:00", "2019-04-24 15:00:00+00:00", "2019-04-24 14:00:00+00:00", "2019-04-24 13:00:00+00:00", "2019-04-24 12:00:00+00:00", "2019-04-24 11:00:00+00:00", "2019-04-24 10:00:00+00:00", "2019-04-24 09:00:00+00:00", "2019-04-24 08:00:00+00:00", "2019-04-24 07:00:00+00:00", "2019-04-24 06:00:00+00:00", "2019-04-24 05:00:00+00:00", "2019-04-24 04:00:00+00:00", "2019-04-24 03:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 01:00:00+00:00", "2019-04-24 00:00:00+00:00", "2019-04-23 23:00:00+00:00", "2019-04-23 22:00:00+00:00", "2019-04-23 21:00:00+00:00", "2019-04-23 20:00:00+00:00", "2019-04-23 19:00:00+00:00", "2019-04-23 18:00:00+00:00", "2019-04-23 17:00:00+00:00", "2019-04-23 16:00:00+00:00", "2019-04-23 15:00:00+00:00", "2019-04-23 14:00:00+00:00", "2019-04-23 13:00:00+00:00", "2019-04-23 12:00:00+00:00", "2019-04-23 11:00:00+00:00", "2019-04-23 10:00:00+00:00", "2019-04-23 09:00:00+00:00", "2019-04-23 08:00:00+00:00", "2019-04-23 07:00:00+00:00", "2019-04-23 06:00:00+00:00", "2019-04-23 05:00:00+00:00", "2019-04-23 04:00:00+00:00", "2019-04-23 03:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-23 00:00:00+00:00", "2019-04-22 23:00:00+00:00", "2019-04-22 22:00:00+00:00", "2019-04-22 21:00:00+00:00", "2019-04-22 20:00:00+00:00", "2019-04-22 19:00:00+00:00", "2019-04-22 18:00:00+00:00", "2019-04-22 17:00:00+00:00", "2019-04-22 16:00:00+00:00", "2019-04-22 15:00:00+00:00", "2019-04-22 14:00:00+00:00", "2019-04-22 13:00:00+00:00", "2019-04-22 12:00:00+00:00", "2019-04-22 11:00:00+00:00", "2019-04-22 10:00:00+00:00", "2019-04-22 09:00:00+00:00", "2019-04-22 08:00:00+00:00", "2019-04-22 07:00:00+00:00", "2019-04-22 06:00:00+00:00", "2019-04-22 05:00:00+00:00", "2019-04-22 04:00:00+00:00", "2019-04-22 03:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-22 00:00:00+00:00", "2019-04-21 23:00:00+00:00", "2019-04-21 22:00:00+00:00", "2019-04-21 21:00:00+00:00", "2019-04-21 20:00:00+00:00", "2019-04-21 19:00:00+00:00", "2019-04-21 18:00:00+00:00", "2019-04-21 17:00:00+00:00", "2019-04-21 16:00:00+00:00", "2019-04-21 15:00:00+00:00", "2019-04-21 14:00:00+00:00", "2019-04-21 13:00:00+00:00", "2019-04-21 12:00:00+00:00", "2019-04-21 11:00:00+00:00", "2019-04-21 10:00:00+00:00", "2019-04-21 09:00:00+00:00", "2019-04-21 08:00:00+00:00", "2019-04-21 07:00:00+00:00", "2019-04-21 06:00:00+00:00", "2019-04-21 05:00:00+00:00", "2019-04-21 04:00:00+00:00", "2019-04-21 03:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-21 00:00:00+00:00", "2019-04-20 23:00:00+00:00", "2019-04-20 22:00:00+00:00", "2019-04-20 21:00:00+00:00", "2019-04-20 20:00:00+00:00", "2019-04-20 19:00:00+00:00", "2019-04-20 18:00:00+00:00", "2019-04-20 17:00:00+00:00", "2019-04-20 16:00:00+00:00", "2019-04-20 15:00:00+00:00", "2019-04-20 14:00:00+00:00", "2019-04-20 13:00:00+00:00", "2019-04-20 12:00:00+00:00", "2019-04-20 11:00:00+00:00", "2019-04-20 10:00:00+00:00", "2019-04-20 09:00:00+00:00", "2019-04-20 08:00:00+00:00", "2019-04-20 07:00:00+00:00", "2019-04-20 06:00:00+00:00", "2019-04-20 05:00:00+00:00", "2019-04-20 04:00:00+00:00", "2019-04-20 03:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-20 00:00:00+00:00", "2019-04-19 23:00:00+00:00", "2019-04-19 22:00:00+00:00", "2019-04-19 21:00:00+00:00", "2019-04-19 20:00:00+00:00", "2019-04-19 19:00:00+00:00", "2019-04-19 18:00:00+00:00", "2019-04-19 17:00:00+00:00", "2019-04-19 16:00:00+00:00", "2019-04-19 15:00:00+00:00", "2019-04-19 14:00:00+00:00", "2019-04-19 13:00:00+00:00", "2019-04-19 12:00:00+00:00", "2019-04-19 11:00:00+00:00", "2019-04-19 10:00:00+00:00", "2019-04-19 09:00:00+00:00", "2019-04-19 08:00:00+00:00", "2019-04-19 07:00:00+00:00", "2019-04-19 06:00:00+00:00", "2019-04-19 05:00:00+00:00", "2019-04-19 04:00:00+00:00", "2019-04-19 03:00:00+00:00", "2019-04-19 02:00:00+00:00", "2019-04-19 01:00:00+00:00", "2019-04-19 00:00:00+00:00", "2019-04-18 23:00:00+00:00", "2019-04-18 22:00:00+00:00", "2019-04-18 21:00:00+00:00", "2019-04-18 20:00:00+00:00", "2019-04-18 19:00:00+00:00", "2019-04-18 18:00:00+00:00", "2019-04-18 17:00:00+00:00", "2019-04-18 16:00:00+00:00", "2019-04-18 15:00:00+00:00", "2019-04-18 14:00:00+00:00", "2019-04-18 13:00:00+00:00", "2019-04-18 12:00:00+00:00", "2019-04-18 11:00:00+00:00", "2019-04-18 10:00:00+00:00", "2019-04-18 09:00:00+00:00", "2019-04-18 08:00:00+00:00", "2019-04-18 07:00:00+00:00", "2019-04-18 06:00:00+00:00", "2019-04-18 05:00:00+00:00", "2019-04-18 04:00:00+00:00", "2019-04-18 03:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-18 00:00:00+00:00", "2019-04-17 23:00:00+00:00", "2019-04-17 22:00:00+00:00", "2019-04-17 21:00:00+00:00", "2019-04-17 20:00:00+00:00", "2019-04-17 19:00:00+00:00", "2019-04-17 18:00:00+00:00", "2019-04-17 17:00:00+00:00", "2019-04-17 16:00:00+00:00", "2019-04-17 15:00:00+00:00", "2019-04-17 14:00:00+00:00", "2019-04-17 13:00:00+00:00", "2019-04-17 12:00:00+00:00", "2019-04-17 11:00:00+00:00", "2019-04-17 10:00:00+00:00", "2019-04-17 09:00:00+00:00", "2019-04-17 08:00:00+00:00", "2019-04-17 07:00:00+00:00", "2019-04-17 06:00:00+00:00", "2019-04-17 05:00:00+00:00", "2019-04-17 04:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 01:00:00+00:00", "2019-04-17 00:00:00+00:00", "2019-04-16 23:00:00+00:00", "2019-04-16 22:00:00+00:00", "2019-04-16 21:00:00+00:00", "2019-04-16 20:00:00+00:00", "2019-04-16 19:00:00+00:00", "2019-04-16 18:00:00+00:00", "2019-04-16 17:00:00+00:00", "2019-04-16 16:00:00+00:00", "2019-04-16 15:00:00+00:00", "2019-04-16 14:00:00+00:00", "2019-04-16 13:00:00+00:00", "2019-04-16 12:00:00+00:00", "2019-04-16 11:00:00+00:00", "2019-04-16 10:00:00+00:00", "2019-04-16 09:00:00+00:00", "2019-04-16 08:00:00+00:00", "2019-04-16 07:00:00+00:00", "2019-04-16 06:00:00+00:00", "2019-04-16 05:00:00+00:00", "2019-04-16 04:00:00+00:00", "2019-04-16 03:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 01:00:00+00:00", "2019-04-16 00:00:00+00:00", "2019-04-15 23:00:00+00:00", "2019-04-15 22:00:00+00:00", "2019-04-15 21:00:00+00:00", "2019-04-15 20:00:00+00:00", "2019-04-15 19:00:00+00:00", "2019-04-15 18:00:00+00:00", "2019-04-15 17:00:00+00:00", "2019-04-15 16:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-15 00:00:00+00:00", "2019-04-14 23:00:00+00:00", "2019-04-14 22:00:00+00:00", "2019-04-14 21:00:00+00:00", "2019-04-14 20:00:00+00:00", "2019-04-14 19:00:00+00:00", "2019-04-14 18:00:00+00:00", "2019-04-14 17:00:00+00:00", "2019-04-14 16:00:00+00:00", "2019-04-14 15:00:00+00:00", "2019-04-14 14:00:00+00:00", "2019-04-14 13:00:00+00:00", "2019-04-14 12:00:00+00:00", "2019-04-14 11:00:00+00:00", "2019-04-14 10:00:00+00:00", "2019-04-14 09:00:00+00:00", "2019-04-14 08:00:00+00:00", "2019-04-14 07:00:00+00:00", "2019-04-14 06:00:00+00:00", "2019-04-14 05:00:00+00:00", "2019-04-14 04:00:00+00:00", "2019-04-14 03:00:00+00:00", "2019-04-14 02:00:00+00:00", "2019-04-14 01:00:00+00:00", "2019-04-14 00:00:00+00:00", "2019-04-13 23:00:00+00:00", "2019-04-13 22:00:00+00:00", "2019-04-13 21:00:00+00:00", "2019-04-13 20:00:00+00:00", "2019-04-13 19:00:00+00:00", "2019-04-13 18:00:00+00:00", "2019-04-13 17:00:00+00:00", "2019-04-13 16:00:00+00:00", "2019-04-13 15:00:00+00:00", "2019-04-13 14:00:00+00:00", "2019-04-13 13:00:00+00:00", "2019-04-13 12:00:00+00:00", "2019-04-13 11:00:00+00:00", "2019-04-13 10:00:00+00:00", "2019-04-13 09:00:00+00:00", "2019-04-13 08:00:00+00:00", "2019-04-13 07:00:00+00:00", "2019-04-13 06:00:00+00:00", "2019-04-13 05:00:00+00:00", "2019-04-13 04:00:00+00:00", "2019-04-13 03:00:00+00:00", "2019-04-13 02:00:00+00:00", "2019-04-13 01:00:00+00:00", "2019-04-13 00:00:00+00:00", "2019-04-12 23:00:00+00:00", "2019-04-12 22:00:00+00:00", "2019-04-12 21:00:00+00:00", "2019-04-12 20:00:00+00:00", "2019-04-12 19:00:00+00:00", "2019-04-12 18:00:00+00:00", "2019-04-12 17:00:00+00:00", "2019-04-12 16:00:00+00:00", "2019-04-12 15:00:00+00:00", "2019-04-12 14:00:00+00:00", "2019-04-12 13:00:00+00:00", "2019-04-12 12:00:00+00:00", "2019-04-12 11:00:00+00:00", "2019-04-12 10:00:00+00:00", "2019-04-12 09:00:00+00:00", "2019-04-12 08:00:00+00:00", "2019-04-12 07:00:00+00:00", "2019-04-12 06:00:00+00:00", "2019-04-12 05:00:00+00:00", "2019-04-12 04:00:00+00:00", "2019-04-12 03:00:00+00:00", "2019-04-12 02:00:00+00:00", "2019-04-12 01:00:00+00:00", "2019-04-12 00:00:00+00:00", "2019-04-11 23:00:00+00:00", "2019-04-11 22:00:00+00:00", "2019-04-11 21:00:00+00:00", "2019-04-11 20:00:00+00:00", "2019-04-11 19:00:00+00:00", "2019-04-11 18:00:00+00:00", "2019-04-11 17:00:00+00:00", "2019-04-11 16:00:00+00:00", "2019-04-11 15:00:00+00:00", "2019-04-11 14:00:00+00:00", "2019-04-11 13:00:00+00:00", "2019-04-11 12:00:00+00:00", "2019-04-11 11:00:00+00:00", "2019-04-11 10:00:00+00:00", "2019-04-11 09:00:00+00:00", "2019-04-11 08:00:00+00:00", "2019-04-11 07:00:00+00:00", "2019-04-11 06:00:00+00:00", "2019-04-11 05:00:00+00:00", "2019-04-11 04:00:00+00:00", "2019-04-11 03:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 01:00:00+00:00", "2019-04-11 00:00:00+00:00", "2019-04-10 23:00:00+00:00", "2019-04-10 22:00:00+00:00", "2019-04-10 21:00:00+00:00", "2019-04-10 20:00:00+00:00", "2019-04-10 19:00:00+00:00", "2019-04-10 18:00:00+00:00", "2019-04-10 17:00:00+00:00", "2019-04-10 16:00:00+00:00", "2019-04-10 15:00:00+00:00", "2019-04-10 14:00:00+00:00", "2019-04-10 13:00:00+00:00", "2019-04-10 12:00:00+00:00", "2019-04-10 11:00:00+00:00", "2019-04-10 10:00:00+00:00", "2019-04-10 09:00:00+00:00", "2019-04-10 08:00:00+00:00", "2019-04-10 07:00:00+00:00", "2019-04-10 06:00:00+00:00", "2019-04-10 05:00:00+00:00", "2019-04-10 04:00:00+00:00", "2019-04-10 03:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-10 00:00:00+00:00", "2019-04-09 23:00:00+00:00", "2019-04-09 22:00:00+00:00", "2019-04-09 21:00:00+00:00", "2019-04-09 20:00:00+00:00", "2019-04-09 19:00:00+00:00", "2019-04-09 18:00:00+00:00", "2019-04-09 17:00:00+00:00", "2019-04-09 16:00:00+00:00", "2019-04-09 15:00:00+00:00", "2019-04-09 14:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00", "2019-04-09 01:00:00+00:00",
-// 					},
-// 					"5a19d02982add20b2d3c0bc86795c532f97f8ad4642548abe3e854fdfbd68612c4b9d89b608c3423a3b10c39290f6522d59858517d29b4272959ff4a96759770": {
-// 						"2019-06-17 11:00:00+00:00", "2019-06-17 10:00:00+00:00", "2019-06-17 09:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-17 00:00:00+00:00", "2019-06-16 23:00:00+00:00", "2019-06-16 21:00:00+00:00", "2019-06-16 20:00:00+00:00", "2019-06-16 19:00:00+00:00", "2019-06-16 18:00:00+00:00", "2019-06-16 17:00:00+00:00", "2019-06-16 16:00:00+00:00", "2019-06-16 15:00:00+00:00", "2019-06-16 14:00:00+00:00", "2019-06-16 13:00:00+00:00", "2019-06-16 12:00:00+00:00", "2019-06-16 11:00:00+00:00", "2019-06-16 10:00:00+00:00", "2019-06-16 09:00:00+00:00", "2019-06-16 08:00:00+00:00", "2019-06-16 07:00:00+00:00", "2019-06-16 06:00:00+00:00", "2019-06-16 05:00:00+00:00", "2019-06-16 04:00:00+00:00", "2019-06-16 03:00:00+00:00", "2019-06-16 02:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-16 00:00:00+00:00", "2019-06-15 23:00:00+00:00", "2019-06-15 22:00:00+00:00", "2019-06-15 21:00:00+00:00", "2019-06-15 20:00:00+00:00", "2019-06-15 19:00:00+00:00", "2019-06-15 18:00:00+00:00", "2019-06-15 17:00:00+00:00", "2019-06-15 16:00:00+00:00", "2019-06-15 15:00:00+00:00", "2019-06-15 14:00:00+00:00", "2019-06-15 13:00:00+00:00", "2019-06-15 12:00:00+00:00", "2019-06-15 11:00:00+00:00", "2019-06-15 10:00:00+00:00", "2019-06-15 09:00:00+00:00", "2019-06-15 08:00:00+00:00", "2019-06-15 07:00:00+00:00", "2019-06-15 06:00:00+00:00", "2019-06-15 05:00:00+00:00", "2019-06-15 04:00:00+00:00", "2019-06-15 00:00:00+00:00", "2019-06-14 23:00:00+00:00", "2019-06-14 22:00:00+00:00", "2019-06-14 21:00:00+00:00", "2019-06-14 20:00:00+00:00", "2019-06-14 19:00:00+00:00", "2019-06-14 18:00:00+00:00", "2019-06-14 17:00:00+00:00", "2019-06-14 16:00:00+00:00", "2019-06-14 15:00:00+00:00", "2019-06-14 14:00:00+00:00", "2019-06-14 13:00:00+00:00", "2019-06-14 12:00:00+00:00", "2019-06-14 11:00:00+00:00", "2019-06-14 10:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-14 08:00:00+00:00", "2019-06-14 07:00:00+00:00", "2019-06-14 06:00:00+00:00", "2019-06-14 05:00:00+00:00", "2019-06-14 04:00:00+00:00", "2019-06-14 03:00:00+00:00", "2019-06-14 02:00:00+00:00", "2019-06-14 00:00:00+00:00", "2019-06-13 23:00:00+00:00", "2019-06-13 22:00:00+00:00", "2019-06-13 21:00:00+00:00", "2019-06-13 20:00:00+00:00", "2019-06-13 19:00:00+00:00", "2019-06-13 18:00:00+00:00", "2019-06-13 17:00:00+00:00", "2019-06-13 16:00:00+00:00", "2019-06-13 15:00:00+00:00", "2019-06-13 14:00:00+00:00", "2019-06-13 13:00:00+00:00", "2019-06-13 12:00:00+00:00", "2019-06-13 11:00:00+00:00", "2019-06-13 10:00:00+00:00", "2019-06-13 09:00:00+00:00", "2019-06-13 08:00:00+00:00", "2019-06-13 07:00:00+00:00", "2019-06-13 06:00:00+00:00", "2019-06-13 05:00:00+00:00", "2019-06-13 04:00:00+00:00", "2019-06-13 03:00:00+00:00", "2019-06-13 02:00:00+00:00", "2019-06-13 00:00:00+00:00", "2019-06-12 23:00:00+00:00", "2019-06-12 21:00:00+00:00", "2019-06-12 20:00:00+00:00", "2019-06-12 19:00:00+00:00", "2019-06-12 18:00:00+00:00", "2019-06-12 17:00:00+00:00", "2019-06-12 16:00:00+00:00", "2019-06-12 15:00:00+00:00", "2019-06-12 14:00:00+00:00", "2019-06-12 13:00:00+00:00", "2019-06-12 12:00:00+00:00", "2019-06-12 11:00:00+00:00", "2019-06-12 10:00:00+00:00", "2019-06-12 09:00:00+00:00", "2019-06-12 08:00:00+00:00", "2019-06-12 07:00:00+00:00", "2019-06-12 06:00:00+00:00", "2019-06-12 05:00:00+00:00", "2019-06-12 04:00:00+00:00", "2019-06-12 03:00:00+00:00", "2019-06-12 00:00:00+00:00", "2019-06-11 23:00:00+00:00", "2019-06-11 22:00:00+00:00", "2019-06-11 21:00:00+00:00", "2019-06-11 20:00:00+00:00", "2019-06-11 19:00:00+00:00", "2019-06-11 18:00:00+00:00", "2019-06-11 17:00:00+00:00", "2019-06-11 16:00:00+00:00", "2019-06-11 15:00:00+00:00", "2019-06-11 14:00:00+00:00", "2019-06-11 13:00:00+00:00", "2019-06-11 12:00:00+00:00", "2019-06-11 11:00:00+00:00", "2019-06-11 10:00:00+00:00", "2019-06-11 09:00:00+00:00", "2019-06-11 08:00:00+00:00", "2019-06-11 07:00:00+00:00", "2019-06-11 06:00:00+00:00", "2019-06-11 05:00:00+00:00", "2019-06-11 04:00:00+00:00", "2019-06-11 03:00:00+00:00", "2019-06-11 02:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-11 00:00:00+00:00", "2019-06-10 23:00:00+00:00", "2019-06-10 22:00:00+00:00", "2019-06-10 21:00:00+00:00", "2019-06-10 20:00:00+00:00", "2019-06-10 19:00:00+00:00", "2019-06-10 18:00:00+00:00", "2019-06-10 17:00:00+00:00", "2019-06-10 16:00:00+00:00", "2019-06-10 15:00:00+00:00", "2019-06-10 14:00:00+00:00", "2019-06-10 13:00:00+00:00", "2019-06-10 12:00:00+00:00", "2019-06-10 11:00:00+00:00", "2019-06-10 10:00:00+00:00", "2019-06-10 09:00:00+00:00", "2019-06-10 08:00:00+00:00", "2019-06-10 07:00:00+00:00", "2019-06-10 06:00:00+00:00", "2019-06-10 05:00:00+00:00", "2019-06-10 04:00:00+00:00", "2019-06-10 03:00:00+00:00", "2019-06-10 02:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-10 00:00:00+00:00", "2019-06-09 23:00:00+00:00", "2019-06-09 21:00:00+00:00", "2019-06-09 20:00:00+00:00", "2019-06-09 19:00:00+00:00", "2019-06-09 18:00:00+00:00", "2019-06-09 17:00:00+00:00", "2019-06-09 16:00:00+00:00", "2019-06-09 15:00:00+00:00", "2019-06-09 14:00:00+00:00", "2019-06-09 13:00:00+00:00", "2019-06-09 12:00:00+00:00", "2019-06-09 11:00:00+00:00", "2019-06-09 10:00:00+00:00", "2019-06-09 09:00:00+00:00", "2019-06-09 08:00:00+00:00", "2019-06-09 07:00:00+00:00", "2019-06-09 06:00:00+00:00", "2019-06-09 05:00:00+00:00", "2019-06-09 04:00:00+00:00", "2019-06-09 03:00:00+00:00", "2019-06-09 02:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-09 00:00:00+00:00", "2019-06-08 23:00:00+00:00", "2019-06-08 21:00:00+00:00", "2019-06-08 20:00:00+00:00", "2019-06-08 19:00:00+00:00", "2019-06-08 18:00:00+00:00", "2019-06-08 17:00:00+00:00", "2019-06-08 16:00:00+00:00", "2019-06-08 15:00:00+00:00", "2019-06-08 14:00:00+00:00", "2019-06-08 13:00:00+00:00", "2019-06-08 12:00:00+00:00", "2019-06-08 11:00:00+00:00", "2019-06-08 10:00:00+00:00", "2019-06-08 09:00:00+00:00", "2019-06-08 08:00:00+00:00", "2019-06-08 07:00:00+00:00", "2019-06-08 06:00:00+00:00", "2019-06-08 05:00:00+00:00", "2019-06-08 04:00:00+00:00", "2019-06-08 03:00:00+00:00", "2019-06-08 02:00:00+00:00", "2019-06-08 00:00:00+00:00", "2019-06-07 23:00:00+00:00", "2019-06-07 21:00:00+00:00", "2019-06-07 20:00:00+00:00", "2019-06-07 19:00:00+00:00", "2019-06-07 18:00:00+00:00", "2019-06-07 17:00:00+00:00", "2019-06-07 16:00:00+00:00", "2019-06-07 15:00:00+00:00", "2019-06-07 14:00:00+00:00", "2019-06-07 13:00:00+00:00", "2019-06-07 12:00:00+00:00", "2019-06-07 11:00:00+00:00", "2019-06-07 10:00:00+00:00", "2019-06-07 09:00:00+00:00", "2019-06-07 08:00:00+00:00", "2019-06-07 07:00:00+00:00", "2019-06-07 06:00:00+00:00", "2019-06-07 05:00:00+00:00", "2019-06-07 04:00:00+00:00", "2019-06-07 03:00:00+00:00", "2019-06-07 02:00:00+00:00", "2019-06-07 01:00:00+00:00", "2019-06-07 00:00:00+00:00", "2019-06-06 23:00:00+00:00", "2019-06-06 22:00:00+00:00", "2019-06-06 21:00:00+00:00", "2019-06-06 20:00:00+00:00", "2019-06-06 19:00:00+00:00", "2019-06-06 18:00:00+00:00", "2019-06-06 17:00:00+00:00", "2019-06-06 16:00:00+00:00", "2019-06-06 15:00:00+00:00", "2019-06-06 14:00:00+00:00", "2019-06-06 13:00:00+00:00", "2019-06-06 12:00:00+00:00", "2019-06-06 11:00:00+00:00", "2019-06-06 10:00:00+00:00", "2019-06-06 09:00:00+00:00", "2019-06-06 08:00:00+00:00", "2019-06-06 07:00:00+00:00", "2019-06-06 06:00:00+00:00", "2019-06-06 05:00:00+00:00", "2019-06-06 04:00:00+00:00", "2019-06-06 03:00:00+00:00", "2019-06-06 02:00:00+00:00", "2019-06-06 00:00:00+00:00", "2019-06-05 23:00:00+00:00", "2019-06-05 22:00:00+00:00", "2019-06-05 21:00:00+00:00", "2019-06-05 20:00:00+00:00", "2019-06-05 19:00:00+00:00", "2019-06-05 18:00:00+00:00", "2019-06-05 17:00:00+00:00", "2019-06-05 16:00:00+00:00", "2019-06-05 15:00:00+00:00", "2019-06-05 14:00:00+00:00", "2019-06-05 13:00:00+00:00", "2019-06-05 12:00:00+00:00", "2019-06-05 11:00:00+00:00", "2019-06-05 10:00:00+00:00", "2019-06-05 09:00:00+00:00", "2019-06-05 08:00:00+00:00", "2019-06-05 07:00:00+00:00", "2019-06-05 06:00:00+00:00", "2019-06-05 05:00:00+00:00", "2019-06-05 04:00:00+00:00", "2019-06-05 03:00:00+00:00", "2019-06-05 02:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-05 00:00:00+00:00", "2019-06-04 23:00:00+00:00", "2019-06-04 22:00:00+00:00", "2019-06-04 21:00:00+00:00", "2019-06-04 20:00:00+00:00", "2019-06-04 19:00:00+00:00", "2019-06-04 18:00:00+00:00", "2019-06-04 17:00:00+00:00", "2019-06-04 16:00:00+00:00", "2019-06-04 15:00:00+00:00", "2019-06-04 14:00:00+00:00", "2019-06-04 13:00:00+00:00", "2019-06-04 12:00:00+00:00", "2019-06-04 11:00:00+00:00", "2019-06-04 10:00:00+00:00", "2019-06-04 09:00:00+00:00", "2019-06-04 08:00:00+00:00", "2019-06-04 07:00:00+00:00", "2019-06-04 06:00:00+00:00", "2019-06-04 05:00:00+00:00", "2019-06-04 04:00:00+00:00", "2019-06-04 03:00:00+00:00", "2019-06-04 02:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-04 00:00:00+00:00", "2019-06-03 23:00:00+00:00", "2019-06-03 22:00:00+00:00", "2019-06-03 21:00:00+00:00", "2019-06-03 20:00:00+00:00", "2019-06-03 19:00:00+00:00", "2019-06-03 18:00:00+00:00", "2019-06-03 17:00:00+00:00", "2019-06-03 16:00:00+00:00", "2019-06-03 15:00:00+00:00", "2019-06-03 14:00:00+00:00", "2019-06-03 13:00:00+00:00", "2019-06-03 12:00:00+00:00", "2019-06-03 11:00:00+00:00", "2019-06-03 10:00:00+00:00", "2019-06-03 09:00:00+00:00", "2019-06-03 08:00:00+00:00", "2019-06-03 07:00:00+00:00", "2019-06-03 06:00:00+00:00", "2019-06-03 05:00:00+00:00", "2019-06-03 04:00:00+00:00", "2019-06-03 03:00:00+00:00", "2019-06-03 02:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-03 00:00:00+00:00", "2019-06-02 23:00:00+00:00", "2019-06-02 22:00:00+00:00", "2019-06-02 21:00:00+00:00", "2019-06-02 20:00:00+00:00", "2019-06-02 19:00:00+00:00", "2019-06-02 18:00:00+00:00", "2019-06-02 17:00:00+00:00", "2019-06-02 16:00:00+00:00", "2019-06-02 15:00:00+00:00", "2019-06-02 14:00:00+00:00", "2019-06-02 13:00:00+00:00", "2019-06-02 12:00:00+00:00", "2019-06-02 11:00:00+00:00", "2019-06-02 10:00:00+00:00", "2019-06-02 09:00:00+00:00", "2019-06-02 08:00:00+00:00", "2019-06-02 07:00:00+00:00", "2019-06-02 06:00:00+00:00", "2019-06-02 05:00:00+00:00", "2019-06-02 04:00:00+00:00", "2019-06-02 03:00:00+00:00", "2019-06-02 02:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-02 00:00:00+00:00", "2019-06-01 23:00:00+00:00", "2019-06-01 22:00:00+00:00", "2019-06-01 21:00:00+00:00", "2019-06-01 20:00:00+00:00", "2019-06-01 19:00:00+00:00", "2019-06-01 18:00:00+00:00", "2019-06-01 17:00:00+00:00", "2019-06-01 16:00:00+00:00", "2019-06-01 15:00:00+00:00", "2019-06-01 14:00:00+00:00", "2019-06-01 13:00:00+00:00", "2019-06-01 12:00:00+00:00", "2019-06-01 11:00:00+00:00", "2019-06-01 10:00:00+00:00", "2019-06-01 09:00:00+00:00", "2019-06-01 08:00:00+00:00", "2019-06-01 07:00:00+00:00", "2019-06-01 06:00:00+00:00", "2019-06-01 05:00:00+00:00", "2019-06-01 04:00:00+00:00", "2019-06-01 03:00:00+00:00", "2019-06-01 02:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-06-01 00:00:00+00:00", "2019-05-31 23:00:00+00:00", "2019-05-31 22:00:00+00:00", "2019-05-31 21:00:00+00:00", "2019-05-31 20:00:00+00:00", "2019-05-31 19:00:00+00:00", "2019-05-31 18:00:00+00:00", "2019-05-31 17:00:00+00:00", "2019-05-31 16:00:00+00:00", "2019-05-31 15:00:00+00:00", "2019-05-31 14:00:00+00:00", "2019-05-31 13:00:00+00:00", "2019-05-31 12:00:00+00:00", "2019-05-31 11:00:00+00:00", "2019-05-31 10:00:00+00:00", "2019-05-31 09:00:00+00:00", "2019-05-31 08:00:00+00:00", "2019-05-31 07:00:00+00:00", "2019-05-31 06:00:00+00:00", "2019-05-31 05:00:00+00:00", "2019-05-31 04:00:00+00:00", "2019-05-31 03:00:00+00:00", "2019-05-31 02:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-31 00:00:00+00:00", "2019-05-30 23:00:00+00:00", "2019-05-30 22:00:00+00:00", "2019-05-30 21:00:00+00:00", "2019-05-30 20:00:00+00:00", "2019-05-30 19:00:00+00:00", "2019-05-30 18:00:00+00:00", "2019-05-30 17:00:00+00:00", "2019-05-30 16:00:00+00:00", "2019-05-30 15:00:00+00:00", "2019-05-30 14:00:00+00:00", "2019-05-30 13:00:00+00:00", "2019-05-30 12:00:00+00:00", "2019-05-30 11:00:00+00:00", "2019-05-30 10:00:00+00:00", "2019-05-30 09:00:00+00:00", "2019-05-30 08:00:00+00:00", "2019-05-30 07:00:00+00:00", "2019-05-30 06:00:00+00:00", "2019-05-30 05:00:00+00:00", "2019-05-30 04:00:00+00:00", "2019-05-30 03:00:00+00:00", "2019-05-30 02:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-30 00:00:00+00:00", "2019-05-29 23:00:00+00:00", "2019-05-29 22:00:00+00:00", "2019-05-29 21:00:00+00:00", "2019-05-29 20:00:00+00:00", "2019-05-29 19:00:00+00:00", "2019-05-29 18:00:00+00:00", "2019-05-29 17:00:00+00:00", "2019-05-29 16:00:00+00:00", "2019-05-29 15:00:00+00:00", "2019-05-29 14:00:00+00:00", "2019-05-29 13:00:00+00:00", "2019-05-29 12:00:00+00:00", "2019-05-29 11:00:00+00:00", "2019-05-29 10:00:00+00:00", "2019-05-29 09:00:00+00:00", "2019-05-29 08:00:00+00:00", "2019-05-29 07:00:00+00:00", "2019-05-29 06:00:00+00:00", "2019-05-29 05:00:00+00:00", "2019-05-29 04:00:00+00:00", "2019-05-29 03:00:00+00:00", "2019-05-29 02:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-29 00:00:00+00:00", "2019-05-28 23:00:00+00:00", "2019-05-28 21:00:00+00:00", "2019-05-28 20:00:00+00:00", "2019-05-28 19:00:00+00:00", "2019-05-28 18:00:00+00:00", "2019-05-28 17:00:00+00:00", "2019-05-28 16:00:00+00:00", "2019-05-28 15:00:00+00:00", "2019-05-28 14:00:00+00:00", "2019-05-28 13:00:00+00:00", "2019-05-28 12:00:00+00:00", "2019-05-28 11:00:00+00:00", "2019-05-28 10:00:00+00:00", "2019-05-28 09:00:00+00:00", "2019-05-28 08:00:00+00:00", "2019-05-28 07:00:00+00:00", "2019-05-28 06:00:00+00:00", "2019-05-28 05:00:00+00:00", "2019-05-28 04:00:00+00:00", "2019-05-28 03:00:00+00:00", "2019-05-28 02:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-28 00:00:00+00:00", "2019-05-27 23:00:00+00:00", "2019-05-27 22:00:00+00:00", "2019-05-27 21:00:00+00:00", "2019-05-27 20:00:00+00:00", "2019-05-27 19:00:00+00:00", "2019-05-27 18:00:00+00:00", "2019-05-27 17:00:00+00:00", "2019-05-27 16:00:00+00:00", "2019-05-27 15:00:00+00:00", "2019-05-27 14:00:00+00:00", "2019-05-27 13:00:00+00:00", "2019-05-27 12:00:00+00:00", "2019-05-27 11:00:00+00:00", "2019-05-27 10:00:00+00:00", "2019-05-27 09:00:00+00:00", "2019-05-27 08:00:00+00:00", "2019-05-27 07:00:00+00:00", "2019-05-27 06:00:00+00:00", "2019-05-27 05:00:00+00:00", "2019-05-27 04:00:00+00:00", "2019-05-27 03:00:00+00:00", "2019-05-27 02:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-27 00:00:00+00:00", "2019-05-26 23:00:00+00:00", "2019-05-26 22:00:00+00:00", "2019-05-26 21:00:00+00:00", "2019-05-26 20:00:00+00:00", "2019-05-26 19:00:00+00:00", "2019-05-26 18:00:00+00:00", "2019-05-26 17:00:00+00:00", "2019-05-26 16:00:00+00:00", "2019-05-26 15:00:00+00:00", "2019-05-26 14:00:00+00:00", "2019-05-26 13:00:00+00:00", "2019-05-26 12:00:00+00:00", "2019-05-26 11:00:00+00:00", "2019-05-26 10:00:00+00:00", "2019-05-26 09:00:00+00:00", "2019-05-26 08:00:00+00:00", "2019-05-26 07:00:00+00:00", "2019-05-26 06:00:00+00:00", "2019-05-26 05:00:00+00:00", "2019-05-26 04:00:00+00:00", "2019-05-26 03:00:00+00:00", "2019-05-26 02:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-26 00:00:00+00:00", "2019-05-25 23:00:00+00:00", "2019-05-25 22:00:00+00:00", "2019-05-25 21:00:00+00:00", "2019-05-25 20:00:00+00:00", "2019-05-25 19:00:00+00:00", "2019-05-25 18:00:00+00:00", "2019-05-25 17:00:00+00:00", "2019-05-25 16:00:00+00:00", "2019-05-25 15:00:00+00:00", "2019-05-25 14:00:00+00:00", "2019-05-25 13:00:00+00:00", "2019-05-25 12:00:00+00:00", "2019-05-25 11:00:00+00:00", "2019-05-25 10:00:00+00:00", "2019-05-25 09:00:00+00:00", "2019-05-25 08:00:00+00:00", "2019-05-25 07:00:00+00:00", "2019-05-25 06:00:00+00:00", "2019-05-25 05:00:00+00:00", "2019-05-25 04:00:00+00:00", "2019-05-25 03:00:00+00:00", "2019-05-25 02:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-25 00:00:00+00:00", "2019-05-24 23:00:00+00:00", "2019-05-24 22:00:00+00:00", "2019-05-24 21:00:00+00:00", "2019-05-24 20:00:00+00:00", "2019-05-24 19:00:00+00:00", "2019-05-24 18:00:00+00:00", "2019-05-24 17:00:00+00:00", "2019-05-24 16:00:00+00:00", "2019-05-24 15:00:00+00:00", "2019-05-24 14:00:00+00:00", "2019-05-24 13:00:00+00:00", "2019-05-24 12:00:00+00:00", "2019-05-24 11:00:00+00:00", "2019-05-24 10:00:00+00:00", "2019-05-24 09:00:00+00:00", "2019-05-24 08:00:00+00:00", "2019-05-24 07:00:00+00:00", "2019-05-24 06:00:00+00:00", "2019-05-24 05:00:00+00:00", "2019-05-24 04:00:00+00:00", "2019-05-24 03:00:00+00:00", "2019-05-24 02:00:00+00:00", "2019-05-24 00:00:00+00:00", "2019-05-23 23:00:00+00:00", "2019-05-23 22:00:00+00:00", "2019-05-23 21:00:00+00:00", "2019-05-23 20:00:00+00:00", "2019-05-23 19:00:00+00:00", "2019-05-23 18:00:00+00:00", "2019-05-23 17:00:00+00:00", "2019-05-23 16:00:00+00:00", "2019-05-23 15:00:00+00:00", "2019-05-23 14:00:00+00:00", "2019-05-23 13:00:00+00:00", "2019-05-23 12:00:00+00:00", "2019-05-23 11:00:00+00:00", "2019-05-23 10:00:00+00:00", "2019-05-23 09:00:00+00:00", "2019-05-23 08:00:00+00:00", "2019-05-23 07:00:00+00:00", "2019-05-23 06:00:00+00:00", "2019-05-23 05:00:00+00:00", "2019-05-23 04:00:00+00:00", "2019-05-23 03:00:00+00:00", "2019-05-23 02:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-23 00:00:00+00:00", "2019-05-22 23:00:00+00:00", "2019-05-22 22:00:00+00:00", "2019-05-22 21:00:00+00:00", "2019-05-22 20:00:00+00:00", "2019-05-22 19:00:00+00:00", "2019-05-22 18:00:00+00:00", "2019-05-22 17:00:00+00:00", "2019-05-22 16:00:00+00:00", "2019-05-22 15:00:00+00:00", "2019-05-22 14:00:00+00:00", "2019-05-22 13:00:00+00:00", "2019-05-22 12:00:00+00:00", "2019-05-22 11:00:00+00:00", "2019-05-22 10:00:00+00:00", "2019-05-22 09:00:00+00:00", "2019-05-22 08:00:00+00:00", "2019-05-22 07:00:00+00:00", "2019-05-22 06:00:00+00:00", "2019-05-22 05:00:00+00:00", "2019-05-22 04:00:00+00:00", "2019-05-22 03:00:00+00:00", "2019-05-22 02:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-22 00:00:00+00:00", "2019-05-21 23:00:00+00:00", "2019-05-21 22:00:00+00:00", "2019-05-21 21:00:00+00:00", "2019-05-21 20:00:00+00:00", "2019-05-21 19:00:00+00:00", "2019-05-21 18:00:00+00:00", "2019-05-21 17:00:00+00:00", "2019-05-21 16:00:00+00:00", "2019-05-21 15:00:00+00:00", "2019-05-21 14:00:00+00:00", "2019-05-21 13:00:00+00:00", "2019-05-21 12:00:00+00:00", "2019-05-21 11:00:00+00:00", "2019-05-21 10:00:00+00:00", "2019-05-21 09:00:00+00:00", "2019-05-21 08:00:00+00:00", "2019-05-21 07:00:00+00:00", "2019-05-21 06:00:00+00:00", "2019-05-21 05:00:00+00:00", "2019-05-21 04:00:00+00:00", "2019-05-21 03:00:00+00:00", "2019-05-21 02:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-21 00:00:00+00:00", "2019-05-20 23:00:00+00:00", "2019-05-20 22:00:00+00:00", "2019-05-20 21:00:00+00:00", "2019-05-20 20:00:00+00:00", "2019-05-20 19:00:00+00:00", "2019-05-20 18:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 17:00:00+00:00", "2019-05-18 16:00:00+00:00", "2019-05-18 15:00:00+00:00", "2019-05-18 14:00:00+00:00", "2019-05-18 13:00:00+00:00", "2019-05-18 12:00:00+00:00", "2019-05-18 11:00:00+00:00", "2019-05-18 10:00:00+00:00", "2019-05-18 09:00:00+00:00", "2019-05-18 08:00:00+00:00", "2019-05-18 07:00:00+00:00", "2019-05-18 06:00:00+00:00", "2019-05-18 05:00:00+00:00", "2019-05-18 04:00:00+00:00", "2019-05-18 03:00:00+00:00", "2019-05-18 02:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-18 00:00:00+00:00", "2019-05-17 23:00:00+00:00", "2019-05-17 22:00:00+00:00", "2019-05-17 21:00:00+00:00", "2019-05-17 20:00:00+00:00", "2019-05-17 19:00:00+00:00", "2019-05-17 18:00:00+00:00", "2019-05-17 17:00:00+00:00", "2019-05-17 16:00:00+00:00", "2019-05-17 15:00:00+00:00", "2019-05-17 14:00:00+00:00", "2019-05-17 13:00:00+00:00", "2019-05-17 12:00:00+00:00", "2019-05-17 11:00:00+00:00", "2019-05-17 10:00:00+00:00", "2019-05-17 09:00:00+00:00", "2019-05-17 08:00:00+00:00", "2019-05-17 07:00:00+00:00", "2019-05-17 06:00:00+00:00", "2019-05-17 05:00:00+00:00", "2019-05-17 04:00:00+00:00", "2019-05-17 03:00:00+00:00", "2019-05-17 02:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-17 00:00:00+00:00", "2019-05-16 23:00:00+00:00", "2019-05-16 22:00:00+00:00", "2019-05-16 21:00:00+00:00", "2019-05-16 20:00:00+00:00", "2019-05-16 19:00:00+00:00", "2019-05-16 18:00:00+00:00", "2019-05-16 17:00:00+00:00", "2019-05-16 16:00:00+00:00", "2019-05-16 15:00:00+00:00", "2019-05-16 14:00:00+00:00", "2019-05-16 13:00:00+00:00", "2019-05-16 12:00:00+00:00", "2019-05-16 11:00:00+00:00", "2019-05-16 10:00:00+00:00", "2019-05-16 09:00:00+00:00", "2019-05-16 08:00:00+00:00", "2019-05-16 07:00:00+00:00", "2019-05-16 06:00:00+00:00", "2019-05-16 05:00:00+00:00", "2019-05-16 04:00:00+00:00", "2019-05-16 03:00:00+00:00", "2019-05-16 02:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-16 00:00:00+00:00", "2019-05-15 23:00:00+00:00", "2019-05-15 22:00:00+00:00", "2019-05-15 21:00:00+00:00", "2019-05-15 20:00:00+00:00", "2019-05-15 19:00:00+00:00", "2019-05-15 18:00:00+00:00", "2019-05-15 17:00:00+00:00", "2019-05-15 16:00:00+00:00", "2019-05-15 15:00:00+00:00", "2019-05-15 14:00:00+00:00", "2019-05-15 13:00:00+00:00", "2019-05-15 12:00:00+00:00", "2019-05-15 11:00:00+00:00", "2019-05-15 10:00:00+00:00", "2019-05-15 09:00:00+00:00", "2019-05-15 08:00:00+00:00", "2019-05-15 07:00:00+00:00", "2019-05-15 06:00:00+00:00", "2019-05-15 05:00:00+00:00", "2019-05-15 04:00:00+00:00", "2019-05-15 03:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 00:00:00+00:00", "2019-05-14 23:00:00+00:00", "2019-05-14 22:00:00+00:00", "2019-05-14 21:00:00+00:00", "2019-05-14 20:00:00+00:00", "2019-05-14 19:00:00+00:00", "2019-05-14 18:00:00+00:00", "2019-05-14 17:00:00+00:00", "2019-05-14 16:00:00+00:00", "2019-05-14 15:00:00+00:00", "2019-05-14 14:00:00+00:00", "2019-05-14 13:00:00+00:00", "2019-05-14 12:00:00+00:00", "2019-05-14 11:00:00+00:00", "2019-05-14 10:00:00+00:00", "2019-05-14 09:00:00+00:00", "2019-05-14 08:00:00+00:00", "2019-05-14 07:00:00+00:00", "2019-05-14 06:00:00+00:00", "2019-05-14 05:00:00+00:00", "2019-05-14 04:00:00+00:00", "2019-05-14 03:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-14 00:00:00+00:00", "2019-05-13 23:00:00+00:00", "2019-05-13 22:00:00+00:00", "2019-05-13 21:00:00+00:00", "2019-05-13 20:00:00+00:00", "2019-05-13 19:00:00+00:00", "2019-05-13 18:00:00+00:00", "2019-05-13 17:00:00+00:00", "2019-05-13 16:00:00+00:00", "2019-05-13 15:00:00+00:00", "2019-05-13 14:00:00+00:00", "2019-05-13 13:00:00+00:00", "2019-05-13 12:00:00+00:00", "2019-05-13 11:00:00+00:00", "2019-05-13 10:00:00+00:00", "2019-05-13 09:00:00+00:00", "2019-05-13 08:00:00+00:00", "2019-05-13 07:00:00+00:00", "2019-05-13 06:00:00+00:00", "2019-05-13 05:00:00+00:00", "2019-05-13 04:00:00+00:00", "2019-05-13 03:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-13 00:00:00+00:00", "2019-05-12 23:00:00+00:00", "2019-05-12 22:00:00+00:00", "2019-05-12 21:00:00+00:00", "2019-05-12 20:00:00+00:00", "2019-05-12 19:00:00+00:00", "2019-05-12 18:00:00+00:00", "2019-05-12 17:00:00+00:00", "2019-05-12 16:00:00+00:00", "2019-05-12 15:00:00+00:00", "2019-05-12 14:00:00+00:00", "2019-05-12 13:00:00+00:00", "2019-05-12 12:00:00+00:00", "2019-05-12 11:00:00+00:00", "2019-05-12 10:00:00+00:00", "2019-05-12 09:00:00+00:00", "2019-05-12 08:00:00+00:00", "2019-05-12 07:00:00+00:00", "2019-05-12 06:00:00+00:00", "2019-05-12 05:00:00+00:00", "2019-05-12 04:00:00+00:00", "2019-05-12 03:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-12 00:00:00+00:00", "2019-05-11 23:00:00+00:00", "2019-05-11 22:00:00+00:00", "2019-05-11 21:00:00+00:00", "2019-05-11 20:00:00+00:00", "2019-05-11 19:00:00+00:00", "2019-05-11 18:00:00+00:00", "2019-05-11 17:00:00+00:00", "2019-05-11 16:00:00+00:00", "2019-05-11 15:00:00+00:00", "2019-05-11 09:00:00+00:00", "2019-05-11 08:00:00+00:00", "2019-05-11 07:00:00+00:00", "2019-05-11 06:00:00+00:00", "2019-05-11 05:00:00+00:00", "2019-05-11 04:00:00+00:00", "2019-05-11 03:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-11 00:00:00+00:00", "2019-05-10 23:00:00+00:00", "2019-05-10 22:00:00+00:00", "2019-05-10 21:00:00+00:00", "2019-05-10 20:00:00+00:00", "2019-05-10 19:00:00+00:00", "2019-05-10 18:00:00+00:00", "2019-05-10 17:00:00+00:00", "2019-05-10 16:00:00+00:00", "2019-05-10 15:00:00+00:00", "2019-05-10 14:00:00+00:00", "2019-05-10 13:00:00+00:00", "2019-05-10 12:00:00+00:00", "2019-05-10 11:00:00+00:00", "2019-05-10 10:00:00+00:00", "2019-05-10 09:00:00+00:00", "2019-05-10 08:00:00+00:00", "2019-05-10 07:00:00+00:00", "2019-05-10 06:00:00+00:00", "2019-05-10 05:00:00+00:00", "2019-05-10 04:00:00+00:00", "2019-05-10 03:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-10 00:00:00+00:00", "2019-05-09 23:00:00+00:00", "2019-05-09 22:00:00+00:00", "2019-05-09 21:00:00+00:00", "2019-05-09 20:00:00+00:00", "2019-05-09 19:00:00+00:00", "2019-05-09 18:00:00+00:00", "2019-05-09 17:00:00+00:00", "2019-05-09 16:00:00+00:00", "2019-05-09 15:00:00+00:00", "2019-05-09 14:00:00+00:00", "2019-05-09 13:00:00+00:00", "2019-05-09 12:00:00+00:00", "2019-05-09 11:00:00+00:00", "2019-05-09 10:00:00+00:00", "2019-05-09 09:00:00+00:00", "2019-05-09 08:00:00+00:00", "2019-05-09 07:00:00+00:00", "2019-05-09 06:00:00+00:00", "2019-05-09 05:00:00+00:00", "2019-05-09 04:00:00+00:00", "2019-05-09 03:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 00:00:00+00:00", "2019-05-08 23:00:00+00:00", "2019-05-08 21:00:00+00:00", "2019-05-08 20:00:00+00:00", "2019-05-08 19:00:00+00:00", "2019-05-08 18:00:00+00:00", "2019-05-08 17:00:00+00:00", "2019-05-08 16:00:00+00:00", "2019-05-08 15:00:00+00:00", "2019-05-08 14:00:00+00:00", "2019-05-08 13:00:00+00:00", "2019-05-08 12:00:00+00:00", "2019-05-08 11:00:00+00:00", "2019-05-08 10:00:00+00:00", "2019-05-08 09:00:00+00:00", "2019-05-08 08:00:00+00:00", "2019-05-08 07:00:00+00:00", "2019-05-08 06:00:00+00:00", "2019-05-08 05:00:00+00:00", "2019-05-08 04:00:00+00:00", "2019-05-08 03:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-08 00:00:00+00:00", "2019-05-07 23:00:00+00:00", "2019-05-07 21:00:00+00:00", "2019-05-07 20:00:00+00:00", "2019-05-07 19:00:00+00:00", "2019-05-07 18:00:00+00:00", "2019-05-07 17:00:00+00:00", "2019-05-07 16:00:00+00:00", "2019-05-07 15:00:00+00:00", "2019-05-07 14:00:00+00:00", "2019-05-07 13:00:00+00:00", "2019-05-07 12:00:00+00:00", "2019-05-07 11:00:00+00:00", "2019-05-07 10:00:00+00:00", "2019-05-07 09:00:00+00:00", "2019-05-07 08:00:00+00:00", "2019-05-07 07:00:00+00:00", "2019-05-07 06:00:00+00:00", "2019-05-07 04:00:00+00:00", "2019-05-07 03:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 23:00:00+00:00", "2019-05-06 22:00:00+00:00", "2019-05-06 21:00:00+00:00", "2019-05-06 20:00:00+00:00", "2019-05-06 19:00:00+00:00", "2019-05-06 18:00:00+00:00", "2019-05-06 17:00:00+00:00", "2019-05-06 16:00:00+00:00", "2019-05-06 15:00:00+00:00", "2019-05-06 14:00:00+00:00", "2019-05-06 13:00:00+00:00", "2019-05-06 12:00:00+00:00", "2019-05-06 11:00:00+00:00", "2019-05-06 10:00:00+00:00", "2019-05-06 09:00:00+00:00", "2019-05-06 08:00:00+00:00", "2019-05-06 07:00:00+00:00", "2019-05-06 06:00:00+00:00", "2019-05-06 05:00:00+00:00", "2019-05-06 04:00:00+00:00", "2019-05-06 03:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-06 00:00:00+00:00", "2019-05-05 23:00:00+00:00", "2019-05-05 22:00:00+00:00", "2019-05-05 21:00:00+00:00", "2019-05-05 20:00:00+00:00", "2019-05-05 19:00:00+00:00", "2019-05-05 18:00:00+00:00", "2019-05-05 17:00:00+00:00", "2019-05-05 16:00:00+00:00", "2019-05-05 15:00:00+00:00", "2019-05-05 14:00:00+00:00", "2019-05-05 13:00:00+00:00", "2019-05-05 12:00:00+00:00", "2019-05-05 11:00:00+00:00", "2019-05-05 10:00:00+00:00", "2019-05-05 09:00:00+00:00", "2019-05-05 08:00:00+00:00", "2019-05-05 07:00:00+00:00", "2019-05-05 06:00:00+00:00", "2019-05-05 05:00:00+00:00", "2019-05-05 04:00:00+00:00", "2019-05-05 03:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-05 00:00:00+00:00", "2019-05-04 23:00:00+00:00", "2019-05-04 22:00:00+00:00", "2019-05-04 21:00:00+00:00", "2019-05-04 20:00:00+00:00", "2019-05-04 19:00:00+00:00", "2019-05-04 18:00:00+00:00", "2019-05-04 17:00:00+00:00", "2019-05-04 16:00:00+00:00", "2019-05-04 15:00:00+00:00", "2019-05-04 14:00:00+00:00", "2019-05-04 13:00:00+00:00", "2019-05-04 12:00:00+00:00", "2019-05-04 11:00:00+00:00", "2019-05-04 10:00:00+00:00", "2019-05-04 09:00:00+00:00", "2019-05-04 08:00:00+00:00", "2019-05-04 07:00:00+00:00", "2019-05-04 06:00:00+00:00", "2019-05-04 05:00:00+00:00", "2019-05-04 04:00:00+00:00", "2019-05-04 03:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-04 00:00:00+00:00", "2019-05-03 23:00:00+00:00", "2019-05-03 22:00:00+00:00", "2019-05-03 21:00:00+00:00", "2019-05-03 20:00:00+00:00", "2019-05-03 19:00:00+00:00", "2019-05-03 18:00:00+00:00", "2019-05-03 17:00:00+00:00", "2019-05-03 16:00:00+00:00", "2019-05-03 15:00:00+00:00", "2019-05-03 14:00:00+00:00", "2019-05-03 13:00:00+00:00", "2019-05-03 12:00:00+00:00", "2019-05-03 11:00:00+00:00", "2019-05-03 10:00:00+00:00", "2019-05-03 09:00:00+00:00", "2019-05-03 08:00:00+00:00", "2019-05-03 07:00:00+00:00", "2019-05-03 06:00:00+00:00", "2019-05-03 05:00:00+00:00", "2019-05-03 04:00:00+00:00", "2019-05-03 03:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-03 00:00:00+00:00", "2019-05-02 23:00:00+00:00", "2019-05-02 22:00:00+00:00", "2019-05-02 21:00:00+00:00", "2019-05-02 20:00:00+00:00", "2019-05-02 19:00:00+00:00", "2019-05-02 18:00:00+00:00", "2019-05-02 17:00:00+00:00", "2019-05-02 16:00:00+00:00", "2019-05-02 15:00:00+00:00", "2019-05-02 14:00:00+00:00", "2019-05-02 13:00:00+00:00", "2019-05-02 12:00:00+00:00", "2019-05-02 11:00:00+00:00", "2019-05-02 10:00:00+00:00", "2019-05-02 09:00:00+00:00", "2019-05-02 08:00:00+00:00", "2019-05-02 07:00:00+00:00", "2019-05-02 06:00:00+00:00", "2019-05-02 05:00:00+00:00", "2019-05-02 04:00:00+00:00", "2019-05-02 03:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-02 00:00:00+00:00", "2019-05-01 23:00:00+00:00", "2019-05-01 22:00:00+00:00", "2019-05-01 21:00:00+00:00", "2019-05-01 20:00:00+00:00", "2019-05-01 19:00:00+00:00", "2019-05-01 18:00:00+00:00", "2019-05-01 17:00:00+00:00", "2019-05-01 16:00:00+00:00", "2019-05-01 15:00:00+00:00", "2019-05-01 14:00:00+00:00", "2019-05-01 13:00:00+00:00", "2019-05-01 12:00:00+00:00", "2019-05-01 11:00:00+00:00", "2019-05-01 10:00:00+00:00", "2019-05-01 09:00:00+00:00", "2019-05-01 08:00:00+00:00", "2019-05-01 07:00:00+00:00", "2019-05-01 06:00:00+00:00", "2019-05-01 05:00:00+00:00", "2019-05-01 04:00:00+00:00", "2019-05-01 03:00:00+00:00", "2019-05-01 00:00:00+00:00", "2019-04-30 23:00:00+00:00", "2019-04-30 22:00:00+00:00", "2019-04-30 21:00:00+00:00", "2019-04-30 20:00:00+00:00", "2019-04-30 19:00:00+00:00", "2019-04-30 18:00:00+00:00", "2019-04-30 17:00:00+00:00", "2019-04-30 16:00:00+00:00", "2019-04-30 15:00:00+00:00", "2019-04-30 14:00:00+00:00", "2019-04-30 13:00:00+00:00", "2019-04-30 12:00:00+00:00", "2019-04-30 11:00:00+00:00", "2019-04-30 10:00:00+00:00", "2019-04-30 09:00:00+00:00", "2019-04-30 08:00:00+00:00", "2019-04-30 07:00:00+00:00", "2019-04-30 06:00:00+00:00", "2019-04-30 05:00:00+00:00", "2019-04-30 04:00:00+00:00", "2019-04-30 03:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-30 00:00:00+00:00", "2019-04-29 23:00:00+00:00", "2019-04-29 22:00:00+00:00", "2019-04-29 21:00:00+00:00", "2019-04-29 20:00:00+00:00", "2019-04-29 19:00:00+00:00", "2019-04-29 18:00:00+00:00", "2019-04-29 17:00:00+00:00", "2019-04-29 16:00:00+00:00", "2019-04-29 15:00:00+00:00", "2019-04-29 14:00:00+00:00", "2019-04-29 13:00:00+00:00", "2019-04-29 12:00:00+00:00", "2019-04-29 11:00:00+00:00", "2019-04-29 10:00:00+00:00", "2019-04-29 09:00:00+00:00", "2019-04-29 08:00:00+00:00", "2019-04-29 07:00:00+00:00", "2019-04-29 06:00:00+00:00", "2019-04-29 05:00:00+00:00", "2019-04-29 04:00:00+00:00", "2019-04-29 03:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-29 00:00:00+00:00", "2019-04-28 23:00:00+00:00", "2019-04-28 22:00:00+00:00", "2019-04-28 21:00:00+00:00", "2019-04-28 20:00:00+00:00", "2019-04-28 19:00:00+00:00", "2019-04-28 18:00:00+00:00", "2019-04-28 17:00:00+00:00", "2019-04-28 16:00:00+00:00", "2019-04-28 15:00:00+00:00", "2019-04-28 14:00:00+00:00", "2019-04-28 13:00:00+00:00", "2019-04-28 12:00:00+00:00", "2019-04-28 11:00:00+00:00", "2019-04-28 10:00:00+00:00", "2019-04-28 09:00:00+00:00", "2019-04-27 13:00:00+00:00", "2019-04-27 12:00:00+00:00", "2019-04-27 11:00:00+00:00", "2019-04-27 10:00:00+00:00", "2019-04-27 09:00:00+00:00", "2019-04-27 08:00:00+00:00", "2019-04-27 07:00:00+00:00", "2019-04-27 06:00:00+00:00", "2019-04-27 05:00:00+00:00", "2019-04-27 04:00:00+00:00", "2019-04-27 03:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 00:00:00+00:00", "2019-04-26 23:00:00+00:00", "2019-04-26 22:00:00+00:00", "2019-04-26 21:00:00+00:00", "2019-04-26 20:00:00+00:00", "2019-04-26 19:00:00+00:00", "2019-04-26 18:00:00+00:00", "2019-04-26 17:00:00+00:00", "2019-04-26 16:00:00+00:00", "2019-04-26 15:00:00+00:00", "2019-04-26 14:00:00+00:00", "2019-04-26 13:00:00+00:00", "2019-04-26 12:00:00+00:00", "2019-04-26 11:00:00+00:00", "2019-04-26 10:00:00+00:00", "2019-04-26 09:00:00+00:00", "2019-04-26 08:00:00+00:00", "2019-04-26 07:00:00+00:00", "2019-04-26 06:00:00+00:00", "2019-04-26 05:00:00+00:00", "2019-04-26 04:00:00+00:00", "2019-04-26 03:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-26 00:00:00+00:00", "2019-04-25 23:00:00+00:00", "2019-04-25 22:00:00+00:00", "2019-04-25 21:00:00+00:00", "2019-04-25 20:00:00+00:00", "2019-04-25 19:00:00+00:00", "2019-04-25 18:00:00+00:00", "2019-04-25 17:00:00+00:00", "2019-04-25 16:00:00+00:00", "2019-04-25 15:00:00+00:00", "2019-04-25 14:00:00+00:00", "2019-04-25 13:00:00+00:00", "2019-04-25 12:00:00+00:00", "2019-04-25 11:00:00+00:00", "2019-04-25 10:00:00+00:00", "2019-04-25 09:00:00+00:00", "2019-04-25 08:00:00+00:00", "2019-04-25 07:00:00+00:00", "2019-04-25 06:00:00+00:00", "2019-04-25 05:00:00+00:00", "2019-04-25 04:00:00+00:00", "2019-04-25 03:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 00:00:00+00:00", "2019-04-24 23:00:00+00:00", "2019-04-24 22:00:00+00:00", "2019-04-24 21:00:00+00:00", "2019-04-24 20:00:00+00:00", "2019-04-24 19:00:00+00:00", "2019-04-24 18:00:00+00:00", "2019-04-24 17:00:00+00:00", "2019-04-24 16:00:00+00:00", "2019-04-24 15:00:00+00:00", "2019-04-24 14:00:00+00:00", "2019-04-24 13:00:00+00:00", "2019-04-24 12:00:00+00:00", "2019-04-24 11:00:00+00:00", "2019-04-24 10:00:00+00:00", "2019-04-24 09:00:00+00:00", "2019-04-24 08:00:00+00:00", "2019-04-24 07:00:00+00:00", "2019-04-24 06:00:00+00:00", "2019-04-24 05:00:00+00:00", "2019-04-24 04:00:00+00:00", "2019-04-24 03:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 00:00:00+00:00", "2019-04-23 23:00:00+00:00", "2019-04-23 22:00:00+00:00", "2019-04-23 21:00:00+00:00", "2019-04-23 20:00:00+00:00", "2019-04-23 19:00:00+00:00", "2019-04-23 18:00:00+00:00", "2019-04-23 17:00:00+00:00", "2019-04-23 16:00:00+00:00", "2019-04-23 15:00:00+00:00", "2019-04-23 14:00:00+00:00", "2019-04-23 13:00:00+00:00", "2019-04-23 12:00:00+00:00", "2019-04-23 11:00:00+00:00", "2019-04-23 10:00:00+00:00", "2019-04-23 09:00:00+00:00", "2019-04-23 08:00:00+00:00", "2019-04-23 07:00:00+00:00", "2019-04-23 06:00:00+00:00", "2019-04-23 05:00:00+00:00", "2019-04-23 04:00:00+00:00", "2019-04-23 03:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-23 00:00:00+00:00", "2019-04-22 23:00:00+00:00", "2019-04-22 22:00:00+00:00", "2019-04-22 21:00:00+00:00", "2019-04-22 20:00:00+00:00", "2019-04-22 19:00:00+00:00", "2019-04-22 18:00:00+00:00", "2019-04-22 17:00:00+00:00", "2019-04-22 16:00:00+00:00", "2019-04-22 15:00:00+00:00", "2019-04-22 14:00:00+00:00", "2019-04-22 13:00:00+00:00", "2019-04-22 12:00:00+00:00", "2019-04-22 11:00:00+00:00", "2019-04-22 10:00:00+00:00", "2019-04-22 09:00:00+00:00", "2019-04-22 08:00:00+00:00", "2019-04-22 07:00:00+00:00", "2019-04-22 06:00:00+00:00", "2019-04-22 05:00:00+00:00", "2019-04-22 04:00:00+00:00", "2019-04-22 03:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-22 00:00:00+00:00", "2019-04-21 23:00:00+00:00", "2019-04-21 22:00:00+00:00", "2019-04-21 21:00:00+00:00", "2019-04-21 20:00:00+00:00", "2019-04-21 19:00:00+00:00", "2019-04-21 18:00:00+00:00", "2019-04-21 17:00:00+00:00", "2019-04-21 16:00:00+00:00", "2019-04-21 15:00:00+00:00", "2019-04-21 14:00:00+00:00", "2019-04-21 13:00:00+00:00", "2019-04-21 12:00:00+00:00", "2019-04-21 11:00:00+00:00", "2019-04-21 10:00:00+00:00", "2019-04-21 09:00:00+00:00", "2019-04-21 08:00:00+00:00", "2019-04-21 07:00:00+00:00", "2019-04-21 06:00:00+00:00", "2019-04-21 05:00:00+00:00", "2019-04-21 04:00:00+00:00", "2019-04-21 03:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-21 00:00:00+00:00", "2019-04-20 23:00:00+00:00", "2019-04-20 22:00:00+00:00", "2019-04-20 21:00:00+00:00", "2019-04-20 20:00:00+00:00", "2019-04-20 19:00:00+00:00", "2019-04-20 18:00:00+00:00", "2019-04-20 17:00:00+00:00", "2019-04-20 16:00:00+00:00", "2019-04-20 15:00:00+00:00", "2019-04-20 14:00:00+00:00", "2019-04-20 13:00:00+00:00", "2019-04-20 12:00:00+00:00", "2019-04-20 11:00:00+00:00", "2019-04-20 10:00:00+00:00", "2019-04-20 09:00:00+00:00", "2019-04-20 08:00:00+00:00", "2019-04-20 07:00:00+00:00", "2019-04-20 06:00:00+00:00", "2019-04-20 05:00:00+00:00", "2019-04-20 04:00:00+00:00", "2019-04-20 03:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-20 00:00:00+00:00", "2019-04-19 23:00:00+00:00", "2019-04-19 22:00:00+00:00", "2019-04-19 21:00:00+00:00", "2019-04-19 20:00:00+00:00", "2019-04-19 19:00:00+00:00", "2019-04-19 18:00:00+00:00", "2019-04-19 17:00:00+00:00", "2019-04-19 16:00:00+00:00", "2019-04-19 15:00:00+00:00", "2019-04-19 14:00:00+00:00", "2019-04-19 13:00:00+00:00", "2019-04-19 12:00:00+00:00", "2019-04-19 11:00:00+00:00", "2019-04-19 10:00:00+00:00", "2019-04-19 09:00:00+00:00", "2019-04-19 08:00:00+00:00", "2019-04-19 07:00:00+00:00", "2019-04-19 06:00:00+00:00", "2019-04-19 05:00:00+00:00", "2019-04-19 04:00:00+00:00", "2019-04-19 03:00:00+00:00", "2019-04-19 02:00:00+00:00", "2019-04-19 00:00:00+00:00", "2019-04-18 23:00:00+00:00", "2019-04-18 22:00:00+00:00", "2019-04-18 21:00:00+00:00", "2019-04-18 20:00:00+00:00", "2019-04-18 19:00:00+00:00", "2019-04-18 18:00:00+00:00", "2019-04-18 17:00:00+00:00", "2019-04-18 16:00:00+00:00", "2019-04-18 15:00:00+00:00", "2019-04-18 14:00:00+00:00", "2019-04-18 13:00:00+00:00", "2019-04-18 12:00:00+00:00", "2019-04-18 11:00:00+00:00", "2019-04-18 10:00:00+00:00", "2019-04-18 09:00:00+00:00", "2019-04-18 08:00:00+00:00", "2019-04-18 07:00:00+00:00", "2019-04-18 06:00:00+00:00", "2019-04-18 05:00:00+00:00", "2019-04-18 04:00:00+00:00", "2019-04-18 03:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-18 00:00:00+00:00", "2019-04-17 23:00:00+00:00", "2019-04-17 22:00:00+00:00", "2019-04-17 21:00:00+00:00", "2019-04-17 20:00:00+00:00", "2019-04-17 19:00:00+00:00", "2019-04-17 18:00:00+00:00", "2019-04-17 17:00:00+00:00", "2019-04-17 16:00:00+00:00", "2019-04-17 15:00:00+00:00", "2019-04-17 14:00:00+00:00", "2019-04-17 13:00:00+00:00", "2019-04-17 12:00:00+00:00", "2019-04-17 11:00:00+00:00", "2019-04-17 10:00:00+00:00", "2019-04-17 09:00:00+00:00", "2019-04-17 08:00:00+00:00", "2019-04-17 07:00:00+00:00", "2019-04-17 06:00:00+00:00", "2019-04-17 05:00:00+00:00", "2019-04-17 04:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 00:00:00+00:00", "2019-04-16 23:00:00+00:00", "2019-04-16 22:00:00+00:00", "2019-04-16 21:00:00+00:00", "2019-04-16 20:00:00+00:00", "2019-04-16 19:00:00+00:00", "2019-04-16 18:00:00+00:00", "2019-04-16 17:00:00+00:00", "2019-04-16 15:00:00+00:00", "2019-04-16 14:00:00+00:00", "2019-04-16 13:00:00+00:00", "2019-04-16 12:00:00+00:00", "2019-04-16 11:00:00+00:00", "2019-04-16 10:00:00+00:00", "2019-04-16 09:00:00+00:00", "2019-04-16 08:00:00+00:00", "2019-04-16 07:00:00+00:00", "2019-04-16 06:00:00+00:00", "2019-04-16 05:00:00+00:00", "2019-04-16 04:00:00+00:00", "2019-04-16 03:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 00:00:00+00:00", "2019-04-15 23:00:00+00:00", "2019-04-15 22:00:00+00:00", "2019-04-15 21:00:00+00:00", "2019-04-15 20:00:00+00:00", "2019-04-15 19:00:00+00:00", "2019-04-15 18:00:00+00:00", "2019-04-15 17:00:00+00:00", "2019-04-15 16:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-15 00:00:00+00:00", "2019-04-14 23:00:00+00:00", "2019-04-14 22:00:00+00:00", "2019-04-14 21:00:00+00:00", "2019-04-14 20:00:00+00:00", "2019-04-14 19:00:00+00:00", "2019-04-14 18:00:00+00:00", "2019-04-14 17:00:00+00:00", "2019-04-14 16:00:00+00:00", "2019-04-14 15:00:00+00:00", "2019-04-14 14:00:00+00:00", "2019-04-14 13:00:00+00:00", "2019-04-14 12:00:00+00:00", "2019-04-14 11:00:00+00:00", "2019-04-14 10:00:00+00:00", "2019-04-14 09:00:00+00:00", "2019-04-14 08:00:00+00:00", "2019-04-14 07:00:00+00:00", "2019-04-14 06:00:00+00:00", "2019-04-14 05:00:00+00:00", "2019-04-14 04:00:00+00:00", "2019-04-14 03:00:00+00:00", "2019-04-14 02:00:00+00:00", "2019-04-14 01:00:00+00:00", "2019-04-14 00:00:00+00:00", "2019-04-13 23:00:00+00:00", "2019-04-13 22:00:00+00:00", "2019-04-13 21:00:00+00:00", "2019-04-13 20:00:00+00:00", "2019-04-13 19:00:00+00:00", "2019-04-13 18:00:00+00:00", "2019-04-13 17:00:00+00:00", "2019-04-13 16:00:00+00:00", "2019-04-13 15:00:00+00:00", "2019-04-13 14:00:00+00:00", "2019-04-13 13:00:00+00:00", "2019-04-13 12:00:00+00:00", "2019-04-13 11:00:00+00:00", "2019-04-13 10:00:00+00:00", "2019-04-13 09:00:00+00:00", "2019-04-13 08:00:00+00:00", "2019-04-13 07:00:00+00:00", "2019-04-13 06:00:00+00:00", "2019-04-13 05:00:00+00:00", "2019-04-13 04:00:00+00:00", "2019-04-13 03:00:00+00:00", "2019-04-13 02:00:00+00:00", "2019-04-13 01:00:00+00:00", "2019-04-13 00:00:00+00:00", "2019-04-12 23:00:00+00:00", "2019-04-12 22:00:00+00:00", "2019-04-12 21:00:00+00:00", "2019-04-12 20:00:00+00:00", "2019-04-12 19:00:00+00:00", "2019-04-12 18:00:00+00:00", "2019-04-12 17:00:00+00:00", "2019-04-12 16:00:00+00:00", "2019-04-12 15:00:00+00:00", "2019-04-12 14:00:00+00:00", "2019-04-12 13:00:00+00:00", "2019-04-12 12:00:00+00:00", "2019-04-12 11:00:00+00:00", "2019-04-12 10:00:00+00:00", "2019-04-12 09:00:00+00:00", "2019-04-12 08:00:00+00:00", "2019-04-12 07:00:00+00:00", "2019-04-12 06:00:00+00:00", "2019-04-12 05:00:00+00:00", "2019-04-12 04:00:00+00:00", "2019-04-12 03:00:00+00:00", "2019-04-12 00:00:00+00:00", "2019-04-11 23:00:00+00:00", "2019-04-11 22:00:00+00:00", "2019-04-11 21:00:00+00:00", "2019-04-11 20:00:00+00:00", "2019-04-11 19:00:00+00:00", "2019-04-11 18:00:00+00:00", "2019-04-11 17:00:00+00:00", "2019-04-11 16:00:00+00:00", "2019-04-11 15:00:00+00:00", "2019-04-11 14:00:00+00:00", "2019-04-11 13:00:00+00:00", "2019-04-11 12:00:00+00:00", "2019-04-11 11:00:00+00:00", "2019-04-11 10:00:00+00:00", "2019-04-11 09:00:00+00:00", "2019-04-11 08:00:00+00:00", "2019-04-11 07:00:00+00:00", "2019-04-11 06:00:00+00:00", "2019-04-11 05:00:00+00:00", "2019-04-11 04:00:00+00:00", "2019-04-11 03:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 00:00:00+00:00", "2019-04-10 23:00:00+00:00", "2019-04-10 22:00:00+00:00", "2019-04-10 21:00:00+00:00", "2019-04-10 20:00:00+00:00", "2019-04-10 19:00:00+00:00", "2019-04-10 18:00:00+00:00", "2019-04-10 17:00:00+00:00", "2019-04-10 16:00:00+00:00", "2019-04-10 15:00:00+00:00", "2019-04-10 14:00:00+00:00", "2019-04-10 13:00:00+00:00", "2019-04-10 12:00:00+00:00", "2019-04-10 11:00:00+00:00", "2019-04-10 10:00:00+00:00", "2019-04-10 09:00:00+00:00", "2019-04-10 08:00:00+00:00", "2019-04-10 07:00:00+00:00", "2019-04-10 06:00:00+00:00", "2019-04-10 05:00:00+00:00", "2019-04-10 04:00:00+00:00", "2019-04-10 03:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-10 00:00:00+00:00", "2019-04-09 23:00:00+00:00", "2019-04-09 22:00:00+00:00", "2019-04-09 21:00:00+00:00", "2019-04-09 20:00:00+00:00", "2019-04-09 19:00:00+00:00", "2019-04-09 18:00:00+00:00", "2019-04-09 17:00:00+00:00", "2019-04-09 16:00:00+00:00", "2019-04-09 15:00:00+00:00", "2019-04-09 14:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00",
-// 					},
-// 					"c367804c2f44cd55a157c97d086a1b146f7a1f5949e1fbff4a01762ff17466d8ffc389ce5dbf62437b7d1041aba3aa33919ba40b7528f92542538127a1fe73b0": {
-// 						"2019-06-18 06:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-15 01:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-13 01:00:00+00:00", "2019-06-12 01:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-08 01:00:00+00:00", "2019-06-06 01:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-24 01:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 01:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 01:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-01 02:00:00+00:00", "2019-05-01 01:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-28 02:00:00+00:00", "2019-04-28 01:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 01:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 01:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 01:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-19 01:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 01:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 01:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-12 02:00:00+00:00", "2019-04-12 01:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 01:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00", "2019-04-09 01:00:00+00:00",
-// 					},
-// 					"6e2143717dc23a5087b440b39ee01182896c37449603628e1e2f67c3e80b7eefe9a84fdb3a664a7106120dd9fcc26a5ba3460610b41a1fa085f61fee971e1b1b": {
-// 						"2019-06-21 00:00:00+00:00", "2019-06-20 23:00:00+00:00", "2019-06-20 22:00:00+00:00", "2019-06-20 21:00:00+00:00", "2019-06-20 20:00:00+00:00", "2019-06-20 19:00:00+00:00", "2019-06-20 18:00:00+00:00", "2019-06-20 17:00:00+00:00", "2019-06-20 16:00:00+00:00", "2019-06-20 15:00:00+00:00", "2019-06-19 13:00:00+00:00", "2019-06-19 12:00:00+00:00", "2019-06-19 11:00:00+00:00", "2019-06-19 00:00:00+00:00", "2019-06-18 23:00:00+00:00", "2019-06-18 22:00:00+00:00", "2019-06-18 21:00:00+00:00", "2019-06-18 11:00:00+00:00", "2019-06-18 10:00:00+00:00", "2019-06-18 09:00:00+00:00", "2019-06-18 08:00:00+00:00", "2019-06-18 07:00:00+00:00", "2019-06-18 06:00:00+00:00", "2019-06-18 05:00:00+00:00", "2019-06-18 04:00:00+00:00", "2019-06-18 03:00:00+00:00", "2019-06-18 02:00:00+00:00", "2019-06-18 00:00:00+00:00", "2019-06-17 23:00:00+00:00", "2019-06-17 22:00:00+00:00", "2019-06-17 21:00:00+00:00", "2019-06-17 20:00:00+00:00", "2019-06-17 19:00:00+00:00", "2019-06-17 18:00:00+00:00", "2019-06-17 17:00:00+00:00", "2019-06-17 16:00:00+00:00", "2019-06-17 15:00:00+00:00", "2019-06-17 14:00:00+00:00", "2019-06-17 13:00:00+00:00", "2019-06-17 12:00:00+00:00", "2019-06-17 11:00:00+00:00", "2019-06-17 10:00:00+00:00", "2019-06-17 09:00:00+00:00", "2019-06-17 08:00:00+00:00", "2019-06-17 07:00:00+00:00", "2019-06-17 06:00:00+00:00", "2019-06-17 05:00:00+00:00", "2019-06-17 04:00:00+00:00", "2019-06-17 03:00:00+00:00", "2019-06-17 02:00:00+00:00", "2019-06-17 01:00:00+00:00", "2019-06-17 00:00:00+00:00", "2019-06-16 23:00:00+00:00", "2019-06-16 21:00:00+00:00", "2019-06-16 20:00:00+00:00", "2019-06-16 19:00:00+00:00", "2019-06-16 18:00:00+00:00", "2019-06-16 17:00:00+00:00", "2019-06-16 16:00:00+00:00", "2019-06-16 15:00:00+00:00", "2019-06-16 14:00:00+00:00", "2019-06-16 13:00:00+00:00", "2019-06-16 12:00:00+00:00", "2019-06-16 11:00:00+00:00", "2019-06-16 10:00:00+00:00", "2019-06-16 09:00:00+00:00", "2019-06-16 08:00:00+00:00", "2019-06-16 07:00:00+00:00", "2019-06-16 06:00:00+00:00", "2019-06-16 05:00:00+00:00", "2019-06-16 04:00:00+00:00", "2019-06-16 03:00:00+00:00", "2019-06-16 02:00:00+00:00", "2019-06-16 01:00:00+00:00", "2019-06-16 00:00:00+00:00", "2019-06-15 23:00:00+00:00", "2019-06-15 22:00:00+00:00", "2019-06-15 21:00:00+00:00", "2019-06-15 20:00:00+00:00", "2019-06-15 19:00:00+00:00", "2019-06-15 18:00:00+00:00", "2019-06-15 17:00:00+00:00", "2019-06-15 16:00:00+00:00", "2019-06-15 15:00:00+00:00", "2019-06-15 14:00:00+00:00", "2019-06-15 13:00:00+00:00", "2019-06-15 12:00:00+00:00", "2019-06-15 11:00:00+00:00", "2019-06-15 10:00:00+00:00", "2019-06-15 09:00:00+00:00", "2019-06-15 08:00:00+00:00", "2019-06-15 07:00:00+00:00", "2019-06-15 06:00:00+00:00", "2019-06-15 05:00:00+00:00", "2019-06-15 04:00:00+00:00", "2019-06-15 00:00:00+00:00", "2019-06-14 23:00:00+00:00", "2019-06-14 22:00:00+00:00", "2019-06-14 21:00:00+00:00", "2019-06-14 20:00:00+00:00", "2019-06-14 19:00:00+00:00", "2019-06-14 18:00:00+00:00", "2019-06-14 17:00:00+00:00", "2019-06-14 16:00:00+00:00", "2019-06-14 15:00:00+00:00", "2019-06-14 14:00:00+00:00", "2019-06-14 13:00:00+00:00", "2019-06-14 12:00:00+00:00", "2019-06-14 11:00:00+00:00", "2019-06-14 10:00:00+00:00", "2019-06-14 09:00:00+00:00", "2019-06-14 08:00:00+00:00", "2019-06-14 07:00:00+00:00", "2019-06-14 06:00:00+00:00", "2019-06-14 05:00:00+00:00", "2019-06-14 04:00:00+00:00", "2019-06-14 03:00:00+00:00", "2019-06-14 02:00:00+00:00", "2019-06-14 00:00:00+00:00", "2019-06-13 23:00:00+00:00", "2019-06-13 22:00:00+00:00", "2019-06-13 21:00:00+00:00", "2019-06-13 20:00:00+00:00", "2019-06-13 19:00:00+00:00", "2019-06-13 18:00:00+00:00", "2019-06-13 17:00:00+00:00", "2019-06-13 16:00:00+00:00", "2019-06-13 15:00:00+00:00", "2019-06-13 14:00:00+00:00", "2019-06-13 13:00:00+00:00", "2019-06-13 12:00:00+00:00", "2019-06-13 11:00:00+00:00", "2019-06-13 10:00:00+00:00", "2019-06-13 09:00:00+00:00", "2019-06-13 08:00:00+00:00", "2019-06-13 07:00:00+00:00", "2019-06-13 06:00:00+00:00", "2019-06-13 05:00:00+00:00", "2019-06-13 04:00:00+00:00", "2019-06-13 03:00:00+00:00", "2019-06-13 02:00:00+00:00", "2019-06-13 00:00:00+00:00", "2019-06-12 23:00:00+00:00", "2019-06-12 21:00:00+00:00", "2019-06-12 20:00:00+00:00", "2019-06-12 19:00:00+00:00", "2019-06-12 18:00:00+00:00", "2019-06-12 17:00:00+00:00", "2019-06-12 16:00:00+00:00", "2019-06-12 15:00:00+00:00", "2019-06-12 14:00:00+00:00", "2019-06-12 13:00:00+00:00", "2019-06-12 12:00:00+00:00", "2019-06-12 11:00:00+00:00", "2019-06-12 10:00:00+00:00", "2019-06-12 09:00:00+00:00", "2019-06-12 08:00:00+00:00", "2019-06-12 07:00:00+00:00", "2019-06-12 06:00:00+00:00", "2019-06-12 05:00:00+00:00", "2019-06-12 04:00:00+00:00", "2019-06-12 03:00:00+00:00", "2019-06-12 00:00:00+00:00", "2019-06-11 23:00:00+00:00", "2019-06-11 22:00:00+00:00", "2019-06-11 21:00:00+00:00", "2019-06-11 20:00:00+00:00", "2019-06-11 19:00:00+00:00", "2019-06-11 18:00:00+00:00", "2019-06-11 17:00:00+00:00", "2019-06-11 16:00:00+00:00", "2019-06-11 15:00:00+00:00", "2019-06-11 14:00:00+00:00", "2019-06-11 13:00:00+00:00", "2019-06-11 12:00:00+00:00", "2019-06-11 11:00:00+00:00", "2019-06-11 10:00:00+00:00", "2019-06-11 09:00:00+00:00", "2019-06-11 08:00:00+00:00", "2019-06-11 07:00:00+00:00", "2019-06-11 06:00:00+00:00", "2019-06-11 05:00:00+00:00", "2019-06-11 04:00:00+00:00", "2019-06-11 03:00:00+00:00", "2019-06-11 02:00:00+00:00", "2019-06-11 01:00:00+00:00", "2019-06-11 00:00:00+00:00", "2019-06-10 23:00:00+00:00", "2019-06-10 22:00:00+00:00", "2019-06-10 21:00:00+00:00", "2019-06-10 20:00:00+00:00", "2019-06-10 19:00:00+00:00", "2019-06-10 18:00:00+00:00", "2019-06-10 17:00:00+00:00", "2019-06-10 16:00:00+00:00", "2019-06-10 15:00:00+00:00", "2019-06-10 14:00:00+00:00", "2019-06-10 13:00:00+00:00", "2019-06-10 12:00:00+00:00", "2019-06-10 11:00:00+00:00", "2019-06-10 10:00:00+00:00", "2019-06-10 09:00:00+00:00", "2019-06-10 08:00:00+00:00", "2019-06-10 07:00:00+00:00", "2019-06-10 06:00:00+00:00", "2019-06-10 05:00:00+00:00", "2019-06-10 04:00:00+00:00", "2019-06-10 03:00:00+00:00", "2019-06-10 02:00:00+00:00", "2019-06-10 01:00:00+00:00", "2019-06-10 00:00:00+00:00", "2019-06-09 23:00:00+00:00", "2019-06-09 21:00:00+00:00", "2019-06-09 20:00:00+00:00", "2019-06-09 19:00:00+00:00", "2019-06-09 18:00:00+00:00", "2019-06-09 17:00:00+00:00", "2019-06-09 16:00:00+00:00", "2019-06-09 15:00:00+00:00", "2019-06-09 14:00:00+00:00", "2019-06-09 13:00:00+00:00", "2019-06-09 12:00:00+00:00", "2019-06-09 11:00:00+00:00", "2019-06-09 10:00:00+00:00", "2019-06-09 09:00:00+00:00", "2019-06-09 08:00:00+00:00", "2019-06-09 07:00:00+00:00", "2019-06-09 06:00:00+00:00", "2019-06-09 05:00:00+00:00", "2019-06-09 04:00:00+00:00", "2019-06-09 03:00:00+00:00", "2019-06-09 02:00:00+00:00", "2019-06-09 01:00:00+00:00", "2019-06-09 00:00:00+00:00", "2019-06-08 23:00:00+00:00", "2019-06-08 21:00:00+00:00", "2019-06-08 20:00:00+00:00", "2019-06-08 19:00:00+00:00", "2019-06-08 18:00:00+00:00", "2019-06-08 17:00:00+00:00", "2019-06-08 16:00:00+00:00", "2019-06-08 15:00:00+00:00", "2019-06-08 14:00:00+00:00", "2019-06-08 13:00:00+00:00", "2019-06-08 12:00:00+00:00", "2019-06-08 11:00:00+00:00", "2019-06-08 10:00:00+00:00", "2019-06-08 09:00:00+00:00", "2019-06-08 08:00:00+00:00", "2019-06-08 07:00:00+00:00", "2019-06-08 06:00:00+00:00", "2019-06-08 05:00:00+00:00", "2019-06-08 04:00:00+00:00", "2019-06-08 03:00:00+00:00", "2019-06-08 02:00:00+00:00", "2019-06-08 00:00:00+00:00", "2019-06-07 23:00:00+00:00", "2019-06-07 21:00:00+00:00", "2019-06-07 20:00:00+00:00", "2019-06-07 19:00:00+00:00", "2019-06-07 18:00:00+00:00", "2019-06-07 17:00:00+00:00", "2019-06-07 16:00:00+00:00", "2019-06-07 15:00:00+00:00", "2019-06-07 14:00:00+00:00", "2019-06-07 13:00:00+00:00", "2019-06-07 12:00:00+00:00", "2019-06-07 11:00:00+00:00", "2019-06-07 10:00:00+00:00", "2019-06-07 09:00:00+00:00", "2019-06-07 08:00:00+00:00", "2019-06-07 07:00:00+00:00", "2019-06-07 06:00:00+00:00", "2019-06-07 05:00:00+00:00", "2019-06-07 04:00:00+00:00", "2019-06-07 03:00:00+00:00", "2019-06-07 02:00:00+00:00", "2019-06-07 01:00:00+00:00", "2019-06-07 00:00:00+00:00", "2019-06-06 23:00:00+00:00", "2019-06-06 22:00:00+00:00", "2019-06-06 21:00:00+00:00", "2019-06-06 20:00:00+00:00", "2019-06-06 19:00:00+00:00", "2019-06-06 18:00:00+00:00", "2019-06-06 17:00:00+00:00", "2019-06-06 16:00:00+00:00", "2019-06-06 15:00:00+00:00", "2019-06-06 14:00:00+00:00", "2019-06-06 13:00:00+00:00", "2019-06-06 12:00:00+00:00", "2019-06-06 11:00:00+00:00", "2019-06-06 10:00:00+00:00", "2019-06-06 09:00:00+00:00", "2019-06-06 08:00:00+00:00", "2019-06-06 07:00:00+00:00", "2019-06-06 06:00:00+00:00", "2019-06-06 05:00:00+00:00", "2019-06-06 04:00:00+00:00", "2019-06-06 03:00:00+00:00", "2019-06-06 02:00:00+00:00", "2019-06-06 00:00:00+00:00", "2019-06-05 23:00:00+00:00", "2019-06-05 22:00:00+00:00", "2019-06-05 21:00:00+00:00", "2019-06-05 20:00:00+00:00", "2019-06-05 19:00:00+00:00", "2019-06-05 18:00:00+00:00", "2019-06-05 17:00:00+00:00", "2019-06-05 16:00:00+00:00", "2019-06-05 15:00:00+00:00", "2019-06-05 14:00:00+00:00", "2019-06-05 13:00:00+00:00", "2019-06-05 12:00:00+00:00", "2019-06-05 11:00:00+00:00", "2019-06-05 10:00:00+00:00", "2019-06-05 09:00:00+00:00", "2019-06-05 08:00:00+00:00", "2019-06-05 07:00:00+00:00", "2019-06-05 06:00:00+00:00", "2019-06-05 05:00:00+00:00", "2019-06-05 04:00:00+00:00", "2019-06-05 03:00:00+00:00", "2019-06-05 02:00:00+00:00", "2019-06-05 01:00:00+00:00", "2019-06-05 00:00:00+00:00", "2019-06-04 23:00:00+00:00", "2019-06-04 22:00:00+00:00", "2019-06-04 21:00:00+00:00", "2019-06-04 20:00:00+00:00", "2019-06-04 19:00:00+00:00", "2019-06-04 18:00:00+00:00", "2019-06-04 17:00:00+00:00", "2019-06-04 16:00:00+00:00", "2019-06-04 15:00:00+00:00", "2019-06-04 14:00:00+00:00", "2019-06-04 13:00:00+00:00", "2019-06-04 12:00:00+00:00", "2019-06-04 11:00:00+00:00", "2019-06-04 10:00:00+00:00", "2019-06-04 09:00:00+00:00", "2019-06-04 08:00:00+00:00", "2019-06-04 07:00:00+00:00", "2019-06-04 06:00:00+00:00", "2019-06-04 05:00:00+00:00", "2019-06-04 04:00:00+00:00", "2019-06-04 03:00:00+00:00", "2019-06-04 02:00:00+00:00", "2019-06-04 01:00:00+00:00", "2019-06-04 00:00:00+00:00", "2019-06-03 23:00:00+00:00", "2019-06-03 22:00:00+00:00", "2019-06-03 21:00:00+00:00", "2019-06-03 20:00:00+00:00", "2019-06-03 19:00:00+00:00", "2019-06-03 18:00:00+00:00", "2019-06-03 17:00:00+00:00", "2019-06-03 16:00:00+00:00", "2019-06-03 15:00:00+00:00", "2019-06-03 14:00:00+00:00", "2019-06-03 13:00:00+00:00", "2019-06-03 12:00:00+00:00", "2019-06-03 11:00:00+00:00", "2019-06-03 10:00:00+00:00", "2019-06-03 09:00:00+00:00", "2019-06-03 08:00:00+00:00", "2019-06-03 07:00:00+00:00", "2019-06-03 06:00:00+00:00", "2019-06-03 05:00:00+00:00", "2019-06-03 04:00:00+00:00", "2019-06-03 03:00:00+00:00", "2019-06-03 02:00:00+00:00", "2019-06-03 01:00:00+00:00", "2019-06-03 00:00:00+00:00", "2019-06-02 23:00:00+00:00", "2019-06-02 22:00:00+00:00", "2019-06-02 21:00:00+00:00", "2019-06-02 20:00:00+00:00", "2019-06-02 19:00:00+00:00", "2019-06-02 18:00:00+00:00", "2019-06-02 17:00:00+00:00", "2019-06-02 16:00:00+00:00", "2019-06-02 15:00:00+00:00", "2019-06-02 14:00:00+00:00", "2019-06-02 13:00:00+00:00", "2019-06-02 12:00:00+00:00", "2019-06-02 11:00:00+00:00", "2019-06-02 10:00:00+00:00", "2019-06-02 09:00:00+00:00", "2019-06-02 08:00:00+00:00", "2019-06-02 07:00:00+00:00", "2019-06-02 06:00:00+00:00", "2019-06-02 05:00:00+00:00", "2019-06-02 04:00:00+00:00", "2019-06-02 03:00:00+00:00", "2019-06-02 02:00:00+00:00", "2019-06-02 01:00:00+00:00", "2019-06-02 00:00:00+00:00", "2019-06-01 23:00:00+00:00", "2019-06-01 22:00:00+00:00", "2019-06-01 21:00:00+00:00", "2019-06-01 20:00:00+00:00", "2019-06-01 19:00:00+00:00", "2019-06-01 18:00:00+00:00", "2019-06-01 17:00:00+00:00", "2019-06-01 16:00:00+00:00", "2019-06-01 15:00:00+00:00", "2019-06-01 14:00:00+00:00", "2019-06-01 13:00:00+00:00", "2019-06-01 12:00:00+00:00", "2019-06-01 11:00:00+00:00", "2019-06-01 10:00:00+00:00", "2019-06-01 09:00:00+00:00", "2019-06-01 08:00:00+00:00", "2019-06-01 07:00:00+00:00", "2019-06-01 06:00:00+00:00", "2019-06-01 05:00:00+00:00", "2019-06-01 04:00:00+00:00", "2019-06-01 03:00:00+00:00", "2019-06-01 02:00:00+00:00", "2019-06-01 01:00:00+00:00", "2019-06-01 00:00:00+00:00", "2019-05-31 23:00:00+00:00", "2019-05-31 22:00:00+00:00", "2019-05-31 21:00:00+00:00", "2019-05-31 20:00:00+00:00", "2019-05-31 19:00:00+00:00", "2019-05-31 18:00:00+00:00", "2019-05-31 17:00:00+00:00", "2019-05-31 16:00:00+00:00", "2019-05-31 15:00:00+00:00", "2019-05-31 14:00:00+00:00", "2019-05-31 13:00:00+00:00", "2019-05-31 12:00:00+00:00", "2019-05-31 11:00:00+00:00", "2019-05-31 10:00:00+00:00", "2019-05-31 09:00:00+00:00", "2019-05-31 08:00:00+00:00", "2019-05-31 07:00:00+00:00", "2019-05-31 06:00:00+00:00", "2019-05-31 05:00:00+00:00", "2019-05-31 04:00:00+00:00", "2019-05-31 03:00:00+00:00", "2019-05-31 02:00:00+00:00", "2019-05-31 01:00:00+00:00", "2019-05-31 00:00:00+00:00", "2019-05-30 23:00:00+00:00", "2019-05-30 22:00:00+00:00", "2019-05-30 21:00:00+00:00", "2019-05-30 20:00:00+00:00", "2019-05-30 19:00:00+00:00", "2019-05-30 18:00:00+00:00", "2019-05-30 17:00:00+00:00", "2019-05-30 16:00:00+00:00", "2019-05-30 15:00:00+00:00", "2019-05-30 14:00:00+00:00", "2019-05-30 13:00:00+00:00", "2019-05-30 12:00:00+00:00", "2019-05-30 11:00:00+00:00", "2019-05-30 10:00:00+00:00", "2019-05-30 09:00:00+00:00", "2019-05-30 08:00:00+00:00", "2019-05-30 07:00:00+00:00", "2019-05-30 06:00:00+00:00", "2019-05-30 05:00:00+00:00", "2019-05-30 04:00:00+00:00", "2019-05-30 03:00:00+00:00", "2019-05-30 02:00:00+00:00", "2019-05-30 01:00:00+00:00", "2019-05-30 00:00:00+00:00", "2019-05-29 23:00:00+00:00", "2019-05-29 22:00:00+00:00", "2019-05-29 21:00:00+00:00", "2019-05-29 20:00:00+00:00", "2019-05-29 19:00:00+00:00", "2019-05-29 18:00:00+00:00", "2019-05-29 17:00:00+00:00", "2019-05-29 16:00:00+00:00", "2019-05-29 15:00:00+00:00", "2019-05-29 14:00:00+00:00", "2019-05-29 13:00:00+00:00", "2019-05-29 12:00:00+00:00", "2019-05-29 11:00:00+00:00", "2019-05-29 10:00:00+00:00", "2019-05-29 09:00:00+00:00", "2019-05-29 08:00:00+00:00", "2019-05-29 07:00:00+00:00", "2019-05-29 06:00:00+00:00", "2019-05-29 05:00:00+00:00", "2019-05-29 04:00:00+00:00", "2019-05-29 03:00:00+00:00", "2019-05-29 02:00:00+00:00", "2019-05-29 01:00:00+00:00", "2019-05-29 00:00:00+00:00", "2019-05-28 23:00:00+00:00", "2019-05-28 21:00:00+00:00", "2019-05-28 20:00:00+00:00", "2019-05-28 19:00:00+00:00", "2019-05-28 18:00:00+00:00", "2019-05-28 17:00:00+00:00", "2019-05-28 16:00:00+00:00", "2019-05-28 15:00:00+00:00", "2019-05-28 14:00:00+00:00", "2019-05-28 13:00:00+00:00", "2019-05-28 12:00:00+00:00", "2019-05-28 11:00:00+00:00", "2019-05-28 10:00:00+00:00", "2019-05-28 09:00:00+00:00", "2019-05-28 08:00:00+00:00", "2019-05-28 07:00:00+00:00", "2019-05-28 06:00:00+00:00", "2019-05-28 05:00:00+00:00", "2019-05-28 04:00:00+00:00", "2019-05-28 03:00:00+00:00", "2019-05-28 02:00:00+00:00", "2019-05-28 01:00:00+00:00", "2019-05-28 00:00:00+00:00", "2019-05-27 23:00:00+00:00", "2019-05-27 22:00:00+00:00", "2019-05-27 21:00:00+00:00", "2019-05-27 20:00:00+00:00", "2019-05-27 19:00:00+00:00", "2019-05-27 18:00:00+00:00", "2019-05-27 17:00:00+00:00", "2019-05-27 16:00:00+00:00", "2019-05-27 15:00:00+00:00", "2019-05-27 14:00:00+00:00", "2019-05-27 13:00:00+00:00", "2019-05-27 12:00:00+00:00", "2019-05-27 11:00:00+00:00", "2019-05-27 10:00:00+00:00", "2019-05-27 09:00:00+00:00", "2019-05-27 08:00:00+00:00", "2019-05-27 07:00:00+00:00", "2019-05-27 06:00:00+00:00", "2019-05-27 05:00:00+00:00", "2019-05-27 04:00:00+00:00", "2019-05-27 03:00:00+00:00", "2019-05-27 02:00:00+00:00", "2019-05-27 01:00:00+00:00", "2019-05-27 00:00:00+00:00", "2019-05-26 23:00:00+00:00", "2019-05-26 22:00:00+00:00", "2019-05-26 21:00:00+00:00", "2019-05-26 20:00:00+00:00", "2019-05-26 19:00:00+00:00", "2019-05-26 18:00:00+00:00", "2019-05-26 17:00:00+00:00", "2019-05-26 16:00:00+00:00", "2019-05-26 15:00:00+00:00", "2019-05-26 14:00:00+00:00", "2019-05-26 13:00:00+00:00", "2019-05-26 12:00:00+00:00", "2019-05-26 11:00:00+00:00", "2019-05-26 10:00:00+00:00", "2019-05-26 09:00:00+00:00", "2019-05-26 08:00:00+00:00", "2019-05-26 07:00:00+00:00", "2019-05-26 06:00:00+00:00", "2019-05-26 05:00:00+00:00", "2019-05-26 04:00:00+00:00", "2019-05-26 03:00:00+00:00", "2019-05-26 02:00:00+00:00", "2019-05-26 01:00:00+00:00", "2019-05-26 00:00:00+00:00", "2019-05-25 23:00:00+00:00", "2019-05-25 22:00:00+00:00", "2019-05-25 21:00:00+00:00", "2019-05-25 20:00:00+00:00", "2019-05-25 19:00:00+00:00", "2019-05-25 18:00:00+00:00", "2019-05-25 17:00:00+00:00", "2019-05-25 16:00:00+00:00", "2019-05-25 15:00:00+00:00", "2019-05-25 14:00:00+00:00", "2019-05-25 13:00:00+00:00", "2019-05-25 12:00:00+00:00", "2019-05-25 11:00:00+00:00", "2019-05-25 10:00:00+00:00", "2019-05-25 09:00:00+00:00", "2019-05-25 08:00:00+00:00", "2019-05-25 07:00:00+00:00", "2019-05-25 06:00:00+00:00", "2019-05-25 05:00:00+00:00", "2019-05-25 04:00:00+00:00", "2019-05-25 03:00:00+00:00", "2019-05-25 02:00:00+00:00", "2019-05-25 01:00:00+00:00", "2019-05-25 00:00:00+00:00", "2019-05-24 23:00:00+00:00", "2019-05-24 22:00:00+00:00", "2019-05-24 21:00:00+00:00", "2019-05-24 20:00:00+00:00", "2019-05-24 19:00:00+00:00", "2019-05-24 18:00:00+00:00", "2019-05-24 17:00:00+00:00", "2019-05-24 16:00:00+00:00", "2019-05-24 15:00:00+00:00", "2019-05-24 14:00:00+00:00", "2019-05-24 13:00:00+00:00", "2019-05-24 12:00:00+00:00", "2019-05-24 11:00:00+00:00", "2019-05-24 10:00:00+00:00", "2019-05-24 09:00:00+00:00", "2019-05-24 08:00:00+00:00", "2019-05-24 07:00:00+00:00", "2019-05-24 06:00:00+00:00", "2019-05-24 05:00:00+00:00", "2019-05-24 04:00:00+00:00", "2019-05-24 03:00:00+00:00", "2019-05-24 02:00:00+00:00", "2019-05-24 00:00:00+00:00", "2019-05-23 23:00:00+00:00", "2019-05-23 22:00:00+00:00", "2019-05-23 21:00:00+00:00", "2019-05-23 20:00:00+00:00", "2019-05-23 19:00:00+00:00", "2019-05-23 18:00:00+00:00", "2019-05-23 17:00:00+00:00", "2019-05-23 16:00:00+00:00", "2019-05-23 15:00:00+00:00", "2019-05-23 14:00:00+00:00", "2019-05-23 13:00:00+00:00", "2019-05-23 12:00:00+00:00", "2019-05-23 11:00:00+00:00", "2019-05-23 10:00:00+00:00", "2019-05-23 09:00:00+00:00", "2019-05-23 08:00:00+00:00", "2019-05-23 07:00:00+00:00", "2019-05-23 06:00:00+00:00", "2019-05-23 05:00:00+00:00", "2019-05-23 04:00:00+00:00", "2019-05-23 03:00:00+00:00", "2019-05-23 02:00:00+00:00", "2019-05-23 01:00:00+00:00", "2019-05-23 00:00:00+00:00", "2019-05-22 23:00:00+00:00", "2019-05-22 22:00:00+00:00", "2019-05-22 21:00:00+00:00", "2019-05-22 20:00:00+00:00", "2019-05-22 19:00:00+00:00", "2019-05-22 18:00:00+00:00", "2019-05-22 17:00:00+00:00", "2019-05-22 16:00:00+00:00", "2019-05-22 15:00:00+00:00", "2019-05-22 14:00:00+00:00", "2019-05-22 13:00:00+00:00", "2019-05-22 12:00:00+00:00", "2019-05-22 11:00:00+00:00", "2019-05-22 10:00:00+00:00", "2019-05-22 09:00:00+00:00", "2019-05-22 08:00:00+00:00", "2019-05-22 07:00:00+00:00", "2019-05-22 06:00:00+00:00", "2019-05-22 05:00:00+00:00", "2019-05-22 04:00:00+00:00", "2019-05-22 03:00:00+00:00", "2019-05-22 02:00:00+00:00", "2019-05-22 01:00:00+00:00", "2019-05-22 00:00:00+00:00", "2019-05-21 23:00:00+00:00", "2019-05-21 22:00:00+00:00", "2019-05-21 21:00:00+00:00", "2019-05-21 20:00:00+00:00", "2019-05-21 19:00:00+00:00", "2019-05-21 18:00:00+00:00", "2019-05-21 17:00:00+00:00", "2019-05-21 16:00:00+00:00", "2019-05-21 15:00:00+00:00", "2019-05-21 14:00:00+00:00", "2019-05-21 13:00:00+00:00", "2019-05-21 12:00:00+00:00", "2019-05-21 11:00:00+00:00", "2019-05-21 10:00:00+00:00", "2019-05-21 09:00:00+00:00", "2019-05-21 08:00:00+00:00", "2019-05-21 07:00:00+00:00", "2019-05-21 06:00:00+00:00", "2019-05-21 05:00:00+00:00", "2019-05-21 04:00:00+00:00", "2019-05-21 03:00:00+00:00", "2019-05-21 02:00:00+00:00", "2019-05-21 01:00:00+00:00", "2019-05-21 00:00:00+00:00", "2019-05-20 23:00:00+00:00", "2019-05-20 22:00:00+00:00", "2019-05-20 21:00:00+00:00", "2019-05-20 20:00:00+00:00", "2019-05-20 19:00:00+00:00", "2019-05-20 18:00:00+00:00", "2019-05-20 17:00:00+00:00", "2019-05-20 16:00:00+00:00", "2019-05-20 15:00:00+00:00", "2019-05-20 14:00:00+00:00", "2019-05-20 13:00:00+00:00", "2019-05-20 12:00:00+00:00", "2019-05-20 11:00:00+00:00", "2019-05-20 10:00:00+00:00", "2019-05-20 09:00:00+00:00", "2019-05-20 08:00:00+00:00", "2019-05-20 07:00:00+00:00", "2019-05-20 06:00:00+00:00", "2019-05-20 05:00:00+00:00", "2019-05-20 04:00:00+00:00", "2019-05-20 03:00:00+00:00", "2019-05-20 02:00:00+00:00", "2019-05-20 01:00:00+00:00", "2019-05-20 00:00:00+00:00", "2019-05-19 23:00:00+00:00", "2019-05-19 22:00:00+00:00", "2019-05-19 21:00:00+00:00", "2019-05-19 20:00:00+00:00", "2019-05-19 19:00:00+00:00", "2019-05-19 18:00:00+00:00", "2019-05-19 17:00:00+00:00", "2019-05-19 16:00:00+00:00", "2019-05-19 15:00:00+00:00", "2019-05-19 14:00:00+00:00", "2019-05-19 13:00:00+00:00", "2019-05-19 12:00:00+00:00", "2019-05-19 11:00:00+00:00", "2019-05-19 10:00:00+00:00", "2019-05-19 09:00:00+00:00", "2019-05-19 08:00:00+00:00", "2019-05-19 07:00:00+00:00", "2019-05-19 06:00:00+00:00", "2019-05-19 05:00:00+00:00", "2019-05-19 04:00:00+00:00", "2019-05-19 03:00:00+00:00", "2019-05-19 02:00:00+00:00", "2019-05-19 01:00:00+00:00", "2019-05-19 00:00:00+00:00", "2019-05-18 23:00:00+00:00", "2019-05-18 22:00:00+00:00", "2019-05-18 21:00:00+00:00", "2019-05-18 20:00:00+00:00", "2019-05-18 19:00:00+00:00", "2019-05-18 18:00:00+00:00", "2019-05-18 17:00:00+00:00", "2019-05-18 16:00:00+00:00", "2019-05-18 15:00:00+00:00", "2019-05-18 14:00:00+00:00", "2019-05-18 13:00:00+00:00", "2019-05-18 12:00:00+00:00", "2019-05-18 11:00:00+00:00", "2019-05-18 10:00:00+00:00", "2019-05-18 09:00:00+00:00", "2019-05-18 08:00:00+00:00", "2019-05-18 07:00:00+00:00", "2019-05-18 06:00:00+00:00", "2019-05-18 05:00:00+00:00", "2019-05-18 04:00:00+00:00", "2019-05-18 03:00:00+00:00", "2019-05-18 02:00:00+00:00", "2019-05-18 01:00:00+00:00", "2019-05-18 00:00:00+00:00", "2019-05-17 23:00:00+00:00", "2019-05-17 22:00:00+00:00", "2019-05-17 21:00:00+00:00", "2019-05-17 20:00:00+00:00", "2019-05-17 19:00:00+00:00", "2019-05-17 18:00:00+00:00", "2019-05-17 17:00:00+00:00", "2019-05-17 16:00:00+00:00", "2019-05-17 15:00:00+00:00", "2019-05-17 14:00:00+00:00", "2019-05-17 13:00:00+00:00", "2019-05-17 12:00:00+00:00", "2019-05-17 11:00:00+00:00", "2019-05-17 10:00:00+00:00", "2019-05-17 09:00:00+00:00", "2019-05-17 08:00:00+00:00", "2019-05-17 07:00:00+00:00", "2019-05-17 06:00:00+00:00", "2019-05-17 05:00:00+00:00", "2019-05-17 04:00:00+00:00", "2019-05-17 03:00:00+00:00", "2019-05-17 02:00:00+00:00", "2019-05-17 01:00:00+00:00", "2019-05-17 00:00:00+00:00", "2019-05-16 23:00:00+00:00", "2019-05-16 22:00:00+00:00", "2019-05-16 21:00:00+00:00", "2019-05-16 20:00:00+00:00", "2019-05-16 19:00:00+00:00", "2019-05-16 18:00:00+00:00", "2019-05-16 17:00:00+00:00", "2019-05-16 16:00:00+00:00", "2019-05-16 15:00:00+00:00", "2019-05-16 14:00:00+00:00", "2019-05-16 13:00:00+00:00", "2019-05-16 12:00:00+00:00", "2019-05-16 11:00:00+00:00", "2019-05-16 10:00:00+00:00", "2019-05-16 09:00:00+00:00", "2019-05-16 08:00:00+00:00", "2019-05-16 07:00:00+00:00", "2019-05-16 06:00:00+00:00", "2019-05-16 05:00:00+00:00", "2019-05-16 04:00:00+00:00", "2019-05-16 03:00:00+00:00", "2019-05-16 02:00:00+00:00", "2019-05-16 01:00:00+00:00", "2019-05-16 00:00:00+00:00", "2019-05-15 23:00:00+00:00", "2019-05-15 22:00:00+00:00", "2019-05-15 21:00:00+00:00", "2019-05-15 20:00:00+00:00", "2019-05-15 19:00:00+00:00", "2019-05-15 18:00:00+00:00", "2019-05-15 17:00:00+00:00", "2019-05-15 16:00:00+00:00", "2019-05-15 15:00:00+00:00", "2019-05-15 14:00:00+00:00", "2019-05-15 13:00:00+00:00", "2019-05-15 12:00:00+00:00", "2019-05-15 11:00:00+00:00", "2019-05-15 10:00:00+00:00", "2019-05-15 09:00:00+00:00", "2019-05-15 08:00:00+00:00", "2019-05-15 07:00:00+00:00", "2019-05-15 06:00:00+00:00", "2019-05-15 05:00:00+00:00", "2019-05-15 04:00:00+00:00", "2019-05-15 03:00:00+00:00", "2019-05-15 02:00:00+00:00", "2019-05-15 00:00:00+00:00", "2019-05-14 23:00:00+00:00", "2019-05-14 22:00:00+00:00", "2019-05-14 21:00:00+00:00", "2019-05-14 20:00:00+00:00", "2019-05-14 19:00:00+00:00", "2019-05-14 18:00:00+00:00", "2019-05-14 17:00:00+00:00", "2019-05-14 16:00:00+00:00", "2019-05-14 15:00:00+00:00", "2019-05-14 14:00:00+00:00", "2019-05-14 13:00:00+00:00", "2019-05-14 12:00:00+00:00", "2019-05-14 11:00:00+00:00", "2019-05-14 10:00:00+00:00", "2019-05-14 09:00:00+00:00", "2019-05-14 08:00:00+00:00", "2019-05-14 07:00:00+00:00", "2019-05-14 06:00:00+00:00", "2019-05-14 05:00:00+00:00", "2019-05-14 04:00:00+00:00", "2019-05-14 03:00:00+00:00", "2019-05-14 02:00:00+00:00", "2019-05-14 01:00:00+00:00", "2019-05-14 00:00:00+00:00", "2019-05-13 23:00:00+00:00", "2019-05-13 22:00:00+00:00", "2019-05-13 21:00:00+00:00", "2019-05-13 20:00:00+00:00", "2019-05-13 19:00:00+00:00", "2019-05-13 18:00:00+00:00", "2019-05-13 17:00:00+00:00", "2019-05-13 16:00:00+00:00", "2019-05-13 15:00:00+00:00", "2019-05-13 14:00:00+00:00", "2019-05-13 13:00:00+00:00", "2019-05-13 12:00:00+00:00", "2019-05-13 11:00:00+00:00", "2019-05-13 10:00:00+00:00", "2019-05-13 09:00:00+00:00", "2019-05-13 08:00:00+00:00", "2019-05-13 07:00:00+00:00", "2019-05-13 06:00:00+00:00", "2019-05-13 05:00:00+00:00", "2019-05-13 04:00:00+00:00", "2019-05-13 03:00:00+00:00", "2019-05-13 02:00:00+00:00", "2019-05-13 01:00:00+00:00", "2019-05-13 00:00:00+00:00", "2019-05-12 23:00:00+00:00", "2019-05-12 22:00:00+00:00", "2019-05-12 21:00:00+00:00", "2019-05-12 20:00:00+00:00", "2019-05-12 19:00:00+00:00", "2019-05-12 18:00:00+00:00", "2019-05-12 17:00:00+00:00", "2019-05-12 16:00:00+00:00", "2019-05-12 15:00:00+00:00", "2019-05-12 14:00:00+00:00", "2019-05-12 13:00:00+00:00", "2019-05-12 12:00:00+00:00", "2019-05-12 11:00:00+00:00", "2019-05-12 10:00:00+00:00", "2019-05-12 09:00:00+00:00", "2019-05-12 08:00:00+00:00", "2019-05-12 07:00:00+00:00", "2019-05-12 06:00:00+00:00", "2019-05-12 05:00:00+00:00", "2019-05-12 04:00:00+00:00", "2019-05-12 03:00:00+00:00", "2019-05-12 02:00:00+00:00", "2019-05-12 01:00:00+00:00", "2019-05-12 00:00:00+00:00", "2019-05-11 23:00:00+00:00", "2019-05-11 22:00:00+00:00", "2019-05-11 21:00:00+00:00", "2019-05-11 20:00:00+00:00", "2019-05-11 19:00:00+00:00", "2019-05-11 18:00:00+00:00", "2019-05-11 17:00:00+00:00", "2019-05-11 16:00:00+00:00", "2019-05-11 15:00:00+00:00", "2019-05-11 09:00:00+00:00", "2019-05-11 08:00:00+00:00", "2019-05-11 07:00:00+00:00", "2019-05-11 06:00:00+00:00", "2019-05-11 05:00:00+00:00", "2019-05-11 04:00:00+00:00", "2019-05-11 03:00:00+00:00", "2019-05-11 02:00:00+00:00", "2019-05-11 01:00:00+00:00", "2019-05-11 00:00:00+00:00", "2019-05-10 23:00:00+00:00", "2019-05-10 22:00:00+00:00", "2019-05-10 21:00:00+00:00", "2019-05-10 20:00:00+00:00", "2019-05-10 19:00:00+00:00", "2019-05-10 18:00:00+00:00", "2019-05-10 17:00:00+00:00", "2019-05-10 16:00:00+00:00", "2019-05-10 15:00:00+00:00", "2019-05-10 14:00:00+00:00", "2019-05-10 13:00:00+00:00", "2019-05-10 12:00:00+00:00", "2019-05-10 11:00:00+00:00", "2019-05-10 10:00:00+00:00", "2019-05-10 09:00:00+00:00", "2019-05-10 08:00:00+00:00", "2019-05-10 07:00:00+00:00", "2019-05-10 06:00:00+00:00", "2019-05-10 05:00:00+00:00", "2019-05-10 04:00:00+00:00", "2019-05-10 03:00:00+00:00", "2019-05-10 02:00:00+00:00", "2019-05-10 01:00:00+00:00", "2019-05-10 00:00:00+00:00", "2019-05-09 23:00:00+00:00", "2019-05-09 22:00:00+00:00", "2019-05-09 21:00:00+00:00", "2019-05-09 20:00:00+00:00", "2019-05-09 19:00:00+00:00", "2019-05-09 18:00:00+00:00", "2019-05-09 17:00:00+00:00", "2019-05-09 16:00:00+00:00", "2019-05-09 15:00:00+00:00", "2019-05-09 14:00:00+00:00", "2019-05-09 13:00:00+00:00", "2019-05-09 12:00:00+00:00", "2019-05-09 11:00:00+00:00", "2019-05-09 10:00:00+00:00", "2019-05-09 09:00:00+00:00", "2019-05-09 08:00:00+00:00", "2019-05-09 07:00:00+00:00", "2019-05-09 06:00:00+00:00", "2019-05-09 05:00:00+00:00", "2019-05-09 04:00:00+00:00", "2019-05-09 03:00:00+00:00", "2019-05-09 02:00:00+00:00", "2019-05-09 00:00:00+00:00", "2019-05-08 23:00:00+00:00", "2019-05-08 21:00:00+00:00", "2019-05-08 20:00:00+00:00", "2019-05-08 19:00:00+00:00", "2019-05-08 18:00:00+00:00", "2019-05-08 17:00:00+00:00", "2019-05-08 16:00:00+00:00", "2019-05-08 15:00:00+00:00", "2019-05-08 14:00:00+00:00", "2019-05-08 13:00:00+00:00", "2019-05-08 12:00:00+00:00", "2019-05-08 11:00:00+00:00", "2019-05-08 10:00:00+00:00", "2019-05-08 09:00:00+00:00", "2019-05-08 08:00:00+00:00", "2019-05-08 07:00:00+00:00", "2019-05-08 06:00:00+00:00", "2019-05-08 05:00:00+00:00", "2019-05-08 04:00:00+00:00", "2019-05-08 03:00:00+00:00", "2019-05-08 02:00:00+00:00", "2019-05-08 01:00:00+00:00", "2019-05-08 00:00:00+00:00", "2019-05-07 23:00:00+00:00", "2019-05-07 21:00:00+00:00", "2019-05-07 20:00:00+00:00", "2019-05-07 19:00:00+00:00", "2019-05-07 18:00:00+00:00", "2019-05-07 17:00:00+00:00", "2019-05-07 16:00:00+00:00", "2019-05-07 15:00:00+00:00", "2019-05-07 14:00:00+00:00", "2019-05-07 13:00:00+00:00", "2019-05-07 12:00:00+00:00", "2019-05-07 11:00:00+00:00", "2019-05-07 10:00:00+00:00", "2019-05-07 09:00:00+00:00", "2019-05-07 08:00:00+00:00", "2019-05-07 07:00:00+00:00", "2019-05-07 06:00:00+00:00", "2019-05-07 04:00:00+00:00", "2019-05-07 03:00:00+00:00", "2019-05-07 02:00:00+00:00", "2019-05-07 01:00:00+00:00", "2019-05-06 23:00:00+00:00", "2019-05-06 22:00:00+00:00", "2019-05-06 21:00:00+00:00", "2019-05-06 20:00:00+00:00", "2019-05-06 19:00:00+00:00", "2019-05-06 18:00:00+00:00", "2019-05-06 17:00:00+00:00", "2019-05-06 16:00:00+00:00", "2019-05-06 15:00:00+00:00", "2019-05-06 14:00:00+00:00", "2019-05-06 13:00:00+00:00", "2019-05-06 12:00:00+00:00", "2019-05-06 11:00:00+00:00", "2019-05-06 10:00:00+00:00", "2019-05-06 09:00:00+00:00", "2019-05-06 08:00:00+00:00", "2019-05-06 07:00:00+00:00", "2019-05-06 06:00:00+00:00", "2019-05-06 05:00:00+00:00", "2019-05-06 04:00:00+00:00", "2019-05-06 03:00:00+00:00", "2019-05-06 02:00:00+00:00", "2019-05-06 01:00:00+00:00", "2019-05-06 00:00:00+00:00", "2019-05-05 23:00:00+00:00", "2019-05-05 22:00:00+00:00", "2019-05-05 21:00:00+00:00", "2019-05-05 20:00:00+00:00", "2019-05-05 19:00:00+00:00", "2019-05-05 18:00:00+00:00", "2019-05-05 17:00:00+00:00", "2019-05-05 16:00:00+00:00", "2019-05-05 15:00:00+00:00", "2019-05-05 14:00:00+00:00", "2019-05-05 13:00:00+00:00", "2019-05-05 12:00:00+00:00", "2019-05-05 11:00:00+00:00", "2019-05-05 10:00:00+00:00", "2019-05-05 09:00:00+00:00", "2019-05-05 08:00:00+00:00", "2019-05-05 07:00:00+00:00", "2019-05-05 06:00:00+00:00", "2019-05-05 05:00:00+00:00", "2019-05-05 04:00:00+00:00", "2019-05-05 03:00:00+00:00", "2019-05-05 02:00:00+00:00", "2019-05-05 01:00:00+00:00", "2019-05-05 00:00:00+00:00", "2019-05-04 23:00:00+00:00", "2019-05-04 22:00:00+00:00", "2019-05-04 21:00:00+00:00", "2019-05-04 20:00:00+00:00", "2019-05-04 19:00:00+00:00", "2019-05-04 18:00:00+00:00", "2019-05-04 17:00:00+00:00", "2019-05-04 16:00:00+00:00", "2019-05-04 15:00:00+00:00", "2019-05-04 14:00:00+00:00", "2019-05-04 13:00:00+00:00", "2019-05-04 12:00:00+00:00", "2019-05-04 11:00:00+00:00", "2019-05-04 10:00:00+00:00", "2019-05-04 09:00:00+00:00", "2019-05-04 08:00:00+00:00", "2019-05-04 07:00:00+00:00", "2019-05-04 06:00:00+00:00", "2019-05-04 05:00:00+00:00", "2019-05-04 04:00:00+00:00", "2019-05-04 03:00:00+00:00", "2019-05-04 02:00:00+00:00", "2019-05-04 01:00:00+00:00", "2019-05-04 00:00:00+00:00", "2019-05-03 23:00:00+00:00", "2019-05-03 22:00:00+00:00", "2019-05-03 21:00:00+00:00", "2019-05-03 20:00:00+00:00", "2019-05-03 19:00:00+00:00", "2019-05-03 18:00:00+00:00", "2019-05-03 17:00:00+00:00", "2019-05-03 16:00:00+00:00", "2019-05-03 15:00:00+00:00", "2019-05-03 14:00:00+00:00", "2019-05-03 13:00:00+00:00", "2019-05-03 12:00:00+00:00", "2019-05-03 11:00:00+00:00", "2019-05-03 10:00:00+00:00", "2019-05-03 09:00:00+00:00", "2019-05-03 08:00:00+00:00", "2019-05-03 07:00:00+00:00", "2019-05-03 06:00:00+00:00", "2019-05-03 05:00:00+00:00", "2019-05-03 04:00:00+00:00", "2019-05-03 03:00:00+00:00", "2019-05-03 02:00:00+00:00", "2019-05-03 01:00:00+00:00", "2019-05-03 00:00:00+00:00", "2019-05-02 23:00:00+00:00", "2019-05-02 22:00:00+00:00", "2019-05-02 21:00:00+00:00", "2019-05-02 20:00:00+00:00", "2019-05-02 19:00:00+00:00", "2019-05-02 18:00:00+00:00", "2019-05-02 17:00:00+00:00", "2019-05-02 16:00:00+00:00", "2019-05-02 15:00:00+00:00", "2019-05-02 14:00:00+00:00", "2019-05-02 13:00:00+00:00", "2019-05-02 12:00:00+00:00", "2019-05-02 11:00:00+00:00", "2019-05-02 10:00:00+00:00", "2019-05-02 09:00:00+00:00", "2019-05-02 08:00:00+00:00", "2019-05-02 07:00:00+00:00", "2019-05-02 06:00:00+00:00", "2019-05-02 05:00:00+00:00", "2019-05-02 04:00:00+00:00", "2019-05-02 03:00:00+00:00", "2019-05-02 02:00:00+00:00", "2019-05-02 01:00:00+00:00", "2019-05-02 00:00:00+00:00", "2019-05-01 23:00:00+00:00", "2019-05-01 22:00:00+00:00", "2019-05-01 21:00:00+00:00", "2019-05-01 20:00:00+00:00", "2019-05-01 19:00:00+00:00", "2019-05-01 18:00:00+00:00", "2019-05-01 17:00:00+00:00", "2019-05-01 16:00:00+00:00", "2019-05-01 15:00:00+00:00", "2019-05-01 14:00:00+00:00", "2019-05-01 13:00:00+00:00", "2019-05-01 12:00:00+00:00", "2019-05-01 11:00:00+00:00", "2019-05-01 10:00:00+00:00", "2019-05-01 09:00:00+00:00", "2019-05-01 08:00:00+00:00", "2019-05-01 07:00:00+00:00", "2019-05-01 06:00:00+00:00", "2019-05-01 05:00:00+00:00", "2019-05-01 04:00:00+00:00", "2019-05-01 03:00:00+00:00", "2019-05-01 00:00:00+00:00", "2019-04-30 23:00:00+00:00", "2019-04-30 22:00:00+00:00", "2019-04-30 21:00:00+00:00", "2019-04-30 20:00:00+00:00", "2019-04-30 19:00:00+00:00", "2019-04-30 18:00:00+00:00", "2019-04-30 17:00:00+00:00", "2019-04-30 16:00:00+00:00", "2019-04-30 15:00:00+00:00", "2019-04-30 14:00:00+00:00", "2019-04-30 13:00:00+00:00", "2019-04-30 12:00:00+00:00", "2019-04-30 11:00:00+00:00", "2019-04-30 10:00:00+00:00", "2019-04-30 09:00:00+00:00", "2019-04-30 08:00:00+00:00", "2019-04-30 07:00:00+00:00", "2019-04-30 06:00:00+00:00", "2019-04-30 05:00:00+00:00", "2019-04-30 04:00:00+00:00", "2019-04-30 03:00:00+00:00", "2019-04-30 02:00:00+00:00", "2019-04-30 01:00:00+00:00", "2019-04-30 00:00:00+00:00", "2019-04-29 23:00:00+00:00", "2019-04-29 22:00:00+00:00", "2019-04-29 21:00:00+00:00", "2019-04-29 20:00:00+00:00", "2019-04-29 19:00:00+00:00", "2019-04-29 18:00:00+00:00", "2019-04-29 17:00:00+00:00", "2019-04-29 16:00:00+00:00", "2019-04-29 15:00:00+00:00", "2019-04-29 14:00:00+00:00", "2019-04-29 13:00:00+00:00", "2019-04-29 12:00:00+00:00", "2019-04-29 11:00:00+00:00", "2019-04-29 10:00:00+00:00", "2019-04-29 09:00:00+00:00", "2019-04-29 08:00:00+00:00", "2019-04-29 07:00:00+00:00", "2019-04-29 06:00:00+00:00", "2019-04-29 05:00:00+00:00", "2019-04-29 04:00:00+00:00", "2019-04-29 03:00:00+00:00", "2019-04-29 02:00:00+00:00", "2019-04-29 01:00:00+00:00", "2019-04-29 00:00:00+00:00", "2019-04-28 23:00:00+00:00", "2019-04-28 22:00:00+00:00", "2019-04-28 21:00:00+00:00", "2019-04-28 20:00:00+00:00", "2019-04-28 19:00:00+00:00", "2019-04-28 18:00:00+00:00", "2019-04-28 17:00:00+00:00", "2019-04-28 16:00:00+00:00", "2019-04-28 15:00:00+00:00", "2019-04-28 14:00:00+00:00", "2019-04-28 13:00:00+00:00", "2019-04-28 12:00:00+00:00", "2019-04-28 11:00:00+00:00", "2019-04-28 10:00:00+00:00", "2019-04-28 09:00:00+00:00", "2019-04-27 13:00:00+00:00", "2019-04-27 12:00:00+00:00", "2019-04-27 11:00:00+00:00", "2019-04-27 10:00:00+00:00", "2019-04-27 09:00:00+00:00", "2019-04-27 08:00:00+00:00", "2019-04-27 07:00:00+00:00", "2019-04-27 06:00:00+00:00", "2019-04-27 05:00:00+00:00", "2019-04-27 04:00:00+00:00", "2019-04-27 03:00:00+00:00", "2019-04-27 02:00:00+00:00", "2019-04-27 00:00:00+00:00", "2019-04-26 23:00:00+00:00", "2019-04-26 22:00:00+00:00", "2019-04-26 21:00:00+00:00", "2019-04-26 20:00:00+00:00", "2019-04-26 19:00:00+00:00", "2019-04-26 18:00:00+00:00", "2019-04-26 17:00:00+00:00", "2019-04-26 16:00:00+00:00", "2019-04-26 15:00:00+00:00", "2019-04-26 14:00:00+00:00", "2019-04-26 13:00:00+00:00", "2019-04-26 12:00:00+00:00", "2019-04-26 11:00:00+00:00", "2019-04-26 10:00:00+00:00", "2019-04-26 09:00:00+00:00", "2019-04-26 08:00:00+00:00", "2019-04-26 07:00:00+00:00", "2019-04-26 06:00:00+00:00", "2019-04-26 05:00:00+00:00", "2019-04-26 04:00:00+00:00", "2019-04-26 03:00:00+00:00", "2019-04-26 02:00:00+00:00", "2019-04-26 01:00:00+00:00", "2019-04-26 00:00:00+00:00", "2019-04-25 23:00:00+00:00", "2019-04-25 22:00:00+00:00", "2019-04-25 21:00:00+00:00", "2019-04-25 20:00:00+00:00", "2019-04-25 19:00:00+00:00", "2019-04-25 18:00:00+00:00", "2019-04-25 17:00:00+00:00", "2019-04-25 16:00:00+00:00", "2019-04-25 15:00:00+00:00", "2019-04-25 14:00:00+00:00", "2019-04-25 13:00:00+00:00", "2019-04-25 12:00:00+00:00", "2019-04-25 11:00:00+00:00", "2019-04-25 10:00:00+00:00", "2019-04-25 09:00:00+00:00", "2019-04-25 08:00:00+00:00", "2019-04-25 07:00:00+00:00", "2019-04-25 06:00:00+00:00", "2019-04-25 05:00:00+00:00", "2019-04-25 04:00:00+00:00", "2019-04-25 03:00:00+00:00", "2019-04-25 02:00:00+00:00", "2019-04-25 00:00:00+00:00", "2019-04-24 23:00:00+00:00", "2019-04-24 22:00:00+00:00", "2019-04-24 21:00:00+00:00", "2019-04-24 20:00:00+00:00", "2019-04-24 19:00:00+00:00", "2019-04-24 18:00:00+00:00", "2019-04-24 17:00:00+00:00", "2019-04-24 16:00:00+00:00", "2019-04-24 15:00:00+00:00", "2019-04-24 14:00:00+00:00", "2019-04-24 13:00:00+00:00", "2019-04-24 12:00:00+00:00", "2019-04-24 11:00:00+00:00", "2019-04-24 10:00:00+00:00", "2019-04-24 09:00:00+00:00", "2019-04-24 08:00:00+00:00", "2019-04-24 07:00:00+00:00", "2019-04-24 06:00:00+00:00", "2019-04-24 05:00:00+00:00", "2019-04-24 04:00:00+00:00", "2019-04-24 03:00:00+00:00", "2019-04-24 02:00:00+00:00", "2019-04-24 00:00:00+00:00", "2019-04-23 23:00:00+00:00", "2019-04-23 22:00:00+00:00", "2019-04-23 21:00:00+00:00", "2019-04-23 20:00:00+00:00", "2019-04-23 19:00:00+00:00", "2019-04-23 18:00:00+00:00", "2019-04-23 17:00:00+00:00", "2019-04-23 16:00:00+00:00", "2019-04-23 15:00:00+00:00", "2019-04-23 14:00:00+00:00", "2019-04-23 13:00:00+00:00", "2019-04-23 12:00:00+00:00", "2019-04-23 11:00:00+00:00", "2019-04-23 10:00:00+00:00", "2019-04-23 09:00:00+00:00", "2019-04-23 08:00:00+00:00", "2019-04-23 07:00:00+00:00", "2019-04-23 06:00:00+00:00", "2019-04-23 05:00:00+00:00", "2019-04-23 04:00:00+00:00", "2019-04-23 03:00:00+00:00", "2019-04-23 02:00:00+00:00", "2019-04-23 01:00:00+00:00", "2019-04-23 00:00:00+00:00", "2019-04-22 23:00:00+00:00", "2019-04-22 22:00:00+00:00", "2019-04-22 21:00:00+00:00", "2019-04-22 20:00:00+00:00", "2019-04-22 19:00:00+00:00", "2019-04-22 18:00:00+00:00", "2019-04-22 17:00:00+00:00", "2019-04-22 16:00:00+00:00", "2019-04-22 15:00:00+00:00", "2019-04-22 14:00:00+00:00", "2019-04-22 13:00:00+00:00", "2019-04-22 12:00:00+00:00", "2019-04-22 11:00:00+00:00", "2019-04-22 10:00:00+00:00", "2019-04-22 09:00:00+00:00", "2019-04-22 08:00:00+00:00", "2019-04-22 07:00:00+00:00", "2019-04-22 06:00:00+00:00", "2019-04-22 05:00:00+00:00", "2019-04-22 04:00:00+00:00", "2019-04-22 03:00:00+00:00", "2019-04-22 02:00:00+00:00", "2019-04-22 01:00:00+00:00", "2019-04-22 00:00:00+00:00", "2019-04-21 23:00:00+00:00", "2019-04-21 22:00:00+00:00", "2019-04-21 21:00:00+00:00", "2019-04-21 20:00:00+00:00", "2019-04-21 19:00:00+00:00", "2019-04-21 18:00:00+00:00", "2019-04-21 17:00:00+00:00", "2019-04-21 16:00:00+00:00", "2019-04-21 15:00:00+00:00", "2019-04-21 14:00:00+00:00", "2019-04-21 13:00:00+00:00", "2019-04-21 12:00:00+00:00", "2019-04-21 11:00:00+00:00", "2019-04-21 10:00:00+00:00", "2019-04-21 09:00:00+00:00", "2019-04-21 08:00:00+00:00", "2019-04-21 07:00:00+00:00", "2019-04-21 06:00:00+00:00", "2019-04-21 05:00:00+00:00", "2019-04-21 04:00:00+00:00", "2019-04-21 03:00:00+00:00", "2019-04-21 02:00:00+00:00", "2019-04-21 01:00:00+00:00", "2019-04-21 00:00:00+00:00", "2019-04-20 23:00:00+00:00", "2019-04-20 22:00:00+00:00", "2019-04-20 21:00:00+00:00", "2019-04-20 20:00:00+00:00", "2019-04-20 19:00:00+00:00", "2019-04-20 18:00:00+00:00", "2019-04-20 17:00:00+00:00", "2019-04-20 16:00:00+00:00", "2019-04-20 15:00:00+00:00", "2019-04-20 14:00:00+00:00", "2019-04-20 13:00:00+00:00", "2019-04-20 12:00:00+00:00", "2019-04-20 11:00:00+00:00", "2019-04-20 10:00:00+00:00", "2019-04-20 09:00:00+00:00", "2019-04-20 08:00:00+00:00", "2019-04-20 07:00:00+00:00", "2019-04-20 06:00:00+00:00", "2019-04-20 05:00:00+00:00", "2019-04-20 04:00:00+00:00", "2019-04-20 03:00:00+00:00", "2019-04-20 02:00:00+00:00", "2019-04-20 01:00:00+00:00", "2019-04-20 00:00:00+00:00", "2019-04-19 23:00:00+00:00", "2019-04-19 22:00:00+00:00", "2019-04-19 21:00:00+00:00", "2019-04-19 20:00:00+00:00", "2019-04-19 19:00:00+00:00", "2019-04-19 18:00:00+00:00", "2019-04-19 17:00:00+00:00", "2019-04-19 16:00:00+00:00", "2019-04-19 15:00:00+00:00", "2019-04-19 14:00:00+00:00", "2019-04-19 13:00:00+00:00", "2019-04-19 12:00:00+00:00", "2019-04-19 11:00:00+00:00", "2019-04-19 10:00:00+00:00", "2019-04-19 09:00:00+00:00", "2019-04-19 08:00:00+00:00", "2019-04-19 07:00:00+00:00", "2019-04-19 06:00:00+00:00", "2019-04-19 05:00:00+00:00", "2019-04-19 04:00:00+00:00", "2019-04-19 03:00:00+00:00", "2019-04-19 02:00:00+00:00", "2019-04-19 00:00:00+00:00", "2019-04-18 23:00:00+00:00", "2019-04-18 22:00:00+00:00", "2019-04-18 21:00:00+00:00", "2019-04-18 20:00:00+00:00", "2019-04-18 19:00:00+00:00", "2019-04-18 18:00:00+00:00", "2019-04-18 17:00:00+00:00", "2019-04-18 16:00:00+00:00", "2019-04-18 15:00:00+00:00", "2019-04-18 14:00:00+00:00", "2019-04-18 13:00:00+00:00", "2019-04-18 12:00:00+00:00", "2019-04-18 11:00:00+00:00", "2019-04-18 10:00:00+00:00", "2019-04-18 09:00:00+00:00", "2019-04-18 08:00:00+00:00", "2019-04-18 07:00:00+00:00", "2019-04-18 06:00:00+00:00", "2019-04-18 05:00:00+00:00", "2019-04-18 04:00:00+00:00", "2019-04-18 03:00:00+00:00", "2019-04-18 02:00:00+00:00", "2019-04-18 01:00:00+00:00", "2019-04-18 00:00:00+00:00", "2019-04-17 23:00:00+00:00", "2019-04-17 22:00:00+00:00", "2019-04-17 21:00:00+00:00", "2019-04-17 20:00:00+00:00", "2019-04-17 19:00:00+00:00", "2019-04-17 18:00:00+00:00", "2019-04-17 17:00:00+00:00", "2019-04-17 16:00:00+00:00", "2019-04-17 15:00:00+00:00", "2019-04-17 14:00:00+00:00", "2019-04-17 13:00:00+00:00", "2019-04-17 12:00:00+00:00", "2019-04-17 11:00:00+00:00", "2019-04-17 10:00:00+00:00", "2019-04-17 09:00:00+00:00", "2019-04-17 08:00:00+00:00", "2019-04-17 07:00:00+00:00", "2019-04-17 06:00:00+00:00", "2019-04-17 05:00:00+00:00", "2019-04-17 04:00:00+00:00", "2019-04-17 03:00:00+00:00", "2019-04-17 02:00:00+00:00", "2019-04-17 00:00:00+00:00", "2019-04-16 23:00:00+00:00", "2019-04-16 22:00:00+00:00", "2019-04-16 21:00:00+00:00", "2019-04-16 20:00:00+00:00", "2019-04-16 19:00:00+00:00", "2019-04-16 18:00:00+00:00", "2019-04-16 17:00:00+00:00", "2019-04-16 15:00:00+00:00", "2019-04-16 14:00:00+00:00", "2019-04-16 13:00:00+00:00", "2019-04-16 12:00:00+00:00", "2019-04-16 11:00:00+00:00", "2019-04-16 10:00:00+00:00", "2019-04-16 09:00:00+00:00", "2019-04-16 08:00:00+00:00", "2019-04-16 07:00:00+00:00", "2019-04-16 06:00:00+00:00", "2019-04-16 05:00:00+00:00", "2019-04-16 04:00:00+00:00", "2019-04-16 03:00:00+00:00", "2019-04-16 02:00:00+00:00", "2019-04-16 00:00:00+00:00", "2019-04-15 23:00:00+00:00", "2019-04-15 22:00:00+00:00", "2019-04-15 21:00:00+00:00", "2019-04-15 20:00:00+00:00", "2019-04-15 19:00:00+00:00", "2019-04-15 18:00:00+00:00", "2019-04-15 17:00:00+00:00", "2019-04-15 16:00:00+00:00", "2019-04-15 15:00:00+00:00", "2019-04-15 14:00:00+00:00", "2019-04-15 13:00:00+00:00", "2019-04-15 12:00:00+00:00", "2019-04-15 11:00:00+00:00", "2019-04-15 10:00:00+00:00", "2019-04-15 09:00:00+00:00", "2019-04-15 08:00:00+00:00", "2019-04-15 07:00:00+00:00", "2019-04-15 06:00:00+00:00", "2019-04-15 05:00:00+00:00", "2019-04-15 04:00:00+00:00", "2019-04-15 03:00:00+00:00", "2019-04-15 02:00:00+00:00", "2019-04-15 01:00:00+00:00", "2019-04-15 00:00:00+00:00", "2019-04-14 23:00:00+00:00", "2019-04-14 22:00:00+00:00", "2019-04-14 21:00:00+00:00", "2019-04-14 20:00:00+00:00", "2019-04-14 19:00:00+00:00", "2019-04-14 18:00:00+00:00", "2019-04-14 17:00:00+00:00", "2019-04-14 16:00:00+00:00", "2019-04-14 15:00:00+00:00", "2019-04-14 14:00:00+00:00", "2019-04-14 13:00:00+00:00", "2019-04-14 12:00:00+00:00", "2019-04-14 11:00:00+00:00", "2019-04-14 10:00:00+00:00", "2019-04-14 09:00:00+00:00", "2019-04-14 08:00:00+00:00", "2019-04-14 07:00:00+00:00", "2019-04-14 06:00:00+00:00", "2019-04-14 05:00:00+00:00", "2019-04-14 04:00:00+00:00", "2019-04-14 03:00:00+00:00", "2019-04-14 02:00:00+00:00", "2019-04-14 01:00:00+00:00", "2019-04-14 00:00:00+00:00", "2019-04-13 23:00:00+00:00", "2019-04-13 22:00:00+00:00", "2019-04-13 21:00:00+00:00", "2019-04-13 20:00:00+00:00", "2019-04-13 19:00:00+00:00", "2019-04-13 18:00:00+00:00", "2019-04-13 17:00:00+00:00", "2019-04-13 16:00:00+00:00", "2019-04-13 15:00:00+00:00", "2019-04-13 14:00:00+00:00", "2019-04-13 13:00:00+00:00", "2019-04-13 12:00:00+00:00", "2019-04-13 11:00:00+00:00", "2019-04-13 10:00:00+00:00", "2019-04-13 09:00:00+00:00", "2019-04-13 08:00:00+00:00", "2019-04-13 07:00:00+00:00", "2019-04-13 06:00:00+00:00", "2019-04-13 05:00:00+00:00", "2019-04-13 04:00:00+00:00", "2019-04-13 03:00:00+00:00", "2019-04-13 02:00:00+00:00", "2019-04-13 01:00:00+00:00", "2019-04-13 00:00:00+00:00", "2019-04-12 23:00:00+00:00", "2019-04-12 22:00:00+00:00", "2019-04-12 21:00:00+00:00", "2019-04-12 20:00:00+00:00", "2019-04-12 19:00:00+00:00", "2019-04-12 18:00:00+00:00", "2019-04-12 17:00:00+00:00", "2019-04-12 16:00:00+00:00", "2019-04-12 15:00:00+00:00", "2019-04-12 14:00:00+00:00", "2019-04-12 13:00:00+00:00", "2019-04-12 12:00:00+00:00", "2019-04-12 11:00:00+00:00", "2019-04-12 10:00:00+00:00", "2019-04-12 09:00:00+00:00", "2019-04-12 08:00:00+00:00", "2019-04-12 07:00:00+00:00", "2019-04-12 06:00:00+00:00", "2019-04-12 05:00:00+00:00", "2019-04-12 04:00:00+00:00", "2019-04-12 03:00:00+00:00", "2019-04-12 00:00:00+00:00", "2019-04-11 23:00:00+00:00", "2019-04-11 22:00:00+00:00", "2019-04-11 21:00:00+00:00", "2019-04-11 20:00:00+00:00", "2019-04-11 19:00:00+00:00", "2019-04-11 18:00:00+00:00", "2019-04-11 17:00:00+00:00", "2019-04-11 16:00:00+00:00", "2019-04-11 15:00:00+00:00", "2019-04-11 14:00:00+00:00", "2019-04-11 13:00:00+00:00", "2019-04-11 12:00:00+00:00", "2019-04-11 11:00:00+00:00", "2019-04-11 10:00:00+00:00", "2019-04-11 09:00:00+00:00", "2019-04-11 08:00:00+00:00", "2019-04-11 07:00:00+00:00", "2019-04-11 06:00:00+00:00", "2019-04-11 05:00:00+00:00", "2019-04-11 04:00:00+00:00", "2019-04-11 03:00:00+00:00", "2019-04-11 02:00:00+00:00", "2019-04-11 00:00:00+00:00", "2019-04-10 23:00:00+00:00", "2019-04-10 22:00:00+00:00", "2019-04-10 21:00:00+00:00", "2019-04-10 20:00:00+00:00", "2019-04-10 19:00:00+00:00", "2019-04-10 18:00:00+00:00", "2019-04-10 17:00:00+00:00", "2019-04-10 16:00:00+00:00", "2019-04-10 15:00:00+00:00", "2019-04-10 14:00:00+00:00", "2019-04-10 13:00:00+00:00", "2019-04-10 12:00:00+00:00", "2019-04-10 11:00:00+00:00", "2019-04-10 10:00:00+00:00", "2019-04-10 09:00:00+00:00", "2019-04-10 08:00:00+00:00", "2019-04-10 07:00:00+00:00", "2019-04-10 06:00:00+00:00", "2019-04-10 05:00:00+00:00", "2019-04-10 04:00:00+00:00", "2019-04-10 03:00:00+00:00", "2019-04-10 02:00:00+00:00", "2019-04-10 01:00:00+00:00", "2019-04-10 00:00:00+00:00", "2019-04-09 23:00:00+00:00", "2019-04-09 22:00:00+00:00", "2019-04-09 21:00:00+00:00", "2019-04-09 20:00:00+00:00", "2019-04-09 19:00:00+00:00", "2019-04-09 18:00:00+00:00", "2019-04-09 17:00:00+00:00", "2019-04-09 16:00:00+00:00", "2019-04-09 15:00:00+00:00", "2019-04-09 14:00:00+00:00", "2019-04-09 13:00:00+00:00", "2019-04-09 12:00:00+00:00", "2019-04-09 11:00:00+00:00", "2019-04-09 10:00:00+00:00", "2019-04-09 09:00:00+00:00", "2019-04-09 08:00:00+00:00", "2019-04-09 07:00:00+00:00", "2019-04-09 06:00:00+00:00", "2019-04-09 05:00:00+00:00", "2019-04-09 04:00:00+00:00", "2019-04-09 03:00:00+00:00", "2019-04-09 02:00:00+00:00",
-// 					},
-// 				},
-// 				[][]interface{}{
-// 					{"pm25", "BETR801"},
-// 					{"pm25", "London Westminster"},
-// 					{"no2", "FR04014"},
-// 					{"no2", "BETR801"},
-// 					{"no2", "London Westminster"},
-// 				},
-// 			},
-// 		},
-// 	}
-// 	for _, test := range groupByTests {
-// 		output, err := test.arg1.GroupBy(test.arg2...)
-// 		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}, GroupBy{}), cmpopts.EquateNaNs()) || err != nil {
-// 			t.Fatalf("expected:\n%v,\ngot:\n%v,\nerror:\n%v\n", test.expected, output, err)
-// 		}
-// 	}
-// }
+func TestDataFrameIterRowsStop(t *testing.T) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	done := make(chan struct{})
+	rows := testDf.IterRows(done)
+	first := <-rows
+	if first.Values["Name"] != "Avery Bradley" {
+		t.Fatalf("expected first row of Avery Bradley, got %v", first.Values["Name"])
+	}
+	close(done)
+
+	// the channel should be closed shortly after done is closed, without reading every row.
+	count := 0
+	for range rows {
+		count++
+	}
+	if count > 1 {
+		t.Fatalf("expected at most 1 row after stopping, got %d", count)
+	}
+}
+
+func iterRowsCollect(rows <-chan Row) []Row {
+	result := make([]Row, 0)
+	for row := range rows {
+		result = append(result, row)
+	}
+	return result
+}
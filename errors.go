@@ -0,0 +1,19 @@
+package gambas
+
+import "errors"
+
+// Sentinel errors returned by gambas. They are wrapped with more details,
+// so use errors.Is to check for them.
+var (
+	// ErrColumnNotFound is returned when a given column name does not exist.
+	ErrColumnNotFound = errors.New("column does not exist")
+
+	// ErrIndexNotFound is returned when a given index does not exist.
+	ErrIndexNotFound = errors.New("index does not exist")
+
+	// ErrTypeMismatch is returned when an operation needs numeric data but the data type is not float64 or int.
+	ErrTypeMismatch = errors.New("data type is not float64 or int")
+
+	// ErrAxisOutOfRange is returned when axis is neither 0 nor 1.
+	ErrAxisOutOfRange = errors.New("axis can only be either 0 or 1")
+)
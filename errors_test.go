@@ -0,0 +1,86 @@
+package gambas
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+	testDf, err := NewDataFrame([][]interface{}{{"Avery", "Bradley"}, {19, 27}}, []string{"Name", "Age"}, []string{"Name"})
+	if err != nil {
+		t.Error(err)
+	}
+	testSer, err := NewSeries([]interface{}{"a", "b"}, "Letter", nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	type errorsTest struct {
+		arg1     func() error
+		expected error
+	}
+	errorsTests := []errorsTest{
+		{
+			func() error {
+				_, err := testDf.LocCol("Height")
+				return err
+			},
+			ErrColumnNotFound,
+		},
+		{
+			func() error {
+				_, err := testDf.ColAdd("Height", 1.0)
+				return err
+			},
+			ErrColumnNotFound,
+		},
+		{
+			func() error {
+				return testDf.RenameCol(map[string]string{"Height": "Weight"})
+			},
+			ErrColumnNotFound,
+		},
+		{
+			func() error {
+				_, err := testDf.At([]interface{}{"Diana"}, "Age")
+				return err
+			},
+			ErrIndexNotFound,
+		},
+		{
+			func() error {
+				_, err := testDf.ColAdd("Name", 1.0)
+				return err
+			},
+			ErrTypeMismatch,
+		},
+		{
+			func() error {
+				_, err := testSer.Clip(0.0, 1.0)
+				return err
+			},
+			ErrTypeMismatch,
+		},
+		{
+			func() error {
+				_, err := testDf.DropNaN(2)
+				return err
+			},
+			ErrAxisOutOfRange,
+		},
+		{
+			func() error {
+				_, err := Concat([]DataFrame{testDf}, -1)
+				return err
+			},
+			ErrAxisOutOfRange,
+		},
+	}
+
+	for _, test := range errorsTests {
+		err := test.arg1()
+		if !errors.Is(err, test.expected) {
+			t.Fatalf("expected errors.Is(%v, %v) to be true", err, test.expected)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package gambas
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Eval evaluates an arithmetic expression over the columns of the DataFrame, such as "price * quantity - discount",
+// and returns the result as a float64 Series with the same index as the DataFrame.
+// The expression can use +, -, *, /, parentheses, numeric literals, and column names.
+// Column names that are not made up of letters, digits, and underscores can be quoted with backticks, like `list price`.
+// The columns must be of int or float64 dtype, and missing values give NaN.
+func (df *DataFrame) Eval(expr string) (Series, error) {
+	p := evalParser{expr: expr, df: df}
+	if err := p.tokenize(); err != nil {
+		return Series{}, err
+	}
+
+	result, err := p.parseExpr()
+	if err != nil {
+		return Series{}, err
+	}
+	if p.pos < len(p.tokens) {
+		return Series{}, fmt.Errorf("invalid expression %q: unexpected %q", expr, p.tokens[p.pos].text)
+	}
+
+	data := make([]interface{}, df.Len())
+	for i := range data {
+		data[i] = result(i)
+	}
+	return NewSeries(data, expr, &df.index)
+}
+
+type evalTokenKind int
+
+const (
+	evalNumber evalTokenKind = iota
+	evalColumn
+	evalOperator
+)
+
+type evalToken struct {
+	kind evalTokenKind
+	text string
+}
+
+// evalParser is a recursive descent parser for the expressions accepted by Eval.
+// Each parsed node is a function that computes the value of the node for the given row position.
+type evalParser struct {
+	expr   string
+	df     *DataFrame
+	tokens []evalToken
+	pos    int
+}
+
+// tokenize splits p.expr into numbers, column names, and operators.
+func (p *evalParser) tokenize() error {
+	runes := []rune(p.expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case strings.ContainsRune("+-*/()", r):
+			p.tokens = append(p.tokens, evalToken{evalOperator, string(r)})
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			j := i
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			p.tokens = append(p.tokens, evalToken{evalNumber, string(runes[i:j])})
+			i = j
+		case r == '`':
+			j := i + 1
+			for j < len(runes) && runes[j] != '`' {
+				j++
+			}
+			if j == len(runes) {
+				return fmt.Errorf("invalid expression %q: unterminated backtick", p.expr)
+			}
+			p.tokens = append(p.tokens, evalToken{evalColumn, string(runes[i+1 : j])})
+			i = j + 1
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			p.tokens = append(p.tokens, evalToken{evalColumn, string(runes[i:j])})
+			i = j
+		default:
+			return fmt.Errorf("invalid expression %q: unexpected %q", p.expr, r)
+		}
+	}
+	return nil
+}
+
+// accept consumes the next token if it is the given operator.
+func (p *evalParser) accept(op string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == evalOperator && p.tokens[p.pos].text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// parseExpr parses a sum or difference of terms.
+func (p *evalParser) parseExpr() (func(int) float64, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("+"):
+			right, err := p.parseTerm()
+			if err != nil {
+				return nil, err
+			}
+			l := left
+			left = func(i int) float64 { return l(i) + right(i) }
+		case p.accept("-"):
+			right, err := p.parseTerm()
+			if err != nil {
+				return nil, err
+			}
+			l := left
+			left = func(i int) float64 { return l(i) - right(i) }
+		default:
+			return left, nil
+		}
+	}
+}
+
+// parseTerm parses a product or quotient of factors.
+func (p *evalParser) parseTerm() (func(int) float64, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("*"):
+			right, err := p.parseFactor()
+			if err != nil {
+				return nil, err
+			}
+			l := left
+			left = func(i int) float64 { return l(i) * right(i) }
+		case p.accept("/"):
+			right, err := p.parseFactor()
+			if err != nil {
+				return nil, err
+			}
+			l := left
+			left = func(i int) float64 { return l(i) / right(i) }
+		default:
+			return left, nil
+		}
+	}
+}
+
+// parseFactor parses a negation, a parenthesized expression, a numeric literal, or a column name.
+func (p *evalParser) parseFactor() (func(int) float64, error) {
+	if p.accept("-") {
+		operand, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return func(i int) float64 { return -operand(i) }, nil
+	}
+	if p.accept("(") {
+		inner, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("invalid expression %q: missing closing parenthesis", p.expr)
+		}
+		return inner, nil
+	}
+	if p.pos == len(p.tokens) {
+		return nil, fmt.Errorf("invalid expression %q: unexpected end of expression", p.expr)
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case evalNumber:
+		value, err := strconv.ParseFloat(token.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression %q: invalid number %q", p.expr, token.text)
+		}
+		return func(int) float64 { return value }, nil
+	case evalColumn:
+		series, err := p.df.LocCol(token.text)
+		if err != nil {
+			return nil, err
+		}
+		if series.dtype != "int" && series.dtype != "float64" {
+			return nil, fmt.Errorf("cannot evaluate column '%v', column %w", token.text, ErrTypeMismatch)
+		}
+		values := make([]float64, len(series.data))
+		for i, data := range series.data {
+			if isNaN(data) {
+				values[i] = math.NaN()
+				continue
+			}
+			values[i], err = i2f(data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return func(i int) float64 { return values[i] }, nil
+	default:
+		return nil, fmt.Errorf("invalid expression %q: unexpected %q", p.expr, token.text)
+	}
+}
@@ -0,0 +1,112 @@
+package gambas
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func BenchmarkDataFrameEval(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.Eval("(Age - 20) * Weight / 2")
+	}
+}
+
+func TestDataFrameEval(t *testing.T) {
+	testDf, err := NewDataFrame(
+		[][]interface{}{{"Avery", "Bradley", "Candice"}, {3, 5, 2}, {4.0, 1.0, math.NaN()}, {1.5, 0.5, 2.0}, {true, false, true}},
+		[]string{"Name", "price", "quantity", "list price", "member"},
+		[]string{"Name"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type evalTest struct {
+		arg1          string
+		expected      Series
+		expectedError error
+	}
+	evalTests := []evalTest{
+		{
+			"price * quantity - `list price`",
+			Series{
+				[]interface{}{10.5, 4.5, math.NaN()},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				"price * quantity - `list price`",
+				"float64",
+			},
+			nil,
+		},
+		{
+			"(price + 1) * -2 / 4",
+			Series{
+				[]interface{}{-2.0, -3.0, -1.5},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				"(price + 1) * -2 / 4",
+				"float64",
+			},
+			nil,
+		},
+		{
+			"price * 1.5",
+			Series{
+				[]interface{}{4.5, 7.5, 3.0},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				"price * 1.5",
+				"float64",
+			},
+			nil,
+		},
+		{
+			"price * discount",
+			Series{},
+			fmt.Errorf("%w: discount", ErrColumnNotFound),
+		},
+		{
+			"price * member",
+			Series{},
+			fmt.Errorf("cannot evaluate column 'member', column %w", ErrTypeMismatch),
+		},
+		{
+			"(price + 1",
+			Series{},
+			fmt.Errorf("invalid expression \"(price + 1\": missing closing parenthesis"),
+		},
+		{
+			"price *",
+			Series{},
+			fmt.Errorf("invalid expression \"price *\": unexpected end of expression"),
+		},
+		{
+			"price % 2",
+			Series{},
+			fmt.Errorf("invalid expression \"price %% 2\": unexpected '%%'"),
+		},
+	}
+
+	for _, test := range evalTests {
+		output, err := testDf.Eval(test.arg1)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
@@ -38,7 +38,12 @@ func NewSeries(data []interface{}, name string, index *IndexData) (Series, error
 
 	switch dtype {
 	case "float64":
-		s.data = consolidateToFloat64(data)
+		s.data, err = consolidateToFloat64(data)
+		if err != nil {
+			return Series{}, err
+		}
+	case "int":
+		s.data = consolidateToInt(data)
 	case "string":
 		s.data = consolidateToString(data)
 	default:
@@ -60,6 +65,12 @@ func NewSeries(data []interface{}, name string, index *IndexData) (Series, error
 	return s, nil
 }
 
+// NewSeriesRange creates a new Series object with a RangeIndex of 0 to len(data)-1.
+// Use this for quick one-off Series that don't need a custom index.
+func NewSeriesRange(data []interface{}, name string) (Series, error) {
+	return NewSeries(data, name, nil)
+}
+
 // NewDataFrame created a new DataFrame object from given parameters.
 // Generally, NewDataFrameFromFile will be used more often.
 func NewDataFrame(data [][]interface{}, columns []string, indexCols []string) (DataFrame, error) {
@@ -72,7 +83,16 @@ func NewDataFrame(data [][]interface{}, columns []string, indexCols []string) (D
 	df.index = IndexData{}
 	df.columns = columns
 
+	for i, v := range data {
+		series, err := NewSeries(v, fmt.Sprint(columns[i]), nil)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		df.series[i] = series
+	}
+
 	// create df.index
+	// index values are taken from the series so that missing values are already consolidated
 	// find location of index column
 	if indexCols == nil {
 		df.index = CreateRangeIndex(len(data[0]))
@@ -93,15 +113,51 @@ func NewDataFrame(data [][]interface{}, columns []string, indexCols []string) (D
 
 			for _, location := range indexColsIndex {
 				indexTuple.id = i
-				indexTuple.value = append(indexTuple.value, data[location][i])
+				indexTuple.value = append(indexTuple.value, df.series[location].data[i])
 			}
 
 			df.index.index = append(df.index.index, indexTuple)
 		}
 	}
 
+	for i := range df.series {
+		df.series[i].index.index = make([]Index, len(df.index.index))
+		df.series[i].index.names = make([]string, len(df.index.names))
+		copy(df.series[i].index.index, df.index.index)
+		copy(df.series[i].index.names, df.index.names)
+	}
+
+	return df, nil
+}
+
+// NewDataFrameWithIndex creates a new DataFrame object with the given index.
+// Unlike NewDataFrame, the index does not have to come from the data columns,
+// so a precomputed index such as a list of dates can be used.
+// The number of index values must match the length of the data.
+func NewDataFrameWithIndex(data [][]interface{}, columns []string, index IndexData) (DataFrame, error) {
+	if len(data) != len(columns) {
+		return DataFrame{}, fmt.Errorf("length of data (%d) and columns (%d) does not match", len(data), len(columns))
+	}
 	for i, v := range data {
-		series, err := NewSeries(v, fmt.Sprint(columns[i]), &df.index)
+		if len(v) != len(index.index) {
+			return DataFrame{}, fmt.Errorf("length of column '%v' (%d) and index (%d) does not match", columns[i], len(v), len(index.index))
+		}
+	}
+	for _, idx := range index.index {
+		if len(idx.value) != len(index.names) {
+			return DataFrame{}, fmt.Errorf("index %v does not match index names %v", idx.value, index.names)
+		}
+	}
+
+	var df DataFrame
+	df.series = make([]Series, len(data))
+	df.index = IndexData{make([]Index, len(index.index)), make([]string, len(index.names))}
+	copy(df.index.index, index.index)
+	copy(df.index.names, index.names)
+	df.columns = append([]string{}, columns...)
+
+	for i, v := range data {
+		series, err := NewSeries(v, columns[i], &df.index)
 		if err != nil {
 			return DataFrame{}, err
 		}
@@ -111,14 +167,47 @@ func NewDataFrame(data [][]interface{}, columns []string, indexCols []string) (D
 	return df, nil
 }
 
+// NewEmptyDataFrame creates a new DataFrame object with the given columns and dtypes, but no rows.
+// Rows can be added later, so a DataFrame can be built up incrementally.
+// Each dtype must be either "int", "float64", "string", or "bool".
+func NewEmptyDataFrame(columns []string, dtypes []string) (DataFrame, error) {
+	if len(columns) != len(dtypes) {
+		return DataFrame{}, fmt.Errorf("length of columns (%d) and dtypes (%d) does not match", len(columns), len(dtypes))
+	}
+
+	var df DataFrame
+	df.series = make([]Series, len(columns))
+	df.index = CreateRangeIndex(0)
+	df.columns = append([]string{}, columns...)
+
+	for i, col := range columns {
+		if containsString(columns[:i], col) {
+			return DataFrame{}, fmt.Errorf("column '%v' is given more than once", col)
+		}
+		switch dtypes[i] {
+		case "int", "float64", "string", "bool":
+		default:
+			return DataFrame{}, fmt.Errorf("invalid dtype %q for column '%v'; dtype should be either int, float64, string, or bool", dtypes[i], col)
+		}
+		df.series[i] = Series{[]interface{}{}, CreateRangeIndex(0), col, dtypes[i]}
+	}
+
+	return df, nil
+}
+
 // NewIndexData creates a new IndexData object.
+// Each element of index is the tuple of values for one row, and ids are assigned in order starting from 0.
+// Every tuple must have one value per name, so pass multiple names for a multiindex.
 func NewIndexData(index [][]interface{}, names []string) (IndexData, error) {
 	indexData := IndexData{}
 	for i, val := range index {
-		idx := Index{i, val}
+		if len(val) != len(names) {
+			return IndexData{}, fmt.Errorf("length of index %v (%d) and names (%d) does not match", val, len(val), len(names))
+		}
+		idx := Index{i, append([]interface{}{}, val...)}
 		indexData.index = append(indexData.index, idx)
 	}
-	indexData.names = names
+	indexData.names = append([]string{}, names...)
 
 	return indexData, nil
 }
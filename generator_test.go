@@ -1,10 +1,13 @@
 package gambas
 
 import (
+	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func BenchmarkGeneratorCreateRangeIndex(b *testing.B) {
@@ -167,6 +170,75 @@ func TestGeneratorNewSeries(t *testing.T) {
 	}
 }
 
+func BenchmarkGeneratorNewSeriesRange(b *testing.B) {
+	data := make([]interface{}, 1000)
+	for i := 0; i < 1000; i++ {
+		data[i] = rand.Intn(1000)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		NewSeriesRange(data, "Data")
+	}
+}
+
+func TestGeneratorNewSeriesRange(t *testing.T) {
+	type newSeriesRangeTest struct {
+		arg1     []interface{}
+		arg2     string
+		expected Series
+	}
+
+	newSeriesRangeTests := []newSeriesRangeTest{
+		{
+			[]interface{}{"alice", "bob", "charlie"},
+			"People",
+			Series{
+				[]interface{}{"alice", "bob", "charlie"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"People",
+				"string",
+			},
+		},
+		{
+			[]interface{}{1.5, math.NaN(), 3.0, 4.5},
+			"Score",
+			Series{
+				[]interface{}{1.5, math.NaN(), 3.0, 4.5},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Score",
+				"float64",
+			},
+		},
+		{
+			[]interface{}{},
+			"Empty",
+			Series{
+				[]interface{}{},
+				IndexData{
+					[]Index{},
+					[]string{""},
+				},
+				"Empty",
+				"string",
+			},
+		},
+	}
+
+	for _, test := range newSeriesRangeTests {
+		output, err := NewSeriesRange(test.arg1, test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
 func BenchmarkGeneratorNewDataFrame(b *testing.B) {
 	data := make([][]interface{}, 5)
 	for i := 0; i < 5; i++ {
@@ -325,15 +397,186 @@ func TestGeneratorNewDataFrame(t *testing.T) {
 	}
 }
 
+func BenchmarkGeneratorNewDataFrameWithIndex(b *testing.B) {
+	data := make([][]interface{}, 5)
+	for i := 0; i < 5; i++ {
+		d := make([]interface{}, 1000)
+		for j := 0; j < 1000; j++ {
+			d[j] = rand.Intn(1000)
+		}
+		data[i] = d
+	}
+	index := CreateRangeIndex(1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		NewDataFrameWithIndex(data, []string{"col1", "col2", "col3", "col4", "col5"}, index)
+	}
+}
+
+func TestGeneratorNewDataFrameWithIndex(t *testing.T) {
+	type newDataFrameWithIndexTest struct {
+		arg1          [][]interface{}
+		arg2          []string
+		arg3          IndexData
+		expected      DataFrame
+		expectedError error
+	}
+	newDataFrameWithIndexTests := []newDataFrameWithIndexTest{
+		{
+			[][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 27, 22}},
+			[]string{"Name", "Age"},
+			IndexData{
+				[]Index{{0, []interface{}{"2022-01-01"}}, {1, []interface{}{"2022-01-02"}}, {2, []interface{}{"2022-01-03"}}},
+				[]string{"Date"},
+			},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"2022-01-01"}}, {1, []interface{}{"2022-01-02"}}, {2, []interface{}{"2022-01-03"}}},
+							[]string{"Date"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 27, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"2022-01-01"}}, {1, []interface{}{"2022-01-02"}}, {2, []interface{}{"2022-01-03"}}},
+							[]string{"Date"},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"2022-01-01"}}, {1, []interface{}{"2022-01-02"}}, {2, []interface{}{"2022-01-03"}}},
+					[]string{"Date"},
+				},
+				[]string{"Name", "Age"},
+			},
+			nil,
+		},
+		{
+			[][]interface{}{{"Avery", "Bradley"}},
+			[]string{"Name"},
+			IndexData{
+				[]Index{{0, []interface{}{"2022-01-01"}}, {1, []interface{}{"2022-01-02"}}, {2, []interface{}{"2022-01-03"}}},
+				[]string{"Date"},
+			},
+			DataFrame{},
+			fmt.Errorf("length of column 'Name' (2) and index (3) does not match"),
+		},
+		{
+			[][]interface{}{{"Avery", "Bradley", "Candice"}},
+			[]string{"Name", "Age"},
+			IndexData{
+				[]Index{{0, []interface{}{"2022-01-01"}}, {1, []interface{}{"2022-01-02"}}, {2, []interface{}{"2022-01-03"}}},
+				[]string{"Date"},
+			},
+			DataFrame{},
+			fmt.Errorf("length of data (1) and columns (2) does not match"),
+		},
+		{
+			[][]interface{}{{"Avery"}},
+			[]string{"Name"},
+			IndexData{
+				[]Index{{0, []interface{}{"2022-01-01"}}},
+				[]string{"Date", "Time"},
+			},
+			DataFrame{},
+			fmt.Errorf("index [2022-01-01] does not match index names [Date Time]"),
+		},
+	}
+
+	for _, test := range newDataFrameWithIndexTests {
+		output, err := NewDataFrameWithIndex(test.arg1, test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestGeneratorNewEmptyDataFrame(t *testing.T) {
+	type newEmptyDataFrameTest struct {
+		arg1            []string
+		arg2            []string
+		expectedColumns []string
+		expectedDtypes  map[string]string
+		expectedError   error
+	}
+	newEmptyDataFrameTests := []newEmptyDataFrameTest{
+		{
+			[]string{"Name", "Age", "Score", "Active"},
+			[]string{"string", "int", "float64", "bool"},
+			[]string{"Name", "Age", "Score", "Active"},
+			map[string]string{"Name": "string", "Age": "int", "Score": "float64", "Active": "bool"},
+			nil,
+		},
+		{
+			[]string{},
+			[]string{},
+			[]string{},
+			map[string]string{},
+			nil,
+		},
+		{
+			[]string{"Name", "Age"},
+			[]string{"string"},
+			nil,
+			nil,
+			fmt.Errorf("length of columns (2) and dtypes (1) does not match"),
+		},
+		{
+			[]string{"Name", "Age"},
+			[]string{"string", "int64"},
+			nil,
+			nil,
+			fmt.Errorf("invalid dtype \"int64\" for column 'Age'; dtype should be either int, float64, string, or bool"),
+		},
+		{
+			[]string{"Name", "Name"},
+			[]string{"string", "string"},
+			nil,
+			nil,
+			fmt.Errorf("column 'Name' is given more than once"),
+		},
+	}
+
+	for _, test := range newEmptyDataFrameTests {
+		output, err := NewEmptyDataFrame(test.arg1, test.arg2)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+		if err != nil {
+			continue
+		}
+		if !cmp.Equal(output.Columns(), test.expectedColumns) || !cmp.Equal(output.Dtypes(), test.expectedDtypes) || output.Len() != 0 {
+			t.Fatalf("expected columns %v and dtypes %v with no rows, got %v and %v with %v rows", test.expectedColumns, test.expectedDtypes, output.Columns(), output.Dtypes(), output.Len())
+		}
+	}
+}
+
 func BenchmarkNewIndexData(b *testing.B) {
+	index := make([][]interface{}, 1000)
+	for i := range index {
+		index[i] = []interface{}{i, rand.Intn(1000)}
+	}
+	b.ResetTimer()
 
+	for i := 0; i < b.N; i++ {
+		NewIndexData(index, []string{"id", "value"})
+	}
 }
 
 func TestNewIndexData(t *testing.T) {
 	type newIndexDataTest struct {
-		arg1     [][]interface{}
-		arg2     []string
-		expected IndexData
+		arg1          [][]interface{}
+		arg2          []string
+		expected      IndexData
+		expectedError error
 	}
 	newIndexDataTests := []newIndexDataTest{
 		{
@@ -349,6 +592,7 @@ func TestNewIndexData(t *testing.T) {
 				},
 				[]string{"key"},
 			},
+			nil,
 		},
 		{
 			[][]interface{}{{"a", "blue"}, {"b", "yellow"}, {"c", "yellow"}, {"d", "red"}, {"e", "blue"}},
@@ -363,11 +607,24 @@ func TestNewIndexData(t *testing.T) {
 				},
 				[]string{"key", "color"},
 			},
+			nil,
+		},
+		{
+			[][]interface{}{{"a", "blue"}, {"b"}},
+			[]string{"key", "color"},
+			IndexData{},
+			fmt.Errorf("length of index [b] (1) and names (2) does not match"),
+		},
+		{
+			[][]interface{}{{"a", "blue"}},
+			[]string{"key"},
+			IndexData{},
+			fmt.Errorf("length of index [a blue] (2) and names (1) does not match"),
 		},
 	}
 	for _, test := range newIndexDataTests {
 		output, err := NewIndexData(test.arg1, test.arg2)
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{})) || err != nil {
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
 			t.Fatalf("expected: %v, got: %v, err: %v", test.expected, output, err)
 		}
 	}
@@ -1,5 +1,7 @@
 package gambas
 
+import "fmt"
+
 // GroupBy type is a intermediary struct that is created after running DataFrame.GroupBy().
 // It holds the necessary data for applying operations such as GroupBy.Agg().
 type GroupBy struct {
@@ -11,42 +13,48 @@ type GroupBy struct {
 
 // Agg aggregates data in the GroupBy object using the given aggFunc.
 func (gb *GroupBy) Agg(targetCol []string, aggFunc StatsFunc) (DataFrame, error) {
+	return gb.AggWith(targetCol, aggFunc)
+}
+
+// AggAny aggregates the given column in the GroupBy object using an AggFunc.
+// Unlike Agg, the results do not have to be float64, so columns of any dtype can be aggregated.
+// For example, AggAny("Name", First) keeps the first name of each group.
+func (gb *GroupBy) AggAny(col string, aggFunc AggFunc) (DataFrame, error) {
+	return gb.AggWith([]string{col}, aggFunc)
+}
+
+// AggWith aggregates each of the target columns in the GroupBy object using any Aggregator.
+// Errors that come with a NaN result are ignored and leave the group as NaN, the same as in PivotTable.
+func (gb *GroupBy) AggWith(targetCol []string, agg Aggregator) (DataFrame, error) {
+	for _, col := range targetCol {
+		if !containsString(gb.dataFrame.columns, col) {
+			return DataFrame{}, fmt.Errorf("%w: %v", ErrColumnNotFound, col)
+		}
+	}
+
 	filtered, err := gb.dataFrame.LocCols(targetCol...)
 	if err != nil {
 		return DataFrame{}, err
 	}
 
-	newDfData := make([][]interface{}, len(gb.colTuples[0]))
-	for _, colTuple := range gb.colTuples {
-		for j, col := range colTuple {
-			newDfData[j] = append(newDfData[j], col)
+	newDfData := gb.keyData()
+	for _, ser := range filtered.series {
+		groups, err := gb.groupData(&ser)
+		if err != nil {
+			return DataFrame{}, err
 		}
-	}
 
-	results := make([]interface{}, 0)
-	for _, ser := range filtered.series {
-		for i, colTuple := range gb.colTuples {
-			colTupleIndex := Index{i, colTuple}
-			key, err := colTupleIndex.hashKeyValueOnly()
-			if err != nil {
+		results := make([]interface{}, len(groups))
+		for i, data := range groups {
+			result, err := agg.Aggregate(data)
+			if err != nil && !isNaN(result) {
 				return DataFrame{}, err
 			}
-
-			indexForData := gb.colIndMap[*key]
-			data := make([]interface{}, 0)
-			for _, id := range indexForData {
-				d, err := ser.IAt(id.(int))
-				if err != nil {
-					return DataFrame{}, err
-				}
-				data = append(data, d)
-			}
-			result := aggFunc(data)
-			results = append(results, result.Result)
+			results[i] = result
 		}
+		newDfData = append(newDfData, results)
 	}
 
-	newDfData = append(newDfData, results)
 	newDfColumns := make([]string, 0)
 	newDfColumns = append(newDfColumns, gb.colTuplesLabels...)
 	newDfColumns = append(newDfColumns, filtered.columns...)
@@ -59,3 +67,72 @@ func (gb *GroupBy) Agg(targetCol []string, aggFunc StatsFunc) (DataFrame, error)
 	newDf.SortByIndex(true)
 	return newDf, nil
 }
+
+// keyData returns the values of the group keys, one slice per grouping column.
+func (gb *GroupBy) keyData() [][]interface{} {
+	keyData := make([][]interface{}, len(gb.colTuples[0]))
+	for _, colTuple := range gb.colTuples {
+		for j, col := range colTuple {
+			keyData[j] = append(keyData[j], col)
+		}
+	}
+
+	return keyData
+}
+
+// groupData splits the data in ser into groups, in the same order as gb.colTuples.
+func (gb *GroupBy) groupData(ser *Series) ([][]interface{}, error) {
+	groups := make([][]interface{}, len(gb.colTuples))
+	for i, colTuple := range gb.colTuples {
+		colTupleIndex := Index{i, colTuple}
+		key, err := colTupleIndex.hashKeyValueOnly()
+		if err != nil {
+			return nil, err
+		}
+
+		indexForData := gb.colIndMap[*key]
+		data := make([]interface{}, 0)
+		for _, id := range indexForData {
+			d, err := ser.IAt(id.(int))
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, d)
+		}
+		groups[i] = data
+	}
+
+	return groups, nil
+}
+
+// groupPositions returns the row positions of each group, in the same order as gb.colTuples.
+// Rows are looked up by their index id, so the positions are correct even if the ids are not 0 to n-1.
+func (gb *GroupBy) groupPositions() ([][]int, error) {
+	positionOf := make(map[int]int, len(gb.dataFrame.index.index))
+	for pos, index := range gb.dataFrame.index.index {
+		positionOf[index.id] = pos
+	}
+
+	seen := make(map[string]bool)
+	groups := make([][]int, 0, len(gb.colTuples))
+	for i, colTuple := range gb.colTuples {
+		colTupleIndex := Index{i, colTuple}
+		key, err := colTupleIndex.hashKeyValueOnly()
+		if err != nil {
+			return nil, err
+		}
+		// tuples holding NaN are not deduplicated in colTuples, but share a key
+		if seen[*key] {
+			continue
+		}
+		seen[*key] = true
+
+		positions := make([]int, 0, len(gb.colIndMap[*key]))
+		for _, id := range gb.colIndMap[*key] {
+			positions = append(positions, positionOf[id.(int)])
+		}
+		groups = append(groups, positions)
+	}
+
+	return groups, nil
+}
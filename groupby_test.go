@@ -1,6 +1,8 @@
 package gambas
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -206,3 +208,224 @@ func TestGroupByAgg(t *testing.T) {
 		}
 	}
 }
+
+func BenchmarkGroupByAggAny(b *testing.B) {
+	newDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	gb, err := newDf.GroupBy("Team")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		gb.AggAny("Name", First)
+	}
+}
+
+func TestGroupByAggAny(t *testing.T) {
+	type aggAnyTest struct {
+		arg1          GroupBy
+		arg2          string
+		arg3          AggFunc
+		expected      DataFrame
+		expectedError error
+	}
+	aggAnyTests := []aggAnyTest{
+		{
+			func() GroupBy {
+				newDf, err := NewDataFrame([][]interface{}{{"A", "B", "A", "B", "A"}, {"", "Bob", "Cat", "Dan", "Eve"}, {true, false, false, true, true}}, []string{"Team", "Name", "Member"}, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				gb, err := newDf.GroupBy("Team")
+				if err != nil {
+					t.Error(err)
+				}
+				return gb
+			}(),
+			"Name",
+			First,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"A", "B"}, {"Cat", "Bob"}}, []string{"Team", "Name"}, []string{"Team"}),
+			nil,
+		},
+		{
+			func() GroupBy {
+				newDf, err := NewDataFrame([][]interface{}{{"A", "B", "A", "B", "A"}, {"", "Bob", "Cat", "Dan", "Eve"}, {true, false, false, true, true}}, []string{"Team", "Name", "Member"}, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				gb, err := newDf.GroupBy("Team")
+				if err != nil {
+					t.Error(err)
+				}
+				return gb
+			}(),
+			"Name",
+			Last,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"A", "B"}, {"Eve", "Dan"}}, []string{"Team", "Name"}, []string{"Team"}),
+			nil,
+		},
+		{
+			func() GroupBy {
+				newDf, err := NewDataFrame([][]interface{}{{"A", "B", "A", "B", "A"}, {"", "Bob", "Cat", "Dan", "Eve"}, {true, false, false, true, true}}, []string{"Team", "Name", "Member"}, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				gb, err := newDf.GroupBy("Team")
+				if err != nil {
+					t.Error(err)
+				}
+				return gb
+			}(),
+			"Member",
+			First,
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"A", "B"}, {true, false}}, []string{"Team", "Member"}, []string{"Team"}),
+			nil,
+		},
+		{
+			func() GroupBy {
+				newDf, err := NewDataFrame([][]interface{}{{"A", "B", "A", "B", "A"}, {"", "Bob", "Cat", "Dan", "Eve"}, {true, false, false, true, true}}, []string{"Team", "Name", "Member"}, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				gb, err := newDf.GroupBy("Team")
+				if err != nil {
+					t.Error(err)
+				}
+				return gb
+			}(),
+			"Height",
+			First,
+			DataFrame{},
+			fmt.Errorf("column does not exist: Height"),
+		},
+	}
+	for _, test := range aggAnyTests {
+		output, err := test.arg1.AggAny(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+// modeOfStrings returns the most common element in a dataset, or NaN if the dataset is empty.
+// Ties are broken by whichever element appears first.
+func modeOfStrings(dataset []interface{}) interface{} {
+	counts := make(map[interface{}]int)
+	var mode interface{} = math.NaN()
+	for _, v := range dataset {
+		counts[v]++
+		if isNaN(mode) || counts[v] > counts[mode] {
+			mode = v
+		}
+	}
+	return mode
+}
+
+func TestGroupByAggWith(t *testing.T) {
+	type aggWithTest struct {
+		arg1          GroupBy
+		arg2          []string
+		arg3          Aggregator
+		expected      DataFrame
+		expectedError error
+	}
+	aggWithTests := []aggWithTest{
+		{
+			func() GroupBy {
+				newDf, err := NewDataFrame([][]interface{}{{"A", "B", "A", "B", "A"}, {"red", "blue", "green", "blue", "red"}, {1.0, 2.0, 3.0, 4.0, 5.0}, {10, 20, 30, 40, 50}}, []string{"Team", "Color", "Score", "Points"}, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				gb, err := newDf.GroupBy("Team")
+				if err != nil {
+					t.Error(err)
+				}
+				return gb
+			}(),
+			[]string{"Color"},
+			AggFunc(modeOfStrings),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"A", "B"}, {"red", "blue"}}, []string{"Team", "Color"}, []string{"Team"}),
+			nil,
+		},
+		{
+			func() GroupBy {
+				newDf, err := NewDataFrame([][]interface{}{{"A", "B", "A", "B", "A"}, {"red", "blue", "green", "blue", "red"}, {1.0, 2.0, 3.0, 4.0, 5.0}, {10, 20, 30, 40, 50}}, []string{"Team", "Color", "Score", "Points"}, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				gb, err := newDf.GroupBy("Team")
+				if err != nil {
+					t.Error(err)
+				}
+				return gb
+			}(),
+			[]string{"Score", "Points"},
+			StatsFunc(Max),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"A", "B"}, {5.0, 4.0}, {50.0, 40.0}}, []string{"Team", "Score", "Points"}, []string{"Team"}),
+			nil,
+		},
+		{
+			func() GroupBy {
+				newDf, err := NewDataFrame([][]interface{}{{"A", "B", "A", "B", "A"}, {"red", "blue", "green", "blue", "red"}, {1.0, 2.0, 3.0, 4.0, 5.0}, {10, 20, 30, 40, 50}}, []string{"Team", "Color", "Score", "Points"}, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				gb, err := newDf.GroupBy("Team")
+				if err != nil {
+					t.Error(err)
+				}
+				return gb
+			}(),
+			[]string{"Color"},
+			StatsFunc(Mean),
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"A", "B"}, {math.NaN(), math.NaN()}}, []string{"Team", "Color"}, []string{"Team"}),
+			nil,
+		},
+	}
+	for _, test := range aggWithTests {
+		output, err := test.arg1.AggWith(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
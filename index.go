@@ -4,6 +4,7 @@ import (
 	"crypto/sha512"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 // Index stores the index values of a series and dataframe.
@@ -63,6 +64,53 @@ func (i Index) hashKeyValueOnly() (*string, error) {
 	return &resultHex, nil
 }
 
+// labelLookup finds index tuples by value in constant time, comparing labels like tuplesAreEqual does.
+type labelLookup struct {
+	labels    [][]interface{}
+	positions map[string][]int
+}
+
+// newLabelLookup creates a labelLookup holding the tuples of index, at their positions in index.
+func newLabelLookup(index []Index) *labelLookup {
+	lookup := &labelLookup{make([][]interface{}, 0, len(index)), make(map[string][]int, len(index))}
+	for _, i := range index {
+		lookup.add(i.value)
+	}
+	return lookup
+}
+
+// add appends label at the next position.
+func (l *labelLookup) add(label []interface{}) {
+	key := labelKey(label)
+	l.positions[key] = append(l.positions[key], len(l.labels))
+	l.labels = append(l.labels, label)
+}
+
+// find returns the first position of label, or -1 if label was never added.
+func (l *labelLookup) find(label []interface{}) int {
+	// tuples that are not equal can share a key, so each candidate is checked
+	for _, pos := range l.positions[labelKey(label)] {
+		if tuplesAreEqual(l.labels[pos], label) {
+			return pos
+		}
+	}
+	return -1
+}
+
+// labelKey returns a map key for an index tuple. Numbers are written by value, so that tuples equal under tuplesAreEqual get the same key.
+func labelKey(label []interface{}) string {
+	var sb strings.Builder
+	for _, val := range label {
+		if f, err := i2f(val); err == nil {
+			sb.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		} else {
+			fmt.Fprint(&sb, val)
+		}
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
 // IndexData type is used to hold index information of a Series or a DataFrame.
 type IndexData struct {
 	index []Index
@@ -77,6 +125,27 @@ func (id IndexData) Names() []string {
 	return id.names
 }
 
+// Contains checks whether the given index tuple exists in the IndexData.
+// For multiindex, you need to pass in the whole index tuple.
+// Use this to validate labels before calling LocRows.
+// Numeric labels are compared by value like in LocRows, so an int label of 2 matches 2.0.
+func (id IndexData) Contains(value []interface{}) bool {
+	for _, index := range id.index {
+		if tuplesAreEqual(index.value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// At returns the Index at the given position.
+func (id IndexData) At(pos int) (Index, error) {
+	if pos < 0 || pos >= len(id.index) {
+		return Index{}, fmt.Errorf("position %d is out of range for index of length %d", pos, len(id.index))
+	}
+	return id.index[pos], nil
+}
+
 // Len is used to implement the sort.Sort interface.
 func (id IndexData) Len() int {
 	return len(id.index)
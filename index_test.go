@@ -1,6 +1,7 @@
 package gambas
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 
@@ -140,3 +141,137 @@ func TestIndexHashKeyValueOnly(t *testing.T) {
 		}
 	}
 }
+
+func BenchmarkIndexDataContains(b *testing.B) {
+	index := make([][]interface{}, 10000)
+	for i := range index {
+		index[i] = []interface{}{i}
+	}
+	indexData, err := NewIndexData(index, []string{"id"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		indexData.Contains([]interface{}{rand.Intn(10000)})
+	}
+}
+
+func TestIndexDataContains(t *testing.T) {
+	type containsTest struct {
+		arg1     IndexData
+		arg2     []interface{}
+		expected bool
+	}
+	containsTests := []containsTest{
+		{
+			IndexData{
+				[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+				[]string{"Name"},
+			},
+			[]interface{}{"Bradley"},
+			true,
+		},
+		{
+			IndexData{
+				[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+				[]string{"Name"},
+			},
+			[]interface{}{"Candice"},
+			false,
+		},
+		{
+			IndexData{
+				[]Index{{0, []interface{}{"Avery", "Male"}}, {1, []interface{}{"Bradley", "Male"}}},
+				[]string{"Name", "Sex"},
+			},
+			[]interface{}{"Avery", "Male"},
+			true,
+		},
+		{
+			IndexData{
+				[]Index{{0, []interface{}{"Avery", "Male"}}, {1, []interface{}{"Bradley", "Male"}}},
+				[]string{"Name", "Sex"},
+			},
+			[]interface{}{"Avery"},
+			false,
+		},
+		{
+			CreateRangeIndex(3),
+			[]interface{}{2},
+			true,
+		},
+		{
+			CreateRangeIndex(3),
+			[]interface{}{2.0},
+			true,
+		},
+		{
+			CreateRangeIndex(3),
+			[]interface{}{"2"},
+			false,
+		},
+	}
+
+	for _, test := range containsTests {
+		output := test.arg1.Contains(test.arg2)
+		if output != test.expected {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func TestIndexDataAt(t *testing.T) {
+	type atTest struct {
+		arg1          IndexData
+		arg2          int
+		expected      Index
+		expectedError error
+	}
+	testIndex := IndexData{
+		[]Index{{3, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+		[]string{"Name"},
+	}
+	atTests := []atTest{
+		{testIndex, 0, Index{3, []interface{}{"Avery"}}, nil},
+		{testIndex, 1, Index{1, []interface{}{"Bradley"}}, nil},
+		{testIndex, 2, Index{}, fmt.Errorf("position 2 is out of range for index of length 2")},
+		{testIndex, -1, Index{}, fmt.Errorf("position -1 is out of range for index of length 2")},
+	}
+
+	for _, test := range atTests {
+		output, err := test.arg1.At(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Index{})) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestIndexLabelLookup(t *testing.T) {
+	lookup := newLabelLookup([]Index{
+		{0, []interface{}{"a", 1}},
+		{1, []interface{}{"b", 2.5}},
+		{2, []interface{}{"3", 4}},
+		{3, []interface{}{"a", 1}},
+	})
+
+	type labelLookupTest struct {
+		arg1     []interface{}
+		expected int
+	}
+	labelLookupTests := []labelLookupTest{
+		{[]interface{}{"a", 1}, 0},
+		{[]interface{}{"a", 1.0}, 0},
+		{[]interface{}{"b", 2.5}, 1},
+		{[]interface{}{3, 4}, -1},
+		{[]interface{}{"3", 4}, 2},
+		{[]interface{}{"a"}, -1},
+	}
+	for _, test := range labelLookupTests {
+		output := lookup.find(test.arg1)
+		if output != test.expected {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
@@ -2,56 +2,377 @@ package gambas
 
 import (
 	"bufio"
+	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"math"
+	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/xuri/excelize/v2"
 )
 
+// NullTokens holds the strings that readers treat as missing values. Empty cells are always missing.
+// It is consulted by ReadCsv, ReadCsvWithSep, ReadExcel, ReadJsonByColumns, and ReadJsonStream.
+// Set it before reading if your data marks missing values differently, e.g. with "NA", "null", "N/A", or "-".
+var NullTokens = []string{"NaN"}
+
+// floatFormat and nanRep control how float64 values and missing values are written in Print and the CSV writers.
+var (
+	floatFormat = ""
+	nanRep      = "NaN"
+)
+
+// SetFloatFormat sets the format used to write float64 values in Print and the CSV writers, e.g. "%.4f".
+// Pass in "" to write floats with fmt.Sprint, which is the default.
+// If format is not a valid format for a float64, an error is returned and the current format is kept.
+func SetFloatFormat(format string) error {
+	if format != "" && strings.Contains(fmt.Sprintf(format, 1.0), "%!") {
+		return fmt.Errorf("invalid float format %q", format)
+	}
+	floatFormat = format
+	return nil
+}
+
+// SetNaNRep sets the string used to write missing values in Print and the CSV writers. The default is "NaN".
+// Pass in "" to leave missing cells empty. Empty cells are always read back as missing,
+// and so is "NaN" as long as it is in NullTokens.
+func SetNaNRep(rep string) {
+	nanRep = rep
+}
+
+// A CsvOpt represents an option used when reading a CSV file.
+type CsvOpt func(*csvConfig)
+
+// csvConfig holds the options set by CsvOpt values.
+type csvConfig struct {
+	hasHeader bool
+	skipRows  int
+	nRows     int
+	client    *http.Client
+	timeout   time.Duration
+}
+
+// newCsvConfig applies opts on top of the default options.
+func newCsvConfig(opts []CsvOpt) csvConfig {
+	cfg := csvConfig{hasHeader: true, skipRows: 0, nRows: -1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// CsvHasHeader sets whether the first row of a CSV file holds the column names. The default is true.
+// If hasHeader is false, every row is read as data and the columns are named A, B, C, ... like in a spreadsheet.
+func CsvHasHeader(hasHeader bool) CsvOpt {
+	return func(cfg *csvConfig) {
+		cfg.hasHeader = hasHeader
+	}
+}
+
+// CsvSkipRows skips the first n lines of a CSV file, such as a banner placed before the header.
+// The skipped lines are not parsed, so they do not need to have the same number of fields as the rest of the file.
+func CsvSkipRows(n int) CsvOpt {
+	return func(cfg *csvConfig) {
+		cfg.skipRows = n
+	}
+}
+
+// CsvNRows limits reading to the first n data rows of a CSV file. The header row is not counted.
+// n must be greater than 0. By default, every row is read.
+func CsvNRows(n int) CsvOpt {
+	return func(cfg *csvConfig) {
+		cfg.nRows = n
+	}
+}
+
+// CsvHttpClient sets the client ReadCsvURL uses to send the request. The default is http.DefaultClient.
+func CsvHttpClient(client *http.Client) CsvOpt {
+	return func(cfg *csvConfig) {
+		cfg.client = client
+	}
+}
+
+// CsvTimeout limits how long ReadCsvURL waits for the whole response, including reading the body.
+// The default of 0 means no limit other than the one set on the client.
+func CsvTimeout(timeout time.Duration) CsvOpt {
+	return func(cfg *csvConfig) {
+		cfg.timeout = timeout
+	}
+}
+
 // ReadCsv reads a CSV file and returns a new DataFrame object.
+// Each cell is parsed as a bool, int, float64, or string, and each column is then given a single dtype,
+// so int and bool columns keep their types instead of becoming float64 or string.
+// Optionally pass in CsvOpt values to change how the file is read.
 // It is recommended to generate pathToFile using `filepath.Join`.
-func ReadCsv(pathToFile string, indexCols []string) (DataFrame, error) {
-	return ReadCsvWithSep(pathToFile, indexCols, ",")
+func ReadCsv(pathToFile string, indexCols []string, opts ...CsvOpt) (DataFrame, error) {
+	return ReadCsvWithSep(pathToFile, indexCols, ",", opts...)
 }
 
 // ReadCsvWithSep reads a CSV file with special sep and returns a new DataFrame object.
+// sep must be a single character.
+// Optionally pass in CsvOpt values to change how the file is read.
 // It is recommended to generate pathToFile using `filepath.Join`.
-func ReadCsvWithSep(pathToFile string, indexCols []string, sep string) (DataFrame, error) {
+func ReadCsvWithSep(pathToFile string, indexCols []string, sep string, opts ...CsvOpt) (DataFrame, error) {
+	var df DataFrame
+	err := readCsvRows(pathToFile, sep, newCsvConfig(opts), -1, func(columns []string, data [][]interface{}) error {
+		// create new DataFrame object
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			return err
+		}
+		df = newDf
+		return nil
+	})
+	if err != nil {
+		return DataFrame{}, err
+	}
+
+	return df, nil
+}
+
+// ReadCsvURL sends a GET request to url and reads the response body as a CSV file, without saving it to disk first.
+// Responses with a status code other than 2xx are returned as an error.
+// Optionally pass in CsvOpt values to change how the data is read, or to set the client and timeout with CsvHttpClient and CsvTimeout.
+func ReadCsvURL(url string, indexCols []string, opts ...CsvOpt) (DataFrame, error) {
+	cfg := newCsvConfig(opts)
+	if err := cfg.validate(","); err != nil {
+		return DataFrame{}, err
+	}
+
+	ctx := context.Background()
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return DataFrame{}, err
+	}
+
+	client := cfg.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return DataFrame{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return DataFrame{}, fmt.Errorf("cannot read %v: %v", url, resp.Status)
+	}
+
+	var df DataFrame
+	err = readCsvRowsFrom(resp.Body, ",", cfg, -1, func(columns []string, data [][]interface{}) error {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			return err
+		}
+		df = newDf
+		return nil
+	})
+	if err != nil {
+		return DataFrame{}, err
+	}
+
+	return df, nil
+}
+
+// ReadCsvGlob reads every CSV file matching pattern, which uses the syntax of `filepath.Match`,
+// and stacks them into a single DataFrame object in the order of their paths.
+// All files must have the same columns in the same order. The index ids are renumbered like in Concat.
+// If sourceCol is not empty, a column with that name is added, holding the path of the file each row came from
+// relative to the directory the pattern starts from, so files with the same name in different directories can be told apart.
+// Optionally pass in CsvOpt values to change how the files are read.
+func ReadCsvGlob(pattern string, indexCols []string, sourceCol string, opts ...CsvOpt) (DataFrame, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return DataFrame{}, err
+	}
+	if len(paths) == 0 {
+		return DataFrame{}, fmt.Errorf("no files match %v", pattern)
+	}
+
+	root := globRoot(pattern)
+	dfs := make([]DataFrame, len(paths))
+	keys := make([]string, len(paths))
+	for i, path := range paths {
+		df, err := ReadCsv(path, indexCols, opts...)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		if i > 0 && !stringSlicesAreEqual(df.columns, dfs[0].columns) {
+			return DataFrame{}, fmt.Errorf("columns %v of %v do not match columns %v of %v", df.columns, path, dfs[0].columns, paths[0])
+		}
+		dfs[i] = df
+		keys[i], err = filepath.Rel(root, path)
+		if err != nil {
+			return DataFrame{}, err
+		}
+	}
+
+	if sourceCol != "" {
+		dfs, err = addSourceColumn(dfs, keys, sourceCol)
+		if err != nil {
+			return DataFrame{}, err
+		}
+	}
+	return Concat(dfs, 0)
+}
+
+// ReadCsvChunks reads a CSV file in chunks of at most chunkSize rows, and calls fn with each chunk as a DataFrame object.
+// Only one chunk is held in memory at a time, so files that are too large for ReadCsv can still be processed.
+// Index ids keep counting up across chunks, and so does the RangeIndex if indexCols is nil.
+// The dtypes of the columns are inferred from the first chunk, and the values of later chunks are converted to them,
+// so that every chunk has the same dtypes.
+// If a value cannot be converted, such as a decimal in a column that was inferred as int, an error is returned.
+// A column with no values in the first chunk is inferred as string, like in ReadCsv.
+// Pass in a larger chunkSize if the first chunk is not representative of the file.
+// Index columns keep the values as they are read.
+// Reading stops at the first error returned by fn, and that error is returned.
+// Optionally pass in CsvOpt values to change how the file is read.
+func ReadCsvChunks(pathToFile string, chunkSize int, indexCols []string, fn func(DataFrame) error, opts ...CsvOpt) error {
+	if chunkSize < 1 {
+		return fmt.Errorf("chunkSize must be greater than 0, got %d", chunkSize)
+	}
+
+	offset := 0
+	var dtypes []string
+	return readCsvRows(pathToFile, ",", newCsvConfig(opts), chunkSize, func(columns []string, data [][]interface{}) error {
+		chunkColumns := make([]string, len(columns))
+		copy(chunkColumns, columns)
+		chunk, err := NewDataFrame(data, chunkColumns, indexCols)
+		if err != nil {
+			return err
+		}
+
+		if dtypes == nil {
+			dtypes = make([]string, len(chunk.series))
+			for i, ser := range chunk.series {
+				dtypes[i] = ser.dtype
+			}
+		} else {
+			for i := range chunk.series {
+				if containsString(chunk.index.names, chunk.series[i].name) {
+					continue
+				}
+				if err := coerceSeries(&chunk.series[i], dtypes[i]); err != nil {
+					return err
+				}
+			}
+		}
+
+		shiftIndex := func(index []Index) {
+			for i := range index {
+				index[i].id += offset
+				if indexCols == nil {
+					index[i].value = []interface{}{index[i].id}
+				}
+			}
+		}
+		shiftIndex(chunk.index.index)
+		for i := range chunk.series {
+			shiftIndex(chunk.series[i].index.index)
+		}
+		offset += chunk.index.Len()
+
+		return fn(chunk)
+	})
+}
+
+// readCsvRows reads a CSV file and calls fn with the column names and the data of every batchSize rows.
+// See readCsvRowsFrom for details.
+func readCsvRows(pathToFile, sep string, cfg csvConfig, batchSize int, fn func(columns []string, data [][]interface{}) error) error {
+	if err := cfg.validate(sep); err != nil {
+		return err
+	}
+
 	// read line by line
 	f, err := os.Open(pathToFile)
 	if err != nil {
-		return DataFrame{}, err
+		return err
 	}
 	defer f.Close()
-	csvr := csv.NewReader(f)
+
+	return readCsvRowsFrom(f, sep, cfg, batchSize, fn)
+}
+
+// validate checks sep and the options in cfg before anything is read.
+func (cfg csvConfig) validate(sep string) error {
+	if utf8.RuneCountInString(sep) != 1 {
+		return fmt.Errorf("sep must be a single character, got %q", sep)
+	}
+	if cfg.skipRows < 0 {
+		return fmt.Errorf("skiprows cannot be negative, got %d", cfg.skipRows)
+	}
+	if cfg.nRows == 0 || cfg.nRows < -1 {
+		return fmt.Errorf("nrows must be greater than 0, got %d", cfg.nRows)
+	}
+	if cfg.timeout < 0 {
+		return fmt.Errorf("timeout cannot be negative, got %v", cfg.timeout)
+	}
+	return nil
+}
+
+// readCsvRowsFrom reads CSV data from r and calls fn with the column names and the data of every batchSize rows.
+// The data is grouped by column, and each cell is converted with tryDataType.
+// If batchSize is -1, all of the data is passed to fn in a single call, even when it has no data rows.
+func readCsvRowsFrom(r io.Reader, sep string, cfg csvConfig, batchSize int, fn func(columns []string, data [][]interface{}) error) error {
+	if err := cfg.validate(sep); err != nil {
+		return err
+	}
+
+	br := bufio.NewReader(r)
+	for i := 0; i < cfg.skipRows; i++ {
+		_, err := br.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+	}
+	csvr := csv.NewReader(br)
+	csvr.Comma, _ = utf8.DecodeRuneInString(sep)
 
 	rowNum := 0
+	dataRowNum := 0
+	batchRowNum := 0
 	columnArray := make([]string, 0)
 	data2DArray := make([][]interface{}, 0)
 	for {
+		if dataRowNum == cfg.nRows {
+			break
+		}
 		row, err := csvr.Read()
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			log.Fatal(err)
+			return err
 		}
-		newRow := strings.Join(row, "")
-		row = strings.Split(newRow, sep)
-		// first line is column name
-		if rowNum == 0 {
+		// first line is column name, unless the file has no header
+		if rowNum == 0 && cfg.hasHeader {
 			// add to columnArray
 			columnArray = append(columnArray, row...)
 			rowNum++
 			continue
 		}
-		// second line onwards is the actual data
+		if rowNum == 0 {
+			for i := range row {
+				columnArray = append(columnArray, generateAlphabets(i+1))
+			}
+		}
+		// the rest is the actual data
 		for i, v := range row {
 			// add to data2DArray
 			if len(data2DArray) < len(row) {
@@ -62,14 +383,22 @@ func ReadCsvWithSep(pathToFile string, indexCols []string, sep string) (DataFram
 			data2DArray[i] = append(data2DArray[i], vChecked)
 		}
 		rowNum++
-	}
-	// create new DataFrame object and return it
-	df, err := NewDataFrame(data2DArray, columnArray, indexCols)
-	if err != nil {
-		return DataFrame{}, err
+		dataRowNum++
+		batchRowNum++
+
+		if batchRowNum == batchSize {
+			if err := fn(columnArray, data2DArray); err != nil {
+				return err
+			}
+			data2DArray = make([][]interface{}, 0)
+			batchRowNum = 0
+		}
 	}
 
-	return df, nil
+	if batchRowNum > 0 || batchSize == -1 {
+		return fn(columnArray, data2DArray)
+	}
+	return nil
 }
 
 // WriteCsv writes a DataFrame object to CSV file.
@@ -78,55 +407,72 @@ func WriteCsv(df DataFrame, pathToFile string, skipColumnLabel bool) (os.FileInf
 	return WriteCsvWithSep(df, pathToFile, skipColumnLabel, ",")
 }
 
-// WriteCsvWithSep writes a DataFrame object to CSV file.
-// The param is sep but head and index is also needed
+// WriteCsvWithSep writes a DataFrame object to CSV file, using sep to separate the fields.
+// sep must be a single character.
+// Fields that contain sep, a double quote, or a line break are quoted, so they can be read back.
 // It is recommended to generate pathToFile using `filepath.Join`.
 func WriteCsvWithSep(df DataFrame, pathToFile string, skipColumnLabel bool, sep string) (os.FileInfo, error) {
+	if utf8.RuneCountInString(sep) != 1 {
+		return nil, fmt.Errorf("sep must be a single character, got %q", sep)
+	}
+
 	f, err := os.Create(pathToFile)
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
 
-	w := bufio.NewWriter(f)
+	w := csv.NewWriter(f)
+	w.Comma, _ = utf8.DecodeRuneInString(sep)
+
 	// write column names in the first row
 	if !skipColumnLabel {
-		for i, col := range df.columns {
-			_, err := w.WriteString(col)
-			if err != nil {
-				return nil, err
-			}
-
-			if i+1 != len(df.columns) {
-				_, err := w.WriteString(fmt.Sprintf("%s", sep))
-				if err != nil {
-					return nil, err
-				}
-			}
+		if err := w.Write(df.columns); err != nil {
+			return nil, err
 		}
-		w.WriteString("\n")
 	}
 
 	// write the data in the following rows
-	for i := range df.series[0].data {
-		for j, ser := range df.series {
-			_, err := w.WriteString(fmt.Sprint(ser.data[i]))
-			if err != nil {
-				return nil, err
-			}
+	if err := writeCsvRows(w, df); err != nil {
+		return nil, err
+	}
 
-			if j+1 != len(df.series) {
-				_, err := w.WriteString(fmt.Sprintf("%s", sep))
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
+	info, err := os.Stat(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
 
-		w.WriteString("\n")
+// WriteCsvAppend appends the rows of a DataFrame object to a CSV file.
+// If the file does not exist or is empty, it is created and the column names are written in the first row.
+// Otherwise, the first row of the file must match the columns of df, and only the data is appended.
+// It is recommended to generate pathToFile using `filepath.Join`.
+func WriteCsvAppend(df DataFrame, pathToFile string) (os.FileInfo, error) {
+	f, err := os.OpenFile(pathToFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
 	}
+	defer f.Close()
 
-	w.Flush()
+	header, err := csv.NewReader(f).Read()
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	w := csv.NewWriter(f)
+	if err == io.EOF {
+		// write column names in the first row of a new file
+		if err := w.Write(df.columns); err != nil {
+			return nil, err
+		}
+	} else if !stringSlicesAreEqual(header, df.columns) {
+		return nil, fmt.Errorf("header %v of %v does not match columns %v", header, pathToFile, df.columns)
+	}
+
+	if err := writeCsvRows(w, df); err != nil {
+		return nil, err
+	}
 
 	info, err := os.Stat(pathToFile)
 	if err != nil {
@@ -135,6 +481,89 @@ func WriteCsvWithSep(df DataFrame, pathToFile string, skipColumnLabel bool, sep
 	return info, nil
 }
 
+// writeCsvRows writes the data of df to w, one record per row, and flushes w.
+func writeCsvRows(w *csv.Writer, df DataFrame) error {
+	record := make([]string, len(df.series))
+	for i := range df.index.index {
+		for j, ser := range df.series {
+			record[j] = formatCell(ser.data[i])
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// ReadTsv reads a tab-separated file and returns a new DataFrame object.
+// This is the same as calling ReadCsvWithSep with a tab as sep.
+// Optionally pass in CsvOpt values to change how the file is read.
+// It is recommended to generate pathToFile using `filepath.Join`.
+func ReadTsv(pathToFile string, indexCols []string, opts ...CsvOpt) (DataFrame, error) {
+	return ReadCsvWithSep(pathToFile, indexCols, "\t", opts...)
+}
+
+// WriteTsv writes a DataFrame object to a tab-separated file.
+// Fields that contain a tab, a double quote, or a line break are quoted.
+// It is recommended to generate pathToFile using `filepath.Join`.
+func WriteTsv(df DataFrame, pathToFile string, skipColumnLabel bool) (os.FileInfo, error) {
+	return WriteCsvWithSep(df, pathToFile, skipColumnLabel, "\t")
+}
+
+// ReadFwf reads a fixed-width file and returns a new DataFrame object.
+// colSpecs gives the [start, end) character range of each column, and columns gives their names.
+// The file should not have a header row. Blank lines are skipped.
+// Surrounding whitespace is trimmed from each field, which is then parsed the same way as in ReadCsv.
+// It is recommended to generate pathToFile using `filepath.Join`.
+func ReadFwf(pathToFile string, colSpecs [][2]int, columns []string, indexCols []string) (DataFrame, error) {
+	if len(colSpecs) != len(columns) {
+		return DataFrame{}, fmt.Errorf("length of colSpecs (%d) and columns (%d) does not match", len(colSpecs), len(columns))
+	}
+	for _, spec := range colSpecs {
+		if spec[0] < 0 || spec[0] >= spec[1] {
+			return DataFrame{}, fmt.Errorf("invalid column range [%d, %d)", spec[0], spec[1])
+		}
+	}
+
+	f, err := os.Open(pathToFile)
+	if err != nil {
+		return DataFrame{}, err
+	}
+	defer f.Close()
+
+	data := make([][]interface{}, len(columns))
+	for i := range data {
+		data[i] = make([]interface{}, 0)
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := []rune(strings.TrimRight(scanner.Text(), "\r"))
+		if strings.TrimSpace(string(line)) == "" {
+			continue
+		}
+
+		for i, spec := range colSpecs {
+			field := ""
+			if spec[0] < len(line) {
+				end := spec[1]
+				if end > len(line) {
+					end = len(line)
+				}
+				field = strings.TrimSpace(string(line[spec[0]:end]))
+			}
+			data[i] = append(data[i], tryDataType(field))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return DataFrame{}, err
+	}
+
+	return NewDataFrame(data, columns, indexCols)
+}
+
 // ReadJson reads a JSON file and returns a new DataFrame object.
 // It is recommended to generate pathToFile using `filepath.Join`.
 // The JSON file should be in this format:
@@ -150,13 +579,11 @@ func ReadJsonByColumns(pathToFile string, indexCols []string) (DataFrame, error)
 	}
 	defer f.Close()
 
-	fbyte, err := io.ReadAll(f)
-	if err != nil {
-		return DataFrame{}, err
-	}
-
+	// numbers are decoded as json.Number, so that integers can be told apart from floats
+	dec := json.NewDecoder(f)
+	dec.UseNumber()
 	var decoded map[string]interface{}
-	err = json.Unmarshal(fbyte, &decoded)
+	err = dec.Decode(&decoded)
 	if err != nil {
 		return DataFrame{}, err
 	}
@@ -167,12 +594,15 @@ func ReadJsonByColumns(pathToFile string, indexCols []string) (DataFrame, error)
 
 	for col, colData := range decoded {
 		newDfCols = append(newDfCols, col)
-		colDataAsserted := colData.([]interface{})
-		for i, cda := range colDataAsserted {
-			checked := checkJsonDataType(cda)
-			colDataAsserted[i] = checked
+		colDataAsserted, ok := colData.([]interface{})
+		if !ok {
+			return DataFrame{}, fmt.Errorf("column '%v' is not a JSON array", col)
 		}
-		newDfData = append(newDfData, colDataAsserted)
+		consolidated, err := consolidateJsonColumn(colDataAsserted)
+		if err != nil {
+			return DataFrame{}, err
+		}
+		newDfData = append(newDfData, consolidated)
 
 		if indexCols != nil && containsString(indexCols, col) {
 			newDfIndexNames = append(newDfIndexNames, col)
@@ -195,7 +625,9 @@ func ReadJsonByColumns(pathToFile string, indexCols []string) (DataFrame, error)
 
 // ReadJsonStream reads a JSON stream and returns a new DataFrame object.
 // The JSON file should be in this format:
-// {"col1":val1, "col2":val2, ...}{"col1":val1, "col2":val2, ...}
+// [{"col1":val1, "col2":val2, ...}, {"col1":val1, "col2":val2, ...}]
+// Columns are ordered by their first appearance in the stream, with the index columns moved to the front.
+// A key that is missing from an object is read as a missing value for that row.
 func ReadJsonStream(pathToFile string, indexCols []string) (DataFrame, error) {
 	f, err := os.Open(pathToFile)
 	if err != nil {
@@ -204,6 +636,7 @@ func ReadJsonStream(pathToFile string, indexCols []string) (DataFrame, error) {
 	defer f.Close()
 
 	dec := json.NewDecoder(f)
+	dec.UseNumber()
 	newDfCols := make([]string, 0)
 	colData := make(map[string][]interface{}, 0)
 
@@ -211,18 +644,24 @@ func ReadJsonStream(pathToFile string, indexCols []string) (DataFrame, error) {
 	if err != nil {
 		return DataFrame{}, err
 	}
+	rowNum := 0
 	for dec.More() {
-		var row map[string]interface{}
-		err := dec.Decode(&row)
-		if err == io.EOF {
-			break
-		} else if err != nil {
+		keys, row, err := decodeJsonObject(dec)
+		if err != nil {
 			return DataFrame{}, err
 		}
 
-		for k, v := range row {
-			colData[k] = append(colData[k], checkJsonDataType(v))
+		for _, k := range keys {
+			if _, ok := colData[k]; !ok {
+				// backfill the rows read before the column first appeared
+				newDfCols = append(newDfCols, k)
+				colData[k] = make([]interface{}, rowNum)
+			}
+		}
+		for _, col := range newDfCols {
+			colData[col] = append(colData[col], row[col])
 		}
+		rowNum++
 	}
 	_, err = dec.Token()
 	if err != nil {
@@ -231,21 +670,57 @@ func ReadJsonStream(pathToFile string, indexCols []string) (DataFrame, error) {
 
 	newDfData := make([][]interface{}, 0)
 
-	for k, v := range colData {
-		newDfCols = append(newDfCols, k)
-		newDfData = append(newDfData, v)
+	for _, col := range newDfCols {
+		consolidated, err := consolidateJsonColumn(colData[col])
+		if err != nil {
+			return DataFrame{}, err
+		}
+		newDfData = append(newDfData, consolidated)
 	}
 
 	newDf, err := NewDataFrame(newDfData, newDfCols, indexCols)
 	if err != nil {
 		return DataFrame{}, err
 	}
-	newDf.SortByColumns()
 	newDf.SortByIndex(true)
 	newDf.SortIndexColFirst()
 	return newDf, nil
 }
 
+// decodeJsonObject decodes the next JSON object from dec.
+// It returns the keys in the order they appear, since decoding into a map loses the order.
+func decodeJsonObject(dec *json.Decoder) ([]string, map[string]interface{}, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if delim, ok := t.(json.Delim); !ok || delim != '{' {
+		return nil, nil, fmt.Errorf("expected a JSON object, got %v", t)
+	}
+
+	keys := make([]string, 0)
+	values := make(map[string]interface{})
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := t.(string)
+		var value interface{}
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
 // WriteJson writes a DataFrame object to a file.
 func WriteJson(df DataFrame, pathToFile string) (os.FileInfo, error) {
 	f, err := os.Create(pathToFile)
@@ -270,6 +745,81 @@ func WriteJson(df DataFrame, pathToFile string) (os.FileInfo, error) {
 	return info, nil
 }
 
+// WriteJsonTo streams a DataFrame object as JSON to w, without building the whole payload in memory first.
+// orient sets the layout of the JSON:
+// "columns" writes {"col1":[val1, val2, ...], "col2":[val1, val2, ...], ...}, which ReadJsonByColumns can read.
+// "records" writes [{"col1":val1, "col2":val2, ...}, ...], which ReadJsonStream can read. The keys of each record are written in the order of the columns.
+// "series" writes the same layout as MarshalJSON.
+// NaN values are written as null.
+func WriteJsonTo(df DataFrame, w io.Writer, orient string) error {
+	enc := json.NewEncoder(w)
+	switch orient {
+	case "series":
+		return enc.Encode(df)
+	case "columns":
+		if _, err := io.WriteString(w, "{"); err != nil {
+			return err
+		}
+		for i, ser := range df.series {
+			if i > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			name, err := json.Marshal(ser.name)
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(append(name, ':')); err != nil {
+				return err
+			}
+			data := make([]interface{}, len(ser.data))
+			for j, d := range ser.data {
+				data[j] = jsonValue(d)
+			}
+			if err := enc.Encode(data); err != nil {
+				return err
+			}
+		}
+		_, err := io.WriteString(w, "}\n")
+		return err
+	case "records":
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+		for i := range df.index.index {
+			if i > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			// each record is written key by key, so that the keys keep the order of the columns
+			record := []byte{'{'}
+			for j, ser := range df.series {
+				if j > 0 {
+					record = append(record, ',')
+				}
+				name, err := json.Marshal(ser.name)
+				if err != nil {
+					return err
+				}
+				value, err := json.Marshal(jsonValue(ser.data[i]))
+				if err != nil {
+					return err
+				}
+				record = append(append(append(record, name...), ':'), value...)
+			}
+			if _, err := w.Write(append(record, '}', '\n')); err != nil {
+				return err
+			}
+		}
+		_, err := io.WriteString(w, "]\n")
+		return err
+	default:
+		return fmt.Errorf("orient must be \"columns\", \"records\", or \"series\", got %q", orient)
+	}
+}
+
 // ReadExcel reads an excel file and converts it to a DataFrame object.
 // The axis depends on the layout of the data.
 // Row-based data where each group represents a row will have an axis=0.
@@ -1,9 +1,19 @@
 package gambas
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -639,10 +649,1074 @@ func TestIoReadCsv(t *testing.T) {
 				[]string{"Name", "Team", "Number", "Position", "Age", "Height", "Weight", "College", "Salary"},
 			},
 		},
+		{
+			filepath.Join("testfiles", "testnullableint1.csv"),
+			nil,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{1, 2, NA},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Id",
+						"int",
+					},
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, NA, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Id", "Name", "Age"},
+			},
+		},
+		{
+			filepath.Join("testfiles", "testcsvtypes1.csv"),
+			nil,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 25, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{true, false, true},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Member",
+						"bool",
+					},
+					{
+						[]interface{}{7.5, 8.0, 6.25},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Member", "Score"},
+			},
+		},
+	}
+
+	for _, test := range readCsvTests {
+		output, err := ReadCsv(test.arg1, test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestIoReadCsvWithSep(t *testing.T) {
+	type readCsvWithSepTest struct {
+		arg1     string
+		arg2     []string
+		arg3     string
+		expected DataFrame
+		err      error
+	}
+
+	readCsvWithSepTests := []readCsvWithSepTest{
+		{
+			filepath.Join("testfiles", "readcsvsep1.csv"),
+			nil,
+			";",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 25, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "readcsvsep1.csv"),
+			nil,
+			"",
+			DataFrame{},
+			fmt.Errorf("sep must be a single character, got \"\""),
+		},
+		{
+			filepath.Join("testfiles", "readcsvsep1.csv"),
+			nil,
+			";;",
+			DataFrame{},
+			fmt.Errorf("sep must be a single character, got \";;\""),
+		},
+	}
+
+	for _, test := range readCsvWithSepTests {
+		output, err := ReadCsvWithSep(test.arg1, test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.err) {
+			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestIoReadCsvOpts(t *testing.T) {
+	type readCsvOptsTest struct {
+		arg1          string
+		arg2          []string
+		arg3          []CsvOpt
+		expected      DataFrame
+		expectedError error
+	}
+
+	readCsvOptsTests := []readCsvOptsTest{
+		{
+			filepath.Join("testfiles", "testnoheader1.csv"),
+			nil,
+			[]CsvOpt{CsvHasHeader(false)},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"A",
+						"string",
+					},
+					{
+						[]interface{}{19, 25, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"B",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"C",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"A", "B", "C"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "testnoheader1.csv"),
+			[]string{"A"},
+			[]CsvOpt{CsvHasHeader(false)},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradford"}}, {2, []interface{}{"Candice"}}},
+							[]string{"A"},
+						},
+						"A",
+						"string",
+					},
+					{
+						[]interface{}{19, 25, 22},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradford"}}, {2, []interface{}{"Candice"}}},
+							[]string{"A"},
+						},
+						"B",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradford"}}, {2, []interface{}{"Candice"}}},
+							[]string{"A"},
+						},
+						"C",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradford"}}, {2, []interface{}{"Candice"}}},
+					[]string{"A"},
+				},
+				[]string{"A", "B", "C"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "test1.csv"),
+			nil,
+			[]CsvOpt{CsvHasHeader(true)},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 25, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "testskiprows1.csv"),
+			nil,
+			[]CsvOpt{CsvSkipRows(2), CsvNRows(3)},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 25, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "testskiprows1.csv"),
+			nil,
+			[]CsvOpt{CsvSkipRows(2)},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice", "Daniel", "Evan"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 25, 22, 30, 6},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male", "Female", "Male", "Male"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+							[]string{""},
+						},
+						"Sex",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Sex"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "testskiprows1.csv"),
+			nil,
+			[]CsvOpt{CsvSkipRows(3), CsvHasHeader(false), CsvNRows(2)},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"A",
+						"string",
+					},
+					{
+						[]interface{}{19, 25},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"B",
+						"int",
+					},
+					{
+						[]interface{}{"Male", "Male"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+							[]string{""},
+						},
+						"C",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
+					[]string{""},
+				},
+				[]string{"A", "B", "C"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "testskiprows1.csv"),
+			nil,
+			[]CsvOpt{CsvSkipRows(-1)},
+			DataFrame{},
+			fmt.Errorf("skiprows cannot be negative, got -1"),
+		},
+		{
+			filepath.Join("testfiles", "testskiprows1.csv"),
+			nil,
+			[]CsvOpt{CsvSkipRows(2), CsvNRows(0)},
+			DataFrame{},
+			fmt.Errorf("nrows must be greater than 0, got 0"),
+		},
+	}
+
+	for _, test := range readCsvOptsTests {
+		output, err := ReadCsv(test.arg1, test.arg2, test.arg3...)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestIoReadCsvChunks(t *testing.T) {
+	type readCsvChunksTest struct {
+		arg1           string
+		arg2           int
+		arg3           []string
+		expectedRows   int
+		expectedChunks int
+		expectedError  error
+	}
+
+	readCsvChunksTests := []readCsvChunksTest{
+		{filepath.Join("testfiles", "nba.csv"), 100, nil, 458, 5, nil},
+		{filepath.Join("testfiles", "nba.csv"), 458, []string{"Name"}, 458, 1, nil},
+		{filepath.Join("testfiles", "nba.csv"), 1000, nil, 458, 1, nil},
+		{filepath.Join("testfiles", "test1.csv"), 2, nil, 3, 2, nil},
+		{filepath.Join("testfiles", "test1.csv"), 0, nil, 0, 0, fmt.Errorf("chunkSize must be greater than 0, got 0")},
+	}
+
+	for _, test := range readCsvChunksTests {
+		rows := 0
+		chunks := 0
+		err := ReadCsvChunks(test.arg1, test.arg2, test.arg3, func(chunk DataFrame) error {
+			for i, index := range chunk.index.index {
+				if index.id != rows+i {
+					return fmt.Errorf("expected index id %d, got %d", rows+i, index.id)
+				}
+			}
+			rows += chunk.index.Len()
+			chunks++
+			return nil
+		})
+		if rows != test.expectedRows || chunks != test.expectedChunks || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v rows in %v chunks, got %v rows in %v chunks, error %v", test.expectedRows, test.expectedChunks, rows, chunks, err)
+		}
+	}
+
+	chunks := 0
+	err := ReadCsvChunks(filepath.Join("testfiles", "nba.csv"), 100, nil, func(chunk DataFrame) error {
+		chunks++
+		return fmt.Errorf("stop")
+	})
+	if chunks != 1 || fmt.Sprint(err) != "stop" {
+		t.Fatalf("expected reading to stop after 1 chunk, got %v chunks, error %v", chunks, err)
+	}
+}
+
+func TestIoReadCsvChunksDtypes(t *testing.T) {
+	type readCsvChunksDtypesTest struct {
+		arg1          string
+		arg2          int
+		arg3          []string
+		expected      []DataFrame
+		expectedError error
+	}
+
+	readCsvChunksDtypesTests := []readCsvChunksDtypesTest{
+		{
+			filepath.Join("testfiles", "readcsvchunks1.csv"),
+			2,
+			[]string{"Name"},
+			[]DataFrame{
+				DataFrame{
+					[]Series{
+						{
+							[]interface{}{"Avery", "Bradley"},
+							IndexData{
+								[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+								[]string{"Name"},
+							},
+							"Name",
+							"string",
+						},
+						{
+							[]interface{}{19, 20},
+							IndexData{
+								[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+								[]string{"Name"},
+							},
+							"Age",
+							"int",
+						},
+						{
+							[]interface{}{1.5, 2.5},
+							IndexData{
+								[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+								[]string{"Name"},
+							},
+							"Height",
+							"float64",
+						},
+						{
+							[]interface{}{"red", "blue"},
+							IndexData{
+								[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+								[]string{"Name"},
+							},
+							"Note",
+							"string",
+						},
+					},
+					IndexData{
+						[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+						[]string{"Name"},
+					},
+					[]string{"Name", "Age", "Height", "Note"},
+				},
+				DataFrame{
+					[]Series{
+						{
+							[]interface{}{"Candice"},
+							IndexData{
+								[]Index{{2, []interface{}{"Candice"}}},
+								[]string{"Name"},
+							},
+							"Name",
+							"string",
+						},
+						{
+							[]interface{}{NA},
+							IndexData{
+								[]Index{{2, []interface{}{"Candice"}}},
+								[]string{"Name"},
+							},
+							"Age",
+							"int",
+						},
+						{
+							[]interface{}{3.0},
+							IndexData{
+								[]Index{{2, []interface{}{"Candice"}}},
+								[]string{"Name"},
+							},
+							"Height",
+							"float64",
+						},
+						{
+							[]interface{}{"7"},
+							IndexData{
+								[]Index{{2, []interface{}{"Candice"}}},
+								[]string{"Name"},
+							},
+							"Note",
+							"string",
+						},
+					},
+					IndexData{
+						[]Index{{2, []interface{}{"Candice"}}},
+						[]string{"Name"},
+					},
+					[]string{"Name", "Age", "Height", "Note"},
+				},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "readcsvchunks2.csv"),
+			2,
+			[]string{"Name"},
+			nil,
+			fmt.Errorf("cannot convert column 'Score' to int: 2.5 is not a whole number"),
+		},
+		{
+			filepath.Join("testfiles", "readcsvchunks3.csv"),
+			2,
+			[]string{"Name"},
+			[]DataFrame{
+				DataFrame{
+					[]Series{
+						{
+							[]interface{}{"Avery", "Bradley"},
+							IndexData{
+								[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+								[]string{"Name"},
+							},
+							"Name",
+							"string",
+						},
+						{
+							[]interface{}{math.NaN(), math.NaN()},
+							IndexData{
+								[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+								[]string{"Name"},
+							},
+							"Score",
+							"string",
+						},
+					},
+					IndexData{
+						[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}},
+						[]string{"Name"},
+					},
+					[]string{"Name", "Score"},
+				},
+				DataFrame{
+					[]Series{
+						{
+							[]interface{}{"Candice"},
+							IndexData{
+								[]Index{{2, []interface{}{"Candice"}}},
+								[]string{"Name"},
+							},
+							"Name",
+							"string",
+						},
+						{
+							[]interface{}{"4.5"},
+							IndexData{
+								[]Index{{2, []interface{}{"Candice"}}},
+								[]string{"Name"},
+							},
+							"Score",
+							"string",
+						},
+					},
+					IndexData{
+						[]Index{{2, []interface{}{"Candice"}}},
+						[]string{"Name"},
+					},
+					[]string{"Name", "Score"},
+				},
+			},
+			nil,
+		},
+	}
+
+	for _, test := range readCsvChunksDtypesTests {
+		var output []DataFrame
+		err := ReadCsvChunks(test.arg1, test.arg2, test.arg3, func(chunk DataFrame) error {
+			output = append(output, chunk)
+			return nil
+		})
+		if test.expectedError != nil {
+			if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+				t.Fatalf("expected error %v, got %v", test.expectedError, err)
+			}
+			continue
+		}
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestIoReadCsvURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/test1.csv":
+			http.ServeFile(w, r, filepath.Join("testfiles", "test1.csv"))
+		case "/slow.csv":
+			time.Sleep(200 * time.Millisecond)
+			http.ServeFile(w, r, filepath.Join("testfiles", "test1.csv"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	type readCsvURLTest struct {
+		arg1          string
+		arg2          []string
+		arg3          []CsvOpt
+		expectedFile  string
+		expectedError error
+	}
+
+	readCsvURLTests := []readCsvURLTest{
+		{server.URL + "/test1.csv", nil, nil, filepath.Join("testfiles", "test1.csv"), nil},
+		{server.URL + "/test1.csv", []string{"Name"}, []CsvOpt{CsvHttpClient(server.Client())}, filepath.Join("testfiles", "test1.csv"), nil},
+		{server.URL + "/test1.csv", nil, []CsvOpt{CsvNRows(2)}, filepath.Join("testfiles", "test1.csv"), nil},
+		{server.URL + "/missing.csv", nil, nil, "", fmt.Errorf("cannot read %v/missing.csv: 404 Not Found", server.URL)},
+		{server.URL + "/test1.csv", nil, []CsvOpt{CsvTimeout(-time.Second)}, "", fmt.Errorf("timeout cannot be negative, got -1s")},
+	}
+
+	for _, test := range readCsvURLTests {
+		expected := DataFrame{}
+		if test.expectedFile != "" {
+			var err error
+			expected, err = ReadCsv(test.expectedFile, test.arg2, test.arg3...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		output, err := ReadCsvURL(test.arg1, test.arg2, test.arg3...)
+		if !cmp.Equal(output, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", expected, output, err)
+		}
+	}
+
+	_, err := ReadCsvURL(server.URL+"/slow.csv", nil, CsvTimeout(50*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestIoReadCsvGlob(t *testing.T) {
+	type readCsvGlobTest struct {
+		arg1          string
+		arg2          []string
+		arg3          string
+		expected      DataFrame
+		expectedError error
+	}
+
+	readCsvGlobTests := []readCsvGlobTest{
+		{
+			filepath.Join("testfiles", "readcsvglob", "sales-*.csv"),
+			nil,
+			"",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{0}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Celtics", "Celtics", "Lakers"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{0}}},
+							[]string{""},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{12, 7, 21},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{0}}},
+							[]string{""},
+						},
+						"Points",
+						"int",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{0}}},
+					[]string{""},
+				},
+				[]string{"Name", "Team", "Points"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "readcsvglob", "sales-*.csv"),
+			[]string{"Name"},
+			"File",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Celtics", "Celtics", "Lakers"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{12, 7, 21},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Points",
+						"int",
+					},
+					{
+						[]interface{}{"sales-1.csv", "sales-1.csv", "sales-2.csv"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"File",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Team", "Points", "File"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "readcsvglob", "parts", "*", "part.csv"),
+			nil,
+			"File",
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"Celtics", "Lakers"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}},
+							[]string{""},
+						},
+						"Team",
+						"string",
+					},
+					{
+						[]interface{}{12, 21},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}},
+							[]string{""},
+						},
+						"Points",
+						"int",
+					},
+					{
+						[]interface{}{filepath.Join("a", "part.csv"), filepath.Join("b", "part.csv")},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}},
+							[]string{""},
+						},
+						"File",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{0}}},
+					[]string{""},
+				},
+				[]string{"Name", "Team", "Points", "File"},
+			},
+			nil,
+		},
+		{
+			filepath.Join("testfiles", "readcsvglob", "*.csv"),
+			nil,
+			"",
+			DataFrame{},
+			fmt.Errorf("columns [Name Points Team] of %v do not match columns [Name Team Points] of %v", filepath.Join("testfiles", "readcsvglob", "teams-1.csv"), filepath.Join("testfiles", "readcsvglob", "sales-1.csv")),
+		},
+		{
+			filepath.Join("testfiles", "readcsvglob", "sales-*.csv"),
+			nil,
+			"Team",
+			DataFrame{},
+			fmt.Errorf("column 'Team' already exists in sales-1.csv"),
+		},
+		{
+			filepath.Join("testfiles", "readcsvglob", "none-*.csv"),
+			nil,
+			"",
+			DataFrame{},
+			fmt.Errorf("no files match %v", filepath.Join("testfiles", "readcsvglob", "none-*.csv")),
+		},
+	}
+
+	for _, test := range readCsvGlobTests {
+		output, err := ReadCsvGlob(test.arg1, test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func TestIoNullTokens(t *testing.T) {
+	type nullTokensTest struct {
+		arg1     []string
+		arg2     func() (DataFrame, error)
+		expected DataFrame
+	}
+	nullTokensTests := []nullTokensTest{
+		{
+			[]string{"NaN", "NA", "null"},
+			func() (DataFrame, error) { return ReadCsv(filepath.Join("testfiles", "testnulltokens1.csv"), nil) },
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, NA, 22},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{math.NaN(), 7.5, 8.0},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Score"},
+			},
+		},
+		{
+			[]string{"NaN"},
+			func() (DataFrame, error) { return ReadCsv(filepath.Join("testfiles", "testnulltokens1.csv"), nil) },
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{"19", "null", "22"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"string",
+					},
+					{
+						[]interface{}{"NA", "7.5", "8"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Score",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Score"},
+			},
+		},
+		{
+			[]string{"NaN", "NA", "null"},
+			func() (DataFrame, error) {
+				return ReadJsonByColumns(filepath.Join("testfiles", "readjsonbycolumns", "3.json"), nil)
+			},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradford", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{math.NaN(), 7.5, math.NaN()},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Score",
+						"float64",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Score"},
+			},
+		},
 	}
 
-	for _, test := range readCsvTests {
-		output, err := ReadCsv(test.arg1, test.arg2)
+	defaultTokens := NullTokens
+	defer func() { NullTokens = defaultTokens }()
+
+	for _, test := range nullTokensTests {
+		NullTokens = test.arg1
+		output, err := test.arg2()
 		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
 			t.Fatalf("expected %v,\ngot %v,\nerror %v", test.expected, output, err)
 		}
@@ -707,6 +1781,183 @@ func TestIoWriteCsv(t *testing.T) {
 	}
 }
 
+func TestIoWriteCsvFloatFormat(t *testing.T) {
+	defer func(format, rep string) {
+		SetFloatFormat(format)
+		SetNaNRep(rep)
+	}(floatFormat, nanRep)
+	if err := SetFloatFormat("%.2f"); err != nil {
+		t.Fatal(err)
+	}
+	SetNaNRep("")
+
+	testDf, err := NewDataFrame(
+		[][]interface{}{{"Avery", "Bradley", "Candice"}, {19, NA, 22}, {1.0 / 3.0, math.NaN(), 1e-7}},
+		[]string{"Name", "Age", "Score"},
+		[]string{"Name"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pathToFile := filepath.Join(t.TempDir(), "floatformat.csv")
+	if _, err := WriteCsv(testDf, pathToFile, false); err != nil {
+		t.Fatal(err)
+	}
+	output, err := os.ReadFile(pathToFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "Name,Age,Score\nAvery,19,0.33\nBradley,,\nCandice,22,0.00\n"
+	if string(output) != expected {
+		t.Fatalf("expected %q, got %q", expected, string(output))
+	}
+
+	// the missing values are read back as missing
+	readDf, err := ReadCsv(pathToFile, []string{"Name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	age, err := readDf.LocCol("Age")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if age.dtype != "int" || !isNaN(age.data[1]) {
+		t.Fatalf("expected an int column with a missing value, got %v", age)
+	}
+}
+
+func TestIoWriteCsvAppend(t *testing.T) {
+	testDf := func(data [][]interface{}, columns []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, nil)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}
+	pathToFile := filepath.Join(t.TempDir(), "append.csv")
+
+	first := testDf([][]interface{}{{"Avery", "Bradley"}, {19, 26}}, []string{"Name", "Age"})
+	second := testDf([][]interface{}{{"Candice"}, {23}}, []string{"Name", "Age"})
+	for _, df := range []DataFrame{first, second} {
+		if _, err := WriteCsvAppend(df, pathToFile); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	expected := testDf([][]interface{}{{"Avery", "Bradley", "Candice"}, {19, 26, 23}}, []string{"Name", "Age"})
+	output, err := ReadCsv(pathToFile, nil)
+	if !cmp.Equal(output, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", expected, output, err)
+	}
+
+	mismatched := testDf([][]interface{}{{"Candice"}, {23}}, []string{"Name", "Score"})
+	_, err = WriteCsvAppend(mismatched, pathToFile)
+	expectedError := fmt.Errorf("header [Name Age] of %v does not match columns [Name Score]", pathToFile)
+	if fmt.Sprint(err) != fmt.Sprint(expectedError) {
+		t.Fatalf("expected error %v, got %v", expectedError, err)
+	}
+	output, err = ReadCsv(pathToFile, nil)
+	if !cmp.Equal(output, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected the file to be unchanged, got %v, error %v", output, err)
+	}
+}
+
+func BenchmarkIoReadTsv(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ReadTsv(filepath.Join("testfiles", "readtsv1.tsv"), []string{"Gene"})
+	}
+}
+
+func TestIoReadTsv(t *testing.T) {
+	expected := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{"BRCA1", "TP53", "EGFR"}, {17, 17, 7}, {2.5, 3.75, 1.25}, {"DNA repair", "tumor\tsuppressor", ""}}, []string{"Gene", "Chromosome", "Expression", "Note"}, []string{"Gene"})
+
+	output, err := ReadTsv(filepath.Join("testfiles", "readtsv1.tsv"), []string{"Gene"})
+	if !cmp.Equal(output, expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", expected, output, err)
+	}
+}
+
+func TestIoWriteTsv(t *testing.T) {
+	// fields with tabs, quotes, and line breaks must survive a round trip.
+	testDf := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{"Avery", "Bradley", "Candice"}, {"likes\ttabs", "say \"hi\"", "two\nlines"}, {19, 27, 22}}, []string{"Name", "Comment", "Age"}, []string{"Name"})
+
+	pathToFile := filepath.Join(t.TempDir(), "writetsv.tsv")
+	if _, err := WriteTsv(testDf, pathToFile, false); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := ReadTsv(pathToFile, []string{"Name"})
+	if !cmp.Equal(output, testDf, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{})) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", testDf, output, err)
+	}
+
+	_, err = WriteCsvWithSep(testDf, pathToFile, false, "::")
+	if fmt.Sprint(err) != `sep must be a single character, got "::"` {
+		t.Fatalf("expected a sep error, got %v", err)
+	}
+}
+
+func BenchmarkIoReadFwf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ReadFwf(filepath.Join("testfiles", "readfwf1.txt"), [][2]int{{0, 8}, {8, 12}, {12, 18}, {18, 40}}, []string{"Gene", "Chromosome", "Expression", "Note"}, []string{"Gene"})
+	}
+}
+
+func TestIoReadFwf(t *testing.T) {
+	type readFwfTest struct {
+		arg1          [][2]int
+		arg2          []string
+		expected      DataFrame
+		expectedError error
+	}
+	readFwfTests := []readFwfTest{
+		{
+			[][2]int{{0, 8}, {8, 12}, {12, 18}, {18, 40}},
+			[]string{"Gene", "Chromosome", "Expression", "Note"},
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"BRCA1", "TP53", "EGFR"}, {17, 17, 7}, {2.5, 3.75, 1.25}, {"DNA repair", "tumor suppressor", ""}}, []string{"Gene", "Chromosome", "Expression", "Note"}, []string{"Gene"}),
+			nil,
+		},
+		{
+			[][2]int{{0, 8}, {8, 12}},
+			[]string{"Gene", "Chromosome", "Expression", "Note"},
+			DataFrame{},
+			fmt.Errorf("length of colSpecs (2) and columns (4) does not match"),
+		},
+		{
+			[][2]int{{0, 8}, {8, 12}, {12, 12}, {18, 40}},
+			[]string{"Gene", "Chromosome", "Expression", "Note"},
+			DataFrame{},
+			fmt.Errorf("invalid column range [12, 12)"),
+		},
+	}
+
+	for _, test := range readFwfTests {
+		output, err := ReadFwf(filepath.Join("testfiles", "readfwf1.txt"), test.arg1, test.arg2, []string{"Gene"})
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
 func BenchmarkIoReadJsonByColumns(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		ReadJsonByColumns("testfiles/1.json", []string{"Name"})
@@ -844,6 +2095,55 @@ func TestIoReadJsonByColumns(t *testing.T) {
 				[]string{"Name", "Age", "Sex"},
 			},
 		},
+		{
+			"testfiles/readjsonbycolumns/4.json",
+			[]string{"Name"},
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{0, 99, NA},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Number",
+						"int",
+					},
+					{
+						[]interface{}{1.0, 2.5, 3.0},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Score",
+						"float64",
+					},
+					{
+						[]interface{}{true, false, true},
+						IndexData{
+							[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+							[]string{"Name"},
+						},
+						"Starter",
+						"bool",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{"Avery"}}, {1, []interface{}{"Bradley"}}, {2, []interface{}{"Candice"}}},
+					[]string{"Name"},
+				},
+				[]string{"Name", "Number", "Score", "Starter"},
+			},
+		},
 	}
 	for _, test := range readJsonByColumnsTests {
 		output, err := ReadJsonByColumns(test.arg1, test.arg2)
@@ -990,6 +2290,46 @@ func TestIoReadJsonStream(t *testing.T) {
 				[]string{"Name", "Age", "Sex"},
 			},
 		},
+		{
+			"testfiles/readjsonstream/3.json",
+			nil,
+			DataFrame{
+				[]Series{
+					{
+						[]interface{}{"Avery", "Bradley", "Candice"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Name",
+						"string",
+					},
+					{
+						[]interface{}{19, 26, NA},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Age",
+						"int",
+					},
+					{
+						[]interface{}{math.NaN(), "Boston Celtics", "Utah Jazz"},
+						IndexData{
+							[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+							[]string{""},
+						},
+						"Team",
+						"string",
+					},
+				},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				[]string{"Name", "Age", "Team"},
+			},
+		},
 	}
 	for _, test := range readJsonStreamTests {
 		output, err := ReadJsonStream(test.arg1, test.arg2)
@@ -1049,6 +2389,163 @@ func TestIoWriteJson(t *testing.T) {
 	}
 }
 
+func BenchmarkIoWriteJsonTo(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		WriteJsonTo(testDf, io.Discard, "records")
+	}
+}
+
+func TestIoWriteJsonTo(t *testing.T) {
+	type writeJsonToTest struct {
+		arg1          DataFrame
+		arg2          string
+		expected      string
+		expectedError error
+	}
+	writeJsonToTests := []writeJsonToTest{
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 26.0, 23.0}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"columns",
+			`{"Name":["Avery","Bradley","Candice"],"Age":[19,26,23],"Score":[1.5,null,3.5]}`,
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 26.0, 23.0}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"records",
+			`[{"Age":19,"Name":"Avery","Score":1.5},{"Age":26,"Name":"Bradley","Score":null},{"Age":23,"Name":"Candice","Score":3.5}]`,
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 26.0, 23.0}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"series",
+			`{"series":[{"data":["Avery","Bradley","Candice"],"name":"Name","dtype":"string"},{"data":[19,26,23],"name":"Age","dtype":"float64"},{"data":[1.5,null,3.5],"name":"Score","dtype":"float64"}],"index":{"index":[{"id":0,"value":["Avery"]},{"id":1,"value":["Bradley"]},{"id":2,"value":["Candice"]}],"names":["Name"]},"columns":["Name","Age","Score"]}`,
+			nil,
+		},
+		{
+			func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+				newDf, err := NewDataFrame(data, columns, indexCols)
+				if err != nil {
+					t.Error(err)
+				}
+				return newDf
+			}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 26.0, 23.0}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Age", "Score"}, []string{"Name"}),
+			"index",
+			"",
+			fmt.Errorf("orient must be \"columns\", \"records\", or \"series\", got \"index\""),
+		},
+	}
+
+	for _, test := range writeJsonToTests {
+		var buf bytes.Buffer
+		err := WriteJsonTo(test.arg1, &buf, test.arg2)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+		if err != nil {
+			continue
+		}
+
+		// compare the decoded values, since the streamed output contains newlines between elements.
+		var output, expected interface{}
+		if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+			t.Fatalf("output is not valid JSON: %v", err)
+		}
+		if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(output, expected) {
+			t.Fatalf("expected %v, got %v", expected, output)
+		}
+	}
+}
+
+func TestIoWriteJsonToRoundTrip(t *testing.T) {
+	testDf := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{"Avery", "Bradley", "Candice"}, {19.0, 26.0, 23.0}, {0, NA, 8}, {1.5, math.NaN(), 3.5}}, []string{"Name", "Age", "Number", "Score"}, []string{"Name"})
+
+	type roundTripTest struct {
+		arg1 string
+		arg2 func(pathToFile string, indexCols []string) (DataFrame, error)
+	}
+	roundTripTests := []roundTripTest{
+		{"columns", ReadJsonByColumns},
+		{"records", ReadJsonStream},
+	}
+
+	for _, test := range roundTripTests {
+		var buf bytes.Buffer
+		if err := WriteJsonTo(testDf, &buf, test.arg1); err != nil {
+			t.Fatal(err)
+		}
+
+		pathToFile := filepath.Join(t.TempDir(), "roundtrip.json")
+		if err := os.WriteFile(pathToFile, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+		output, err := test.arg2(pathToFile, []string{"Name"})
+		if !cmp.Equal(output, testDf, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+			t.Fatalf("expected %v, got %v, error %v", testDf, output, err)
+		}
+	}
+}
+
+func TestIoWriteJsonToRecordsOrder(t *testing.T) {
+	testDf := func(data [][]interface{}, columns []string, indexCols []string) DataFrame {
+		newDf, err := NewDataFrame(data, columns, indexCols)
+		if err != nil {
+			t.Error(err)
+		}
+		return newDf
+	}([][]interface{}{{1.5, math.NaN()}, {"Avery", "Bradley"}, {19, 26}}, []string{"Score", "Name", "Age"}, nil)
+
+	var buf bytes.Buffer
+	if err := WriteJsonTo(testDf, &buf, "records"); err != nil {
+		t.Fatal(err)
+	}
+	expected := "[{\"Score\":1.5,\"Name\":\"Avery\",\"Age\":19}\n,{\"Score\":null,\"Name\":\"Bradley\",\"Age\":26}\n]\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+
+	pathToFile := filepath.Join(t.TempDir(), "records.json")
+	if err := os.WriteFile(pathToFile, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output, err := ReadJsonStream(pathToFile, nil)
+	if !cmp.Equal(output, testDf, cmp.AllowUnexported(DataFrame{}, Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || err != nil {
+		t.Fatalf("expected %v, got %v, error %v", testDf, output, err)
+	}
+}
+
 func TestReadExcel(t *testing.T) {
 	type readExcelTest struct {
 		arg1     string
@@ -1134,7 +2631,7 @@ func TestReadExcel(t *testing.T) {
 						"string",
 					},
 					{
-						[]interface{}{19.0, 26.0, math.NaN()},
+						[]interface{}{19, 26, NA},
 						IndexData{
 							[]Index{
 								{0, []interface{}{0}},
@@ -1144,7 +2641,7 @@ func TestReadExcel(t *testing.T) {
 							[]string{""},
 						},
 						"Age",
-						"float64",
+						"int",
 					},
 					{
 						[]interface{}{"Male", "Male", math.NaN()},
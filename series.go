@@ -17,6 +17,23 @@ type Series struct {
 	dtype string
 }
 
+// NA marks a missing value in an int Series.
+// Int columns keep their int dtype when some values are missing by storing NA in place of each missing entry,
+// whereas float64 and string columns use math.NaN().
+var NA = naValue{}
+
+type naValue struct{}
+
+// String prints NA the same way as a float64 NaN.
+func (naValue) String() string {
+	return "NaN"
+}
+
+// MarshalJSON is used to implement the json.Marshaler interface{}.
+func (naValue) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
 func (s Series) Data() []interface{} {
 	return s.data
 }
@@ -57,6 +74,7 @@ func (s Series) Swap(i, j int) {
 }
 
 // Print prints all data in a Series object.
+// Floats and missing values are written as set by SetFloatFormat and SetNaNRep.
 func (s *Series) Print() {
 	w := new(tabwriter.Writer)
 
@@ -71,14 +89,14 @@ func (s *Series) Print() {
 	for i := 0; i < len(s.data); i++ {
 		if len(s.index.index[i].value) > 1 {
 			for j := range s.index.index[i].value {
-				fmt.Fprint(w, s.index.index[i].value[j], "\t")
+				fmt.Fprint(w, formatCell(s.index.index[i].value[j]), "\t")
 			}
 		} else {
-			fmt.Fprint(w, s.index.index[i].value[0], "\t")
+			fmt.Fprint(w, formatCell(s.index.index[i].value[0]), "\t")
 		}
 
 		fmt.Fprint(w, "|", "\t")
-		fmt.Fprint(w, s.data[i], "\t")
+		fmt.Fprint(w, formatCell(s.data[i]), "\t")
 		fmt.Fprintln(w)
 	}
 	w.Flush()
@@ -100,14 +118,14 @@ func (s *Series) PrintRange(start, end int) {
 	for i := start; i < end; i++ {
 		if len(s.index.index[i].value) > 1 {
 			for j := range s.index.index[i].value {
-				fmt.Fprint(w, s.index.index[i].value[j], "\t")
+				fmt.Fprint(w, formatCell(s.index.index[i].value[j]), "\t")
 			}
 		} else {
-			fmt.Fprint(w, s.index.index[i].value[0], "\t")
+			fmt.Fprint(w, formatCell(s.index.index[i].value[0]), "\t")
 		}
 
 		fmt.Fprint(w, "|", "\t")
-		fmt.Fprint(w, s.data[i], "\t")
+		fmt.Fprint(w, formatCell(s.data[i]), "\t")
 		fmt.Fprintln(w)
 	}
 	w.Flush()
@@ -115,14 +133,56 @@ func (s *Series) PrintRange(start, end int) {
 
 /* Indexing methods */
 
-// Head prints the first howMany items in a Series object.
-func (s *Series) Head(howMany int) {
-	s.PrintRange(0, howMany)
+// Head returns the first howMany items in a Series object as a new Series.
+// If howMany is larger than the length of the Series, the whole Series is returned.
+// If howMany is negative, an empty Series is returned.
+// Use Head(howMany).Print() to print them.
+func (s Series) Head(howMany int) Series {
+	end := howMany
+	if end > len(s.data) {
+		end = len(s.data)
+	}
+	return s.slice(0, end)
 }
 
-// Tail prints the last howMany items in a Series object.
-func (s *Series) Tail(howMany int) {
-	s.PrintRange(len(s.data)-howMany, len(s.data))
+// Tail returns the last howMany items in a Series object as a new Series.
+// If howMany is larger than the length of the Series, the whole Series is returned.
+// If howMany is negative, an empty Series is returned.
+func (s Series) Tail(howMany int) Series {
+	start := len(s.data) - howMany
+	if start < 0 {
+		start = 0
+	}
+	return s.slice(start, len(s.data))
+}
+
+// slice returns the items from start up to but not including end as a new Series, along with their index.
+// An empty Series is returned if start is not less than end.
+func (s Series) slice(start, end int) Series {
+	if start < 0 {
+		start = 0
+	}
+	if end < 0 {
+		end = 0
+	}
+	if end > len(s.data) {
+		end = len(s.data)
+	}
+	if start > end {
+		start = end
+	}
+
+	newSeries := Series{
+		make([]interface{}, end-start),
+		IndexData{make([]Index, end-start), make([]string, len(s.index.names))},
+		s.name,
+		s.dtype,
+	}
+	copy(newSeries.data, s.data[start:end])
+	copy(newSeries.index.index, s.index.index[start:end])
+	copy(newSeries.index.names, s.index.names)
+
+	return newSeries
 }
 
 // At returns an element at a given index.
@@ -140,7 +200,7 @@ func (s *Series) At(ind ...interface{}) (interface{}, error) {
 			return s.data[i], nil
 		}
 	}
-	return nil, fmt.Errorf("the given index does not match any of the index in the series: %v", ind)
+	return nil, fmt.Errorf("%w: %v", ErrIndexNotFound, ind)
 }
 
 // IAt returns an element at a given integer index.
@@ -159,11 +219,9 @@ func (s *Series) IAt(ind int) (interface{}, error) {
 // For multiindex Series, you can either pass in the whole index tuple, or the first index.
 func (s *Series) Loc(idx ...[]interface{}) (Series, error) {
 	// This makes sure that each index passed are the same length.
-	indexLength := len(idx[0])
-	for i, eachIndex := range idx {
-		if indexLength != len(eachIndex) {
-			return Series{}, fmt.Errorf("index length does not match: %v, %v", idx[i-1], eachIndex)
-		}
+	indexLength, err := s.checkLocIndexLength(idx)
+	if err != nil {
+		return Series{}, err
 	}
 
 	allFiltered := make([]interface{}, 0)
@@ -176,7 +234,7 @@ func (s *Series) Loc(idx ...[]interface{}) (Series, error) {
 		for j, seriesIndex := range s.index.index {
 			isSame := true
 			for k := 0; k < indexLength; k++ {
-				if inputIndex[k] != seriesIndex.value[k] {
+				if !labelsAreEqual(inputIndex[k], seriesIndex.value[k]) {
 					isSame = false
 					break
 				}
@@ -204,11 +262,9 @@ func (s *Series) Loc(idx ...[]interface{}) (Series, error) {
 
 // LocItems acts the exact same as Loc, but returns data as []interface{} instead of Series.
 func (s *Series) LocItems(idx ...[]interface{}) ([]interface{}, error) {
-	indexLength := len(idx[0])
-	for i, eachIndex := range idx {
-		if indexLength != len(eachIndex) {
-			return nil, fmt.Errorf("index length does not match: %v, %v", idx[i-1], eachIndex)
-		}
+	indexLength, err := s.checkLocIndexLength(idx)
+	if err != nil {
+		return nil, err
 	}
 
 	allFiltered := make([]interface{}, 0)
@@ -219,7 +275,7 @@ func (s *Series) LocItems(idx ...[]interface{}) ([]interface{}, error) {
 		for j, seriesIndex := range s.index.index {
 			isSame := true
 			for k := 0; k < indexLength; k++ {
-				if inputIndex[k] != seriesIndex.value[k] {
+				if !labelsAreEqual(inputIndex[k], seriesIndex.value[k]) {
 					isSame = false
 					break
 				}
@@ -238,6 +294,27 @@ func (s *Series) LocItems(idx ...[]interface{}) ([]interface{}, error) {
 	return allFiltered, nil
 }
 
+// checkLocIndexLength makes sure that every index tuple passed to Loc or LocItems has the same length,
+// and that the length does not exceed the number of index levels in the Series.
+func (s *Series) checkLocIndexLength(idx [][]interface{}) (int, error) {
+	if len(idx) == 0 {
+		return 0, fmt.Errorf("no index was given")
+	}
+
+	indexLength := len(idx[0])
+	for i, eachIndex := range idx {
+		if indexLength != len(eachIndex) {
+			return 0, fmt.Errorf("index length does not match: %v, %v", idx[i-1], eachIndex)
+		}
+	}
+
+	if len(s.index.index) > 0 && indexLength > len(s.index.index[0].value) {
+		return 0, fmt.Errorf("index length %d is greater than the number of index levels %d", indexLength, len(s.index.index[0].value))
+	}
+
+	return indexLength, nil
+}
+
 // ILoc returns an array of elements at a given integer index range.
 func (s *Series) ILoc(min, max int) ([]interface{}, error) {
 	result := make([]interface{}, 0)
@@ -254,11 +331,12 @@ func (s *Series) ILoc(min, max int) ([]interface{}, error) {
 
 /* Summary statistics methods. These are Series-specific, unlike the ones in stats.go. */
 
-// Count counts the number of non-NaN elements in a column.
+// Count counts the number of non-missing elements in a column.
+// This works for columns of any dtype. NaN, NA, nil, and the strings "NaN" and "" are treated as missing.
 func (s *Series) Count() StatsResult {
 	count := 0
 	for _, v := range s.data {
-		if v != nil || v != math.NaN() {
+		if !isNaN(v) && v != "" {
 			count++
 		}
 	}
@@ -307,7 +385,7 @@ func (s *Series) Mean() StatsResult {
 	}
 
 	mean /= float64(len(data))
-	return StatsResult{"Mean", math.Round(mean*1000) / 1000, nil}
+	return StatsResult{"Mean", roundStat(mean), nil}
 
 	// conc 1
 
@@ -376,6 +454,38 @@ func (s *Series) Mean() StatsResult {
 	// return StatsResult{"Mean", roundedMean, nil}
 }
 
+// Sum returns the sum of the elements in a column.
+// NaN values are skipped, and an empty column sums to 0.
+func (s *Series) Sum() StatsResult {
+	data, err := interface2F64Slice(s.data)
+	if err != nil {
+		return StatsResult{"Sum", math.NaN(), err}
+	}
+
+	sum := 0.0
+	for _, v := range data {
+		sum += v
+	}
+
+	return StatsResult{"Sum", sum, nil}
+}
+
+// Prod returns the product of the elements in a column.
+// NaN values are skipped, and the product of an empty column is 1.
+func (s *Series) Prod() StatsResult {
+	data, err := interface2F64Slice(s.data)
+	if err != nil {
+		return StatsResult{"Prod", math.NaN(), err}
+	}
+
+	prod := 1.0
+	for _, v := range data {
+		prod *= v
+	}
+
+	return StatsResult{"Prod", prod, nil}
+}
+
 // Median returns the median of the elements in a column.
 func (s *Series) Median() StatsResult {
 	// new median algorithm using the quickselect algorithm and Hoare's partition scheme
@@ -396,7 +506,7 @@ func (s *Series) Median() StatsResult {
 		median = quickSelect(data, 0, total-1, total/2)
 	}
 
-	return StatsResult{"Median", math.Round(median*1000) / 1000, nil}
+	return StatsResult{"Median", roundStat(median), nil}
 
 	// data, err := interface2F64Slice(s.data)
 	// if err != nil {
@@ -466,7 +576,7 @@ func (s *Series) Std() StatsResult {
 	}
 
 	std := math.Sqrt(numerator / float64(len(data)-1))
-	return StatsResult{"Std", math.Round(std*1000) / 1000, nil}
+	return StatsResult{"Std", roundStat(std), nil}
 }
 
 // Min returns the smallest element in a column.
@@ -581,14 +691,14 @@ func (s *Series) Q1() StatsResult {
 		if err != nil {
 			return StatsResult{"Q1", math.NaN(), err}
 		}
-		return StatsResult{"Q1", q1, nil}
+		return StatsResult{"Q1", roundStat(q1), nil}
 	} else {
 		lower := data[:(len(data)-1)/2]
 		q1, err := median(lower)
 		if err != nil {
 			return StatsResult{"Q1", math.NaN(), err}
 		}
-		return StatsResult{"Q1", q1, nil}
+		return StatsResult{"Q1", roundStat(q1), nil}
 	}
 }
 
@@ -618,14 +728,14 @@ func (s *Series) Q3() StatsResult {
 		if err != nil {
 			return StatsResult{"Q3", math.NaN(), err}
 		}
-		return StatsResult{"Q3", q3, nil}
+		return StatsResult{"Q3", roundStat(q3), nil}
 	} else {
 		upper := data[(len(data)+1)/2:]
 		q3, err := median(upper)
 		if err != nil {
 			return StatsResult{"Q3", math.NaN(), err}
 		}
-		return StatsResult{"Q3", q3, nil}
+		return StatsResult{"Q3", roundStat(q3), nil}
 	}
 }
 
@@ -690,6 +800,42 @@ func (s *Series) Describe() ([]StatsResult, error) {
 	return result, nil
 }
 
+// Autocorr returns the correlation between the Series and itself shifted by lag positions.
+// Pairs where either element is NaN are skipped.
+func (s *Series) Autocorr(lag int) (float64, error) {
+	if s.dtype != "float64" && s.dtype != "int" {
+		return math.NaN(), fmt.Errorf("series %w", ErrTypeMismatch)
+	}
+	if lag < 0 || lag >= len(s.data) {
+		return math.NaN(), fmt.Errorf("lag must be between 0 and %d, got %d", len(s.data)-1, lag)
+	}
+
+	x := make([]float64, 0)
+	y := make([]float64, 0)
+	for i := 0; i+lag < len(s.data); i++ {
+		if isNaN(s.data[i]) || isNaN(s.data[i+lag]) {
+			continue
+		}
+		xi, err := i2f(s.data[i])
+		if err != nil {
+			return math.NaN(), err
+		}
+		yi, err := i2f(s.data[i+lag])
+		if err != nil {
+			return math.NaN(), err
+		}
+		x = append(x, xi)
+		y = append(y, yi)
+	}
+
+	corr, err := pearson(x, y)
+	if err != nil {
+		return math.NaN(), err
+	}
+
+	return roundStat(corr), nil
+}
+
 /* Properties */
 
 // ValueCounts returns a Series containing the number of unique values in a given Series.
@@ -762,13 +908,376 @@ func (s *Series) RenameIndex(newNames map[string]string) error {
 			}
 		}
 		if !exists {
-			return fmt.Errorf("index does not exist: %v", oldName)
+			return fmt.Errorf("%w: %v", ErrIndexNotFound, oldName)
 		}
 	}
 
 	return nil
 }
 
+// MapValues returns a copy of the Series where each element is replaced with its value in mapping.
+// Keys are matched by their printed form, so 1 and 1.0 both match the element 1, and math.NaN() matches NaN.
+// Elements that are not in mapping are left unchanged, or set to NaN if unmappedToNaN is true.
+// Mapped values must be a bool, int, float64, or string.
+// The data type of the Series is checked again after mapping.
+func (s *Series) MapValues(mapping map[interface{}]interface{}, unmappedToNaN bool) (Series, error) {
+	keyMap := make(map[string]interface{}, len(mapping))
+	for k, v := range mapping {
+		switch v.(type) {
+		case bool, int, float64, string:
+		default:
+			return Series{}, fmt.Errorf("mapped value %v is not a bool, int, float64, or string", v)
+		}
+		keyMap[fmt.Sprint(k)] = v
+	}
+
+	mapped := make([]interface{}, len(s.data))
+	for i, data := range s.data {
+		if v, ok := keyMap[fmt.Sprint(data)]; ok {
+			mapped[i] = v
+		} else if unmappedToNaN {
+			mapped[i] = NA
+		} else {
+			mapped[i] = data
+		}
+	}
+
+	return NewSeries(mapped, s.name, &s.index)
+}
+
+/* Missing data methods */
+
+// Replace returns a copy of the Series where every occurrence of oldValue is replaced with newValue.
+// Numbers are compared by value, so oldValue=-999 matches both -999 and -999.0.
+// Pass in math.NaN() as oldValue to replace missing values, or as newValue to mark the matches as missing.
+// newValue must be a bool, int, float64, or string. The data type of the Series is checked again after replacing.
+func (s Series) Replace(oldValue, newValue interface{}) (Series, error) {
+	if err := checkReplaceValue(newValue); err != nil {
+		return Series{}, err
+	}
+	if isNaN(newValue) {
+		newValue = NA
+	}
+
+	replaced := make([]interface{}, len(s.data))
+	for i, data := range s.data {
+		if isNaN(oldValue) && isNaN(data) || !isNaN(oldValue) && labelsAreEqual(data, oldValue) {
+			replaced[i] = newValue
+		} else {
+			replaced[i] = data
+		}
+	}
+
+	return NewSeries(replaced, s.name, &s.index)
+}
+
+// FillNaN returns a copy of the Series where every NaN value is replaced with value.
+// The data type of the Series is checked again after filling.
+// value should be a bool, int, float64, or string. Any other value leaves the data unchanged.
+func (s Series) FillNaN(value interface{}) Series {
+	switch value.(type) {
+	case bool, int, float64, string:
+	default:
+		return copySeries(&s)
+	}
+
+	filled := make([]interface{}, len(s.data))
+	for i, data := range s.data {
+		if isNaN(data) {
+			filled[i] = value
+		} else {
+			filled[i] = data
+		}
+	}
+
+	newS, err := NewSeries(filled, s.name, &s.index)
+	if err != nil {
+		return copySeries(&s)
+	}
+
+	return newS
+}
+
+// Interpolate returns a copy of the Series where NaN values are filled by interpolating between the surrounding values.
+// Only the "linear" method is supported for now, which treats the values as equally spaced.
+// NaN values at the start or end of the Series have no value on one side, so they stay NaN.
+// int Series become float64 Series.
+func (s *Series) Interpolate(method string) (Series, error) {
+	if method != "linear" {
+		return Series{}, fmt.Errorf("method must be \"linear\", got %q", method)
+	}
+	if s.dtype != "float64" && s.dtype != "int" {
+		return Series{}, fmt.Errorf("cannot interpolate, series %w", ErrTypeMismatch)
+	}
+
+	newS := copySeries(s)
+	if err := intSeriesToFloat64(&newS); err != nil {
+		return Series{}, err
+	}
+
+	prev := -1
+	for i, data := range newS.data {
+		if isNaN(data) {
+			continue
+		}
+		if prev >= 0 && i-prev > 1 {
+			start := newS.data[prev].(float64)
+			step := (data.(float64) - start) / float64(i-prev)
+			for j := prev + 1; j < i; j++ {
+				newS.data[j] = start + step*float64(j-prev)
+			}
+		}
+		prev = i
+	}
+
+	return newS, nil
+}
+
+// DropNaN returns a copy of the Series without NaN values.
+// The index of each remaining value is kept.
+func (s *Series) DropNaN() Series {
+	newS := Series{name: s.name, dtype: s.dtype}
+	newS.data = make([]interface{}, 0)
+	newS.index.index = make([]Index, 0)
+	newS.index.names = append(newS.index.names, s.index.names...)
+	for i, data := range s.data {
+		if !isNaN(data) {
+			newS.data = append(newS.data, data)
+			newS.index.index = append(newS.index.index, s.index.index[i])
+		}
+	}
+
+	return newS
+}
+
+// Clip returns a copy of the Series where values below lo are set to lo, and values above hi are set to hi.
+// NaN values are left as is.
+func (s *Series) Clip(lo, hi float64) (Series, error) {
+	if s.dtype != "float64" && s.dtype != "int" {
+		return Series{}, fmt.Errorf("cannot clip, series %w", ErrTypeMismatch)
+	}
+	if lo > hi {
+		return Series{}, fmt.Errorf("lower bound %v is greater than upper bound %v", lo, hi)
+	}
+
+	clipped := make([]interface{}, len(s.data))
+	for i, data := range s.data {
+		if isNaN(data) {
+			clipped[i] = data
+			continue
+		}
+		v, err := i2f(data)
+		if err != nil {
+			return Series{}, err
+		}
+
+		switch {
+		case v < lo:
+			clipped[i] = clipBound(lo, s.dtype)
+		case v > hi:
+			clipped[i] = clipBound(hi, s.dtype)
+		default:
+			clipped[i] = data
+		}
+	}
+
+	return NewSeries(clipped, s.name, &s.index)
+}
+
+// Abs returns a copy of the Series with the absolute value of each element.
+// NaN values are left as is.
+func (s *Series) Abs() (Series, error) {
+	if s.dtype != "float64" && s.dtype != "int" {
+		return Series{}, fmt.Errorf("cannot take absolute value, series %w", ErrTypeMismatch)
+	}
+
+	absolute := make([]interface{}, len(s.data))
+	for i, data := range s.data {
+		switch v := data.(type) {
+		case float64:
+			absolute[i] = math.Abs(v)
+		case int:
+			if v < 0 {
+				absolute[i] = -v
+			} else {
+				absolute[i] = v
+			}
+		default:
+			absolute[i] = data
+		}
+	}
+
+	return NewSeries(absolute, s.name, &s.index)
+}
+
+// Round returns a copy of the Series with each element rounded to the given number of decimals.
+// Negative decimals round to the left of the decimal point, so -1 rounds to the nearest ten.
+func (s *Series) Round(decimals int) (Series, error) {
+	if s.dtype != "float64" && s.dtype != "int" {
+		return Series{}, fmt.Errorf("cannot round, series %w", ErrTypeMismatch)
+	}
+
+	return NewSeries(roundData(s.data, decimals), s.name, &s.index)
+}
+
+// Cumsum returns a copy of the Series where each element is the sum of all values up to and including it.
+// NaN values stay NaN and are skipped by the running sum. int Series stay int, and an error is returned if the sum overflows.
+func (s Series) Cumsum() (Series, error) {
+	return s.cumulative("Cumsum", func(acc, v float64) float64 { return acc + v }, addInt64)
+}
+
+// Cumprod returns a copy of the Series where each element is the product of all values up to and including it.
+// NaN values stay NaN and are skipped by the running product. int Series stay int, and an error is returned if the product overflows.
+func (s Series) Cumprod() (Series, error) {
+	return s.cumulative("Cumprod", func(acc, v float64) float64 { return acc * v }, mulInt64)
+}
+
+// Cummax returns a copy of the Series where each element is the largest of all values up to and including it.
+// NaN values stay NaN and are skipped by the running maximum.
+func (s Series) Cummax() (Series, error) {
+	return s.cumulative("Cummax", math.Max, func(acc, v int64) (int64, bool) {
+		if v > acc {
+			return v, true
+		}
+		return acc, true
+	})
+}
+
+// Cummin returns a copy of the Series where each element is the smallest of all values up to and including it.
+// NaN values stay NaN and are skipped by the running minimum.
+func (s Series) Cummin() (Series, error) {
+	return s.cumulative("Cummin", math.Min, func(acc, v int64) (int64, bool) {
+		if v < acc {
+			return v, true
+		}
+		return acc, true
+	})
+}
+
+// cumulative folds the non-NaN values of the Series, keeping the running result at each position.
+// float64 Series are folded with op. int Series are folded with intOp, which returns false if the result overflows,
+// so that large values do not lose precision by going through float64.
+// name is the name of the calling method, used in the error messages.
+func (s Series) cumulative(name string, op func(acc, v float64) float64, intOp func(acc, v int64) (int64, bool)) (Series, error) {
+	if s.dtype != "float64" && s.dtype != "int" {
+		return Series{}, fmt.Errorf("cannot compute %v, series %w", name, ErrTypeMismatch)
+	}
+
+	result := make([]interface{}, len(s.data))
+	var acc float64
+	var intAcc int64
+	started := false
+	for i, data := range s.data {
+		if isNaN(data) {
+			result[i] = data
+			continue
+		}
+
+		if s.dtype == "int" {
+			v, ok := data.(int)
+			if !ok {
+				return Series{}, fmt.Errorf("cannot compute %v, %v is not an int", name, data)
+			}
+			if started {
+				intAcc, ok = intOp(intAcc, int64(v))
+				if !ok {
+					return Series{}, fmt.Errorf("cannot compute %v, int overflow at index %v", name, s.index.index[i].value)
+				}
+			} else {
+				intAcc = int64(v)
+				started = true
+			}
+			result[i] = int(intAcc)
+			continue
+		}
+
+		v, err := i2f(data)
+		if err != nil {
+			return Series{}, err
+		}
+		if started {
+			acc = op(acc, v)
+		} else {
+			acc = v
+			started = true
+		}
+		result[i] = acc
+	}
+
+	return NewSeries(result, s.name, &s.index)
+}
+
+// IsMonotonicIncreasing returns true if each value in the Series is greater than or equal to the previous one.
+// Numbers are compared by value, and other values, such as dates stored as strings, are compared as strings.
+// If skipNaN is true, NaN values are ignored. Otherwise, a NaN value breaks monotonicity.
+func (s Series) IsMonotonicIncreasing(skipNaN bool) bool {
+	return s.isMonotonic(skipNaN, 1)
+}
+
+// IsMonotonicDecreasing returns true if each value in the Series is less than or equal to the previous one.
+// Numbers are compared by value, and other values, such as dates stored as strings, are compared as strings.
+// If skipNaN is true, NaN values are ignored. Otherwise, a NaN value breaks monotonicity.
+func (s Series) IsMonotonicDecreasing(skipNaN bool) bool {
+	return s.isMonotonic(skipNaN, -1)
+}
+
+// isMonotonic checks that no value compares against its predecessor in the opposite of direction,
+// which is 1 for increasing and -1 for decreasing.
+func (s Series) isMonotonic(skipNaN bool, direction int) bool {
+	var prev interface{}
+	hasPrev := false
+	for _, data := range s.data {
+		if isNaN(data) {
+			if !skipNaN {
+				return false
+			}
+			continue
+		}
+		if hasPrev && compareValues(data, prev)*direction < 0 {
+			return false
+		}
+		prev = data
+		hasPrev = true
+	}
+
+	return true
+}
+
+// Nlargest returns the n largest values of the Series, ordered from the largest, along with their index.
+// Ties are broken by position, and NaN values are skipped. If n is greater than the number of values, all values are returned.
+func (s Series) Nlargest(n int) (Series, error) {
+	return s.nExtreme(n, true)
+}
+
+// Nsmallest returns the n smallest values of the Series, ordered from the smallest, along with their index.
+// Ties are broken by position, and NaN values are skipped. If n is greater than the number of values, all values are returned.
+func (s Series) Nsmallest(n int) (Series, error) {
+	return s.nExtreme(n, false)
+}
+
+// nExtreme is the shared implementation of Nlargest and Nsmallest.
+func (s Series) nExtreme(n int, largest bool) (Series, error) {
+	if s.dtype != "float64" && s.dtype != "int" {
+		return Series{}, fmt.Errorf("series %w", ErrTypeMismatch)
+	}
+
+	positions, err := nExtremePositions(s.data, n, largest)
+	if err != nil {
+		return Series{}, err
+	}
+
+	newS := Series{name: s.name, dtype: s.dtype}
+	newS.data = make([]interface{}, len(positions))
+	newS.index.index = make([]Index, len(positions))
+	newS.index.names = append(newS.index.names, s.index.names...)
+	for i, pos := range positions {
+		newS.data[i] = s.data[pos]
+		newS.index.index[i] = s.index.index[pos]
+	}
+
+	return newS, nil
+}
+
 /* Sorting methods */
 
 // SortByIndex sorts the elements in a Series by index.
@@ -96,18 +96,26 @@ func TestSeriesPrintRange(t *testing.T) {
 
 func TestSeriesHead(t *testing.T) {
 	type headTest struct {
-		arg1 Series
+		arg1     Series
+		arg2     int
+		expected Series
 	}
 	headTests := []headTest{
 		{
 			Series{
 				[]interface{}{"alice", "bob", "charlie"},
 				IndexData{
-					[]Index{
-						{0, []interface{}{0}},
-						{1, []interface{}{1}},
-						{2, []interface{}{2}},
-					},
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+			2,
+			Series{
+				[]interface{}{"alice", "bob"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}},
 					[]string{"RangeIndex"},
 				},
 				"People",
@@ -118,38 +126,97 @@ func TestSeriesHead(t *testing.T) {
 			Series{
 				[]interface{}{"apple", "banana", "cherry"},
 				IndexData{
-					[]Index{
-						{0, []interface{}{"a"}},
-						{1, []interface{}{"b"}},
-						{2, []interface{}{"c"}},
-					},
+					[]Index{{0, []interface{}{"a"}}, {1, []interface{}{"b"}}, {2, []interface{}{"c"}}},
+					[]string{"Index"},
+				},
+				"Fruit",
+				"string",
+			},
+			5,
+			Series{
+				[]interface{}{"apple", "banana", "cherry"},
+				IndexData{
+					[]Index{{0, []interface{}{"a"}}, {1, []interface{}{"b"}}, {2, []interface{}{"c"}}},
 					[]string{"Index"},
 				},
 				"Fruit",
 				"string",
 			},
 		},
+		{
+			Series{
+				[]interface{}{"alice", "bob", "charlie"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+			0,
+			Series{
+				[]interface{}{},
+				IndexData{
+					[]Index{},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+		},
+		{
+			Series{
+				[]interface{}{"alice", "bob", "charlie"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+			-1,
+			Series{
+				[]interface{}{},
+				IndexData{
+					[]Index{},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+		},
 	}
 
 	for _, test := range headTests {
-		test.arg1.Head(2)
+		output := test.arg1.Head(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{})) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
 	}
 }
 
 func TestSeriesTail(t *testing.T) {
 	type tailTest struct {
-		arg1 Series
+		arg1     Series
+		arg2     int
+		expected Series
 	}
 	tailTests := []tailTest{
 		{
 			Series{
 				[]interface{}{"alice", "bob", "charlie"},
 				IndexData{
-					[]Index{
-						{0, []interface{}{0}},
-						{1, []interface{}{1}},
-						{2, []interface{}{2}},
-					},
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+			2,
+			Series{
+				[]interface{}{"bob", "charlie"},
+				IndexData{
+					[]Index{{1, []interface{}{1}}, {2, []interface{}{2}}},
 					[]string{"RangeIndex"},
 				},
 				"People",
@@ -160,21 +227,72 @@ func TestSeriesTail(t *testing.T) {
 			Series{
 				[]interface{}{"apple", "banana", "cherry"},
 				IndexData{
-					[]Index{
-						{0, []interface{}{"a"}},
-						{1, []interface{}{"b"}},
-						{2, []interface{}{"c"}},
-					},
+					[]Index{{0, []interface{}{"a"}}, {1, []interface{}{"b"}}, {2, []interface{}{"c"}}},
+					[]string{"Index"},
+				},
+				"Fruit",
+				"string",
+			},
+			5,
+			Series{
+				[]interface{}{"apple", "banana", "cherry"},
+				IndexData{
+					[]Index{{0, []interface{}{"a"}}, {1, []interface{}{"b"}}, {2, []interface{}{"c"}}},
 					[]string{"Index"},
 				},
 				"Fruit",
 				"string",
 			},
 		},
+		{
+			Series{
+				[]interface{}{"alice", "bob", "charlie"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+			0,
+			Series{
+				[]interface{}{},
+				IndexData{
+					[]Index{},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+		},
+		{
+			Series{
+				[]interface{}{"alice", "bob", "charlie"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+			-1,
+			Series{
+				[]interface{}{},
+				IndexData{
+					[]Index{},
+					[]string{"RangeIndex"},
+				},
+				"People",
+				"string",
+			},
+		},
 	}
 
 	for _, test := range tailTests {
-		test.arg1.Tail(2)
+		output := test.arg1.Tail(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{})) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
 	}
 }
 
@@ -1912,6 +2030,97 @@ func TestSeriesLoc(t *testing.T) {
 			Series{},
 			fmt.Errorf("no data found for index [volleyball]"),
 		},
+		{
+			Series{
+				[]interface{}{"apple", "banana", "cherry"},
+				IndexData{
+					[]Index{
+						{0, []interface{}{1.0}},
+						{1, []interface{}{2.0}},
+						{2, []interface{}{3.0}},
+					},
+					[]string{"id"},
+				},
+				"Fruit",
+				"string",
+			},
+			[][]interface{}{{2}, {3}},
+			Series{
+				[]interface{}{"banana", "cherry"},
+				IndexData{
+					[]Index{
+						{1, []interface{}{2.0}},
+						{2, []interface{}{3.0}},
+					},
+					[]string{"id"},
+				},
+				"Fruit",
+				"string",
+			},
+			nil,
+		},
+		{
+			Series{
+				[]interface{}{"clara", "brian", "dorian"},
+				IndexData{
+					[]Index{
+						{0, []interface{}{2021, 1.0}},
+						{1, []interface{}{2021, 2.0}},
+						{2, []interface{}{2022, 1.0}},
+					},
+					[]string{"year", "quarter"},
+				},
+				"People",
+				"string",
+			},
+			[][]interface{}{{2021.0, 2}},
+			Series{
+				[]interface{}{"brian"},
+				IndexData{
+					[]Index{{1, []interface{}{2021, 2.0}}},
+					[]string{"year", "quarter"},
+				},
+				"People",
+				"string",
+			},
+			nil,
+		},
+		{
+			Series{
+				[]interface{}{"clara", "brian", "dorian"},
+				IndexData{
+					[]Index{
+						{0, []interface{}{2021, 1.0}},
+						{1, []interface{}{2021, 2.0}},
+						{2, []interface{}{2022, 1.0}},
+					},
+					[]string{"year", "quarter"},
+				},
+				"People",
+				"string",
+			},
+			[][]interface{}{{2021, 2, "a"}},
+			Series{},
+			fmt.Errorf("index length 3 is greater than the number of index levels 2"),
+		},
+		{
+			Series{
+				[]interface{}{"clara", "brian", "dorian"},
+				IndexData{
+					[]Index{
+						{0, []interface{}{"1"}},
+						{1, []interface{}{"2"}},
+						{2, []interface{}{"3"}},
+					},
+					[]string{"id"},
+				},
+				"People",
+				"string",
+			},
+			[][]interface{}{{2}},
+			Series{},
+			fmt.Errorf("no data found for index [2]"),
+		},
 	}
 
 	for _, test := range locTests {
@@ -3164,42 +3373,114 @@ func TestSeriesCount(t *testing.T) {
 				nil,
 			},
 		},
-	}
-	for _, test := range countTests {
-		output := test.arg1.Count()
-		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}), cmpopts.EquateErrors(), cmpopts.EquateNaNs()) {
-			t.Fatalf("expected %v, got %v", test.expected, output)
-		}
-	}
-}
-
-func BenchmarkSeriesMean(b *testing.B) {
-	benchmarks := []struct {
-		name string
-		ser  Series
-	}{
 		{
-			"1 Points",
-			func() Series {
-				testDf, err := NewSampleDataFrame(map[string]string{"title": "string", "rating": "float64"}, 1, nil)
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
 				if err != nil {
-					b.Error(err)
+					t.Error(err)
 				}
-				testSer, err := testDf.LocCol("rating")
+				return newSer
+			}(
+				[]interface{}{"Avery", "", "Candice", "NaN"},
+				"Name",
+				nil,
+			),
+			StatsResult{
+				"Count",
+				2.0,
+				nil,
+			},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
 				if err != nil {
-					b.Error(err)
+					t.Error(err)
 				}
-				return testSer
-			}(),
+				return newSer
+			}(
+				[]interface{}{true, "", false, false},
+				"Member",
+				nil,
+			),
+			StatsResult{
+				"Count",
+				3.0,
+				nil,
+			},
 		},
 		{
-			"10 Points",
-			func() Series {
-				testDf, err := NewSampleDataFrame(map[string]string{"title": "string", "rating": "float64"}, 10, nil)
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
 				if err != nil {
-					b.Error(err)
+					t.Error(err)
 				}
-				testSer, err := testDf.LocCol("rating")
+				return newSer
+			}(
+				[]interface{}{true, false, true},
+				"Member",
+				nil,
+			),
+			StatsResult{
+				"Count",
+				3.0,
+				nil,
+			},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, NA, 3},
+				"Age",
+				nil,
+			),
+			StatsResult{
+				"Count",
+				2.0,
+				nil,
+			},
+		},
+	}
+	for _, test := range countTests {
+		output := test.arg1.Count()
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}), cmpopts.EquateErrors(), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkSeriesMean(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		ser  Series
+	}{
+		{
+			"1 Points",
+			func() Series {
+				testDf, err := NewSampleDataFrame(map[string]string{"title": "string", "rating": "float64"}, 1, nil)
+				if err != nil {
+					b.Error(err)
+				}
+				testSer, err := testDf.LocCol("rating")
+				if err != nil {
+					b.Error(err)
+				}
+				return testSer
+			}(),
+		},
+		{
+			"10 Points",
+			func() Series {
+				testDf, err := NewSampleDataFrame(map[string]string{"title": "string", "rating": "float64"}, 10, nil)
+				if err != nil {
+					b.Error(err)
+				}
+				testSer, err := testDf.LocCol("rating")
 				if err != nil {
 					b.Error(err)
 				}
@@ -3374,6 +3655,226 @@ func TestSeriesMean(t *testing.T) {
 	}
 }
 
+func TestSeriesMeanInt(t *testing.T) {
+	type meanIntTest struct {
+		arg1     Series
+		expected StatsResult
+	}
+	meanIntTests := []meanIntTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 4},
+				"Age",
+				nil,
+			),
+			StatsResult{"Mean", 2.5, nil},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, "", 3},
+				"Age",
+				nil,
+			),
+			StatsResult{"Mean", 2.0, nil},
+		},
+	}
+
+	for _, test := range meanIntTests {
+		output := test.arg1.Mean()
+		if !cmp.Equal(output.UsedFunc, test.expected.UsedFunc) || !cmp.Equal(output.Result, test.expected.Result, cmpopts.EquateNaNs()) || fmt.Sprint(output.Err) != fmt.Sprint(test.expected.Err) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkSeriesSum(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Sum()
+	}
+}
+
+func TestSeriesSum(t *testing.T) {
+	type sumTest struct {
+		arg1     Series
+		expected StatsResult
+	}
+	sumTests := []sumTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 4},
+				"Age",
+				nil,
+			),
+			StatsResult{"Sum", 10.0, nil},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.5, 2.5, 3.0},
+				"Score",
+				nil,
+			),
+			StatsResult{"Sum", 7.0, nil},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.5, math.NaN(), 3.0},
+				"Score",
+				nil,
+			),
+			StatsResult{"Sum", 4.5, nil},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"Avery", "Bradley"},
+				"Name",
+				nil,
+			),
+			StatsResult{"Sum", math.NaN(), fmt.Errorf("data is not a number: Avery")},
+		},
+	}
+
+	for _, test := range sumTests {
+		output := test.arg1.Sum()
+		if !cmp.Equal(output.UsedFunc, test.expected.UsedFunc) || !cmp.Equal(output.Result, test.expected.Result, cmpopts.EquateNaNs()) || fmt.Sprint(output.Err) != fmt.Sprint(test.expected.Err) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkSeriesProd(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Prod()
+	}
+}
+
+func TestSeriesProd(t *testing.T) {
+	type prodTest struct {
+		arg1     Series
+		expected StatsResult
+	}
+	prodTests := []prodTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 4},
+				"Age",
+				nil,
+			),
+			StatsResult{"Prod", 24.0, nil},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.5, 2.0, 3.0},
+				"Score",
+				nil,
+			),
+			StatsResult{"Prod", 9.0, nil},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.5, math.NaN(), 3.0},
+				"Score",
+				nil,
+			),
+			StatsResult{"Prod", 4.5, nil},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"Avery", "Bradley"},
+				"Name",
+				nil,
+			),
+			StatsResult{"Prod", math.NaN(), fmt.Errorf("data is not a number: Avery")},
+		},
+	}
+
+	for _, test := range prodTests {
+		output := test.arg1.Prod()
+		if !cmp.Equal(output.UsedFunc, test.expected.UsedFunc) || !cmp.Equal(output.Result, test.expected.Result, cmpopts.EquateNaNs()) || fmt.Sprint(output.Err) != fmt.Sprint(test.expected.Err) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
 func BenchmarkSeriesMedian(b *testing.B) {
 	benchmarks := []struct {
 		name string
@@ -4052,7 +4553,7 @@ func TestSeriesMin(t *testing.T) {
 			StatsResult{
 				"Min",
 				math.NaN(),
-				fmt.Errorf("data is not a float64: %v", "Avery"),
+				fmt.Errorf("data is not a number: %v", "Avery"),
 			},
 		},
 		{
@@ -4315,7 +4816,7 @@ func TestSeriesMax(t *testing.T) {
 			StatsResult{
 				"Max",
 				math.NaN(),
-				fmt.Errorf("data is not a float64: %v", "Avery"),
+				fmt.Errorf("data is not a number: %v", "Avery"),
 			},
 		},
 		{
@@ -4578,7 +5079,7 @@ func TestSeriesQ1(t *testing.T) {
 			StatsResult{
 				"Q1",
 				math.NaN(),
-				fmt.Errorf("data is not a float64: %v", "Avery"),
+				fmt.Errorf("data is not a number: %v", "Avery"),
 			},
 		},
 		{
@@ -5286,32 +5787,161 @@ func TestSeriesDescribe(t *testing.T) {
 	}
 }
 
-func BenchmarkSeriesValueCounts(b *testing.B) {
-	testDf, err := ReadCsv("testfiles/neo_v2.csv", []string{"id"})
+func BenchmarkSeriesAutocorr(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
 	if err != nil {
 		b.Error(err)
 	}
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		testDf.series[0].ValueCounts()
+		testSer.Autocorr(1)
 	}
 }
 
-func TestSeriesValueCounts(t *testing.T) {
-	type valueCountsTest struct {
-		arg1     Series
-		expected Series
+func TestSeriesAutocorr(t *testing.T) {
+	type autocorrTest struct {
+		arg1          Series
+		arg2          int
+		expected      float64
+		expectedError error
 	}
-	valueCountsTests := []valueCountsTest{
+	autocorrTests := []autocorrTest{
 		{
-			Series{
-				[]interface{}{"Amazon", "Amazon", "Google", "Apple", "Apple", "Apple", "Facebook"},
-				IndexData{
-					[]Index{
-						{0, []interface{}{0}},
-						{1, []interface{}{1}},
-						{2, []interface{}{2}},
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 1, 2, 3, 1, 2, 3},
+				"Period",
+				nil,
+			),
+			3,
+			1.0,
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 1, 2, 3, 1, 2, 3},
+				"Period",
+				nil,
+			),
+			1,
+			-0.385,
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.0, 2.0, math.NaN(), 4.0, 5.0, 6.0},
+				"Value",
+				nil,
+			),
+			1,
+			1.0,
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 1, 2, 3, 1, 2, 3},
+				"Period",
+				nil,
+			),
+			9,
+			math.NaN(),
+			fmt.Errorf("lag must be between 0 and 8, got 9"),
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{5, 5, 5, 5},
+				"Constant",
+				nil,
+			),
+			1,
+			math.NaN(),
+			fmt.Errorf("cannot compute correlation of constant data"),
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"a", "b", "c"},
+				"Letter",
+				nil,
+			),
+			1,
+			math.NaN(),
+			fmt.Errorf("series data type is not float64 or int"),
+		},
+	}
+	for _, test := range autocorrTests {
+		output, err := test.arg1.Autocorr(test.arg2)
+		if !cmp.Equal(output, test.expected, cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesValueCounts(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/neo_v2.csv", []string{"id"})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testDf.series[0].ValueCounts()
+	}
+}
+
+func TestSeriesValueCounts(t *testing.T) {
+	type valueCountsTest struct {
+		arg1     Series
+		expected Series
+	}
+	valueCountsTests := []valueCountsTest{
+		{
+			Series{
+				[]interface{}{"Amazon", "Amazon", "Google", "Apple", "Apple", "Apple", "Facebook"},
+				IndexData{
+					[]Index{
+						{0, []interface{}{0}},
+						{1, []interface{}{1}},
+						{2, []interface{}{2}},
 						{3, []interface{}{3}},
 						{4, []interface{}{4}},
 						{5, []interface{}{5}},
@@ -5561,6 +6191,1374 @@ func TestSeriesRenameIndex(t *testing.T) {
 	}
 }
 
+func BenchmarkSeriesMapValues(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Position")
+	if err != nil {
+		b.Error(err)
+	}
+	mapping := map[interface{}]interface{}{"PG": "Point Guard", "SG": "Shooting Guard", "C": "Center"}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.MapValues(mapping, false)
+	}
+}
+
+func TestSeriesMapValues(t *testing.T) {
+	type mapValuesTest struct {
+		arg1          Series
+		arg2          map[interface{}]interface{}
+		arg3          bool
+		expected      Series
+		expectedError error
+	}
+	mapValuesTests := []mapValuesTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"M", "F", "M", "X"},
+				"Sex",
+				nil,
+			),
+			map[interface{}]interface{}{"M": "Male", "F": "Female"},
+			false,
+			Series{
+				[]interface{}{"Male", "Female", "Male", "X"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Sex",
+				"string",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"M", "F", "M", "X"},
+				"Sex",
+				nil,
+			),
+			map[interface{}]interface{}{"M": "Male", "F": "Female"},
+			true,
+			Series{
+				[]interface{}{"Male", "Female", "Male", math.NaN()},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Sex",
+				"string",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 2},
+				"Grade",
+				nil,
+			),
+			map[interface{}]interface{}{1.0: 10, 2: 20},
+			true,
+			Series{
+				[]interface{}{10, 20, NA, 20},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Grade",
+				"int",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.5, math.NaN(), 3.0, 1.5},
+				"Score",
+				nil,
+			),
+			map[interface{}]interface{}{math.NaN(): 0.0},
+			false,
+			Series{
+				[]interface{}{1.5, 0.0, 3.0, 1.5},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, 2, 3, 2},
+				"Grade",
+				nil,
+			),
+			map[interface{}]interface{}{1: []int{1}},
+			false,
+			Series{},
+			fmt.Errorf("mapped value [1] is not a bool, int, float64, or string"),
+		},
+	}
+
+	for _, test := range mapValuesTests {
+		output, err := test.arg1.MapValues(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesReplace(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Age")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Replace(25.0, math.NaN())
+	}
+}
+
+func TestSeriesReplace(t *testing.T) {
+	type replaceTest struct {
+		arg1          []interface{}
+		arg2          interface{}
+		arg3          interface{}
+		expected      Series
+		expectedError error
+	}
+	replaceTests := []replaceTest{
+		{
+			[]interface{}{1.5, -999.0, 3.0},
+			-999,
+			math.NaN(),
+			Series{
+				[]interface{}{1.5, math.NaN(), 3.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Value",
+				"float64",
+			},
+			nil,
+		},
+		{
+			[]interface{}{1, -999, 3},
+			-999.0,
+			math.NaN(),
+			Series{
+				[]interface{}{1, NA, 3},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Value",
+				"int",
+			},
+			nil,
+		},
+		{
+			[]interface{}{1.5, math.NaN(), 3.0},
+			math.NaN(),
+			0.0,
+			Series{
+				[]interface{}{1.5, 0.0, 3.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Value",
+				"float64",
+			},
+			nil,
+		},
+		{
+			[]interface{}{"a", "b", "a"},
+			"a",
+			"c",
+			Series{
+				[]interface{}{"c", "b", "c"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Value",
+				"string",
+			},
+			nil,
+		},
+		{
+			[]interface{}{1, 2, 3},
+			2,
+			"two",
+			Series{
+				[]interface{}{"1", "two", "3"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Value",
+				"string",
+			},
+			nil,
+		},
+		{
+			[]interface{}{1, 2, 3},
+			2,
+			[]int{2},
+			Series{},
+			fmt.Errorf("new value [2] is not a bool, int, float64, or string"),
+		},
+	}
+
+	for _, test := range replaceTests {
+		testSer, err := NewSeries(test.arg1, "Value", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output, err := testSer.Replace(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesFillNaN(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.FillNaN(0.0)
+	}
+}
+
+func TestSeriesFillNaN(t *testing.T) {
+	type fillNaNTest struct {
+		arg1     Series
+		arg2     interface{}
+		expected Series
+	}
+	fillNaNTests := []fillNaNTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.0, math.NaN(), 3.0},
+				"Score",
+				nil,
+			),
+			0.0,
+			Series{
+				[]interface{}{1.0, 0.0, 3.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Score",
+				"float64",
+			},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"a", "", "c"},
+				"Letter",
+				nil,
+			),
+			"b",
+			Series{
+				[]interface{}{"a", "b", "c"},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Letter",
+				"string",
+			},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.0, math.NaN(), 3.0},
+				"Score",
+				nil,
+			),
+			[]int{0},
+			Series{
+				[]interface{}{1.0, math.NaN(), 3.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Score",
+				"float64",
+			},
+		},
+	}
+
+	for _, test := range fillNaNTests {
+		output := test.arg1.FillNaN(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkSeriesInterpolate(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	ser, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ser.Interpolate("linear")
+	}
+}
+
+func TestSeriesInterpolate(t *testing.T) {
+	type interpolateTest struct {
+		arg1          Series
+		arg2          string
+		expected      Series
+		expectedError error
+	}
+	interpolateTests := []interpolateTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.0, math.NaN(), 3.0},
+				"Score",
+				nil,
+			),
+			"linear",
+			Series{
+				[]interface{}{1.0, 2.0, 3.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{0.0, math.NaN(), math.NaN(), math.NaN(), 4.0},
+				"Score",
+				nil,
+			),
+			"linear",
+			Series{
+				[]interface{}{0.0, 1.0, 2.0, 3.0, 4.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+					[]string{""},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{math.NaN(), 1.0, math.NaN(), 3.0, math.NaN(), math.NaN()},
+				"Score",
+				nil,
+			),
+			"linear",
+			Series{
+				[]interface{}{math.NaN(), 1.0, 2.0, 3.0, math.NaN(), math.NaN()},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}, {5, []interface{}{5}}},
+					[]string{""},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1, NA, 5},
+				"Age",
+				nil,
+			),
+			"linear",
+			Series{
+				[]interface{}{1.0, 3.0, 5.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Age",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"Avery", math.NaN(), "Candice"},
+				"Name",
+				nil,
+			),
+			"linear",
+			Series{},
+			fmt.Errorf("cannot interpolate, series data type is not float64 or int"),
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.0, math.NaN(), 3.0},
+				"Score",
+				nil,
+			),
+			"nearest",
+			Series{},
+			fmt.Errorf("method must be \"linear\", got \"nearest\""),
+		},
+	}
+
+	for _, test := range interpolateTests {
+		output, err := test.arg1.Interpolate(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesDropNaN(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.DropNaN()
+	}
+}
+
+func TestSeriesDropNaN(t *testing.T) {
+	type dropNaNTest struct {
+		arg1     Series
+		expected Series
+	}
+	dropNaNTests := []dropNaNTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.0, math.NaN(), 3.0, math.NaN()},
+				"Score",
+				&IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+			),
+			Series{
+				[]interface{}{1.0, 3.0},
+				IndexData{
+					[]Index{{0, []interface{}{"A"}}, {2, []interface{}{"C"}}},
+					[]string{"Name"},
+				},
+				"Score",
+				"float64",
+			},
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"a", "", "c", "d"},
+				"Letter",
+				&IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+			),
+			Series{
+				[]interface{}{"a", "c", "d"},
+				IndexData{
+					[]Index{{0, []interface{}{"A"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+				"Letter",
+				"string",
+			},
+		},
+	}
+
+	for _, test := range dropNaNTests {
+		output := test.arg1.DropNaN()
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{})) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkSeriesClip(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Clip(1000000.0, 5000000.0)
+	}
+}
+
+func TestSeriesClip(t *testing.T) {
+	type clipTest struct {
+		arg1          Series
+		arg2          float64
+		arg3          float64
+		expected      Series
+		expectedError error
+	}
+	clipTests := []clipTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{-5.0, 2.5, math.NaN(), 10.0},
+				"Score",
+				&IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+			),
+			0.0,
+			5.0,
+			Series{
+				[]interface{}{0.0, 2.5, math.NaN(), 5.0},
+				IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{-3, 4, 9},
+				"Age",
+				nil,
+			),
+			0.0,
+			5.0,
+			Series{
+				[]interface{}{0, 4, 5},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Age",
+				"int",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"a", "b"},
+				"Letter",
+				nil,
+			),
+			0.0,
+			5.0,
+			Series{},
+			fmt.Errorf("cannot clip, series data type is not float64 or int"),
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{1.0, 2.0},
+				"Score",
+				nil,
+			),
+			5.0,
+			0.0,
+			Series{},
+			fmt.Errorf("lower bound 5 is greater than upper bound 0"),
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{-3, NA, 8},
+				"Count",
+				nil,
+			),
+			0.0,
+			5.0,
+			Series{
+				[]interface{}{0, NA, 5},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Count",
+				"int",
+			},
+			nil,
+		},
+	}
+
+	for _, test := range clipTests {
+		output, err := test.arg1.Clip(test.arg2, test.arg3)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || (fmt.Sprint(err) != fmt.Sprint(test.expectedError)) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesAbs(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Abs()
+	}
+}
+
+func TestSeriesAbs(t *testing.T) {
+	type absTest struct {
+		arg1          Series
+		expected      Series
+		expectedError error
+	}
+	absTests := []absTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{-5.25, 2.5, math.NaN(), -10.0},
+				"Score",
+				&IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+			),
+			Series{
+				[]interface{}{5.25, 2.5, math.NaN(), 10.0},
+				IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{-3, NA, 8, 0},
+				"Count",
+				nil,
+			),
+			Series{
+				[]interface{}{3, NA, 8, 0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Count",
+				"int",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"a", "b"},
+				"Letter",
+				nil,
+			),
+			Series{},
+			fmt.Errorf("cannot take absolute value, series data type is not float64 or int"),
+		},
+	}
+	for _, test := range absTests {
+		output, err := test.arg1.Abs()
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesRound(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Round(-3)
+	}
+}
+
+func TestSeriesRound(t *testing.T) {
+	type roundTest struct {
+		arg1          Series
+		arg2          int
+		expected      Series
+		expectedError error
+	}
+	roundTests := []roundTest{
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{-5.26, 2.54, math.NaN(), -10.0},
+				"Score",
+				&IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+			),
+			1,
+			Series{
+				[]interface{}{-5.3, 2.5, math.NaN(), -10.0},
+				IndexData{
+					[]Index{{0, []interface{}{"A"}}, {1, []interface{}{"B"}}, {2, []interface{}{"C"}}, {3, []interface{}{"D"}}},
+					[]string{"Name"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{-34, NA, 86, 0},
+				"Count",
+				nil,
+			),
+			-1,
+			Series{
+				[]interface{}{-30, NA, 90, 0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Count",
+				"int",
+			},
+			nil,
+		},
+		{
+			func(data []interface{}, name string, index *IndexData) Series {
+				newSer, err := NewSeries(data, name, index)
+				if err != nil {
+					t.Error(err)
+				}
+				return newSer
+			}(
+				[]interface{}{"a", "b"},
+				"Letter",
+				nil,
+			),
+			0,
+			Series{},
+			fmt.Errorf("cannot round, series data type is not float64 or int"),
+		},
+	}
+	for _, test := range roundTests {
+		output, err := test.arg1.Round(test.arg2)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesCumsum(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Cumsum()
+	}
+}
+
+func TestSeriesCumulative(t *testing.T) {
+	type cumulativeTest struct {
+		arg1          []interface{}
+		arg2          func(Series) (Series, error)
+		expected      Series
+		expectedError error
+	}
+	cumulativeTests := []cumulativeTest{
+		{
+			[]interface{}{1.0, 3.0, math.NaN(), -2.0, 4.0},
+			Series.Cumsum,
+			Series{
+				[]interface{}{1.0, 4.0, math.NaN(), 2.0, 6.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+					[]string{""},
+				},
+				"Value",
+				"float64",
+			},
+			nil,
+		},
+		{
+			[]interface{}{1.0, 3.0, math.NaN(), -2.0, 4.0},
+			Series.Cumprod,
+			Series{
+				[]interface{}{1.0, 3.0, math.NaN(), -6.0, -24.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+					[]string{""},
+				},
+				"Value",
+				"float64",
+			},
+			nil,
+		},
+		{
+			[]interface{}{1.0, 3.0, math.NaN(), -2.0, 4.0},
+			Series.Cummax,
+			Series{
+				[]interface{}{1.0, 3.0, math.NaN(), 3.0, 4.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+					[]string{""},
+				},
+				"Value",
+				"float64",
+			},
+			nil,
+		},
+		{
+			[]interface{}{1.0, 3.0, math.NaN(), -2.0, 4.0},
+			Series.Cummin,
+			Series{
+				[]interface{}{1.0, 1.0, math.NaN(), -2.0, -2.0},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}, {4, []interface{}{4}}},
+					[]string{""},
+				},
+				"Value",
+				"float64",
+			},
+			nil,
+		},
+		{
+			[]interface{}{2, NA, 5, 1},
+			Series.Cumsum,
+			Series{
+				[]interface{}{2, NA, 7, 8},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Value",
+				"int",
+			},
+			nil,
+		},
+		{
+			[]interface{}{2, NA, 5, 1},
+			Series.Cumprod,
+			Series{
+				[]interface{}{2, NA, 10, 10},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Value",
+				"int",
+			},
+			nil,
+		},
+		{
+			[]interface{}{2, NA, 5, 1},
+			Series.Cummax,
+			Series{
+				[]interface{}{2, NA, 5, 5},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Value",
+				"int",
+			},
+			nil,
+		},
+		{
+			[]interface{}{2, NA, 5, 1},
+			Series.Cummin,
+			Series{
+				[]interface{}{2, NA, 2, 1},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}, {3, []interface{}{3}}},
+					[]string{""},
+				},
+				"Value",
+				"int",
+			},
+			nil,
+		},
+		{
+			[]interface{}{"a", "b"},
+			Series.Cummax,
+			Series{},
+			fmt.Errorf("cannot compute Cummax, series %w", ErrTypeMismatch),
+		},
+		{
+			[]interface{}{1 << 53, 1, 1},
+			Series.Cumsum,
+			Series{
+				[]interface{}{1 << 53, 1<<53 + 1, 1<<53 + 2},
+				IndexData{
+					[]Index{{0, []interface{}{0}}, {1, []interface{}{1}}, {2, []interface{}{2}}},
+					[]string{""},
+				},
+				"Value",
+				"int",
+			},
+			nil,
+		},
+		{
+			[]interface{}{math.MaxInt64, NA, 1},
+			Series.Cumsum,
+			Series{},
+			fmt.Errorf("cannot compute Cumsum, int overflow at index [2]"),
+		},
+		{
+			[]interface{}{1 << 40, 1 << 30, -1},
+			Series.Cumprod,
+			Series{},
+			fmt.Errorf("cannot compute Cumprod, int overflow at index [1]"),
+		},
+	}
+
+	for _, test := range cumulativeTests {
+		testSer, err := NewSeries(test.arg1, "Value", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output, err := test.arg2(testSer)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
+func BenchmarkSeriesIsMonotonicIncreasing(b *testing.B) {
+	testSer, err := NewSeries(func() []interface{} {
+		data := make([]interface{}, 1000)
+		for i := range data {
+			data[i] = i
+		}
+		return data
+	}(), "Number", nil)
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.IsMonotonicIncreasing(false)
+	}
+}
+
+func TestSeriesIsMonotonic(t *testing.T) {
+	type isMonotonicTest struct {
+		arg1               []interface{}
+		arg2               bool
+		expectedIncreasing bool
+		expectedDecreasing bool
+	}
+	isMonotonicTests := []isMonotonicTest{
+		{[]interface{}{1, 2, 3, 4}, false, true, false},
+		{[]interface{}{4.5, 3.0, 2.5, -1.0}, false, false, true},
+		{[]interface{}{2, 2, 2}, false, true, true},
+		{[]interface{}{1, 3, 2, 4}, false, false, false},
+		{[]interface{}{1.0, math.NaN(), 2.0, 3.0}, false, false, false},
+		{[]interface{}{1.0, math.NaN(), 2.0, 3.0}, true, true, false},
+		{[]interface{}{3, NA, 2, 1}, true, false, true},
+		{[]interface{}{"2022-01-01", "2022-01-02", "2022-02-01"}, false, true, false},
+		{[]interface{}{}, false, true, true},
+	}
+
+	for _, test := range isMonotonicTests {
+		testSer, err := NewSeries(test.arg1, "Value", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		increasing := testSer.IsMonotonicIncreasing(test.arg2)
+		decreasing := testSer.IsMonotonicDecreasing(test.arg2)
+		if increasing != test.expectedIncreasing || decreasing != test.expectedDecreasing {
+			t.Fatalf("expected increasing %v and decreasing %v for %v, got %v and %v", test.expectedIncreasing, test.expectedDecreasing, test.arg1, increasing, decreasing)
+		}
+	}
+}
+
+func BenchmarkSeriesNlargest(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Nlargest(10)
+	}
+}
+
+func TestSeriesNlargest(t *testing.T) {
+	testSer, err := NewSeries([]interface{}{5.0, 9.0, math.NaN(), 9.0, 1.0}, "Score", &IndexData{
+		[]Index{{0, []interface{}{"a"}}, {1, []interface{}{"b"}}, {2, []interface{}{"c"}}, {3, []interface{}{"d"}}, {4, []interface{}{"e"}}},
+		[]string{"Label"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type nlargestTest struct {
+		arg1          int
+		expected      Series
+		expectedError error
+	}
+	nlargestTests := []nlargestTest{
+		{
+			2,
+			Series{
+				[]interface{}{9.0, 9.0},
+				IndexData{
+					[]Index{{1, []interface{}{"b"}}, {3, []interface{}{"d"}}},
+					[]string{"Label"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			10,
+			Series{
+				[]interface{}{9.0, 9.0, 5.0, 1.0},
+				IndexData{
+					[]Index{{1, []interface{}{"b"}}, {3, []interface{}{"d"}}, {0, []interface{}{"a"}}, {4, []interface{}{"e"}}},
+					[]string{"Label"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			-1,
+			Series{},
+			fmt.Errorf("n cannot be negative: -1"),
+		},
+	}
+
+	for _, test := range nlargestTests {
+		output, err := testSer.Nlargest(test.arg1)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+
+	stringSer, err := NewSeries([]interface{}{"a", "b"}, "Label", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stringSer.Nlargest(1); fmt.Sprint(err) != fmt.Sprint(fmt.Errorf("series %w", ErrTypeMismatch)) {
+		t.Fatalf("expected type mismatch error, got %v", err)
+	}
+}
+
+func BenchmarkSeriesNsmallest(b *testing.B) {
+	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
+	if err != nil {
+		b.Error(err)
+	}
+	testSer, err := testDf.LocCol("Salary")
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		testSer.Nsmallest(10)
+	}
+}
+
+func TestSeriesNsmallest(t *testing.T) {
+	testSer, err := NewSeries([]interface{}{5.0, 9.0, math.NaN(), 9.0, 1.0}, "Score", &IndexData{
+		[]Index{{0, []interface{}{"a"}}, {1, []interface{}{"b"}}, {2, []interface{}{"c"}}, {3, []interface{}{"d"}}, {4, []interface{}{"e"}}},
+		[]string{"Label"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type nsmallestTest struct {
+		arg1          int
+		expected      Series
+		expectedError error
+	}
+	nsmallestTests := []nsmallestTest{
+		{
+			2,
+			Series{
+				[]interface{}{1.0, 5.0},
+				IndexData{
+					[]Index{{4, []interface{}{"e"}}, {0, []interface{}{"a"}}},
+					[]string{"Label"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			10,
+			Series{
+				[]interface{}{1.0, 5.0, 9.0, 9.0},
+				IndexData{
+					[]Index{{4, []interface{}{"e"}}, {0, []interface{}{"a"}}, {1, []interface{}{"b"}}, {3, []interface{}{"d"}}},
+					[]string{"Label"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			0,
+			Series{
+				[]interface{}{},
+				IndexData{
+					[]Index{},
+					[]string{"Label"},
+				},
+				"Score",
+				"float64",
+			},
+			nil,
+		},
+		{
+			-1,
+			Series{},
+			fmt.Errorf("n cannot be negative: -1"),
+		},
+	}
+
+	for _, test := range nsmallestTests {
+		output, err := testSer.Nsmallest(test.arg1)
+		if !cmp.Equal(output, test.expected, cmp.AllowUnexported(Series{}, IndexData{}, Index{}), cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+
+	stringSer, err := NewSeries([]interface{}{"a", "b"}, "Label", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stringSer.Nsmallest(1); fmt.Sprint(err) != fmt.Sprint(fmt.Errorf("series %w", ErrTypeMismatch)) {
+		t.Fatalf("expected type mismatch error, got %v", err)
+	}
+}
+
 func BenchmarkSeriesSortByIndex(b *testing.B) {
 	testDf, err := ReadCsv("testfiles/nba.csv", []string{"Name"})
 	if err != nil {
@@ -9,6 +9,27 @@ import (
 // StatsFunc represents any function that accepts dataset as input and returns StatsResult as output.
 type StatsFunc func(dataset []interface{}) StatsResult
 
+// AggFunc represents any function that aggregates a dataset into a single value.
+// Unlike StatsFunc, the value can be of any type, so it can be used on string and bool columns with GroupBy.AggAny.
+type AggFunc func(dataset []interface{}) interface{}
+
+// Aggregator is implemented by anything that aggregates a dataset into a single value.
+// Both StatsFunc and AggFunc implement it, so either can be passed to GroupBy.AggWith and DataFrame.PivotTableWith.
+type Aggregator interface {
+	Aggregate(dataset []interface{}) (interface{}, error)
+}
+
+// Aggregate lets a StatsFunc be used as an Aggregator. It returns the Result and Err of the StatsResult.
+func (f StatsFunc) Aggregate(dataset []interface{}) (interface{}, error) {
+	result := f(dataset)
+	return result.Result, result.Err
+}
+
+// Aggregate lets an AggFunc be used as an Aggregator.
+func (f AggFunc) Aggregate(dataset []interface{}) (interface{}, error) {
+	return f(dataset), nil
+}
+
 // StatsResult holds the results of calculation from a statistics function such as Mean or Median.
 type StatsResult struct {
 	UsedFunc string
@@ -16,11 +37,35 @@ type StatsResult struct {
 	Err      error
 }
 
-// Count counts the number of non-NaN elements in a dataset.
+// statsPrecision is the number of decimals that results of statistics functions such as Mean are rounded to.
+var statsPrecision = 3
+
+// SetStatsPrecision sets the number of decimals that Mean, Median, Std, Q1, Q2, Q3, IQR, MAD and Autocorr round their results to.
+// The default is 3. Pass -1 to disable rounding.
+// The setting applies to the whole package, so it should not be changed while statistics are being calculated in other goroutines.
+func SetStatsPrecision(decimals int) error {
+	if decimals < -1 {
+		return fmt.Errorf("precision must be -1 or greater, got %d", decimals)
+	}
+	statsPrecision = decimals
+	return nil
+}
+
+// roundStat rounds a statistics result to the precision set by SetStatsPrecision.
+func roundStat(x float64) float64 {
+	if statsPrecision < 0 {
+		return x
+	}
+	factor := math.Pow(10, float64(statsPrecision))
+	return math.Round(x*factor) / factor
+}
+
+// Count counts the number of non-missing elements in a dataset.
+// NaN, NA, nil, and the strings "NaN" and "" are treated as missing.
 func Count(dataset []interface{}) StatsResult {
 	count := 0
 	for _, v := range dataset {
-		if v != nil || v != math.NaN() {
+		if !isNaN(v) && v != "" {
 			count++
 		}
 	}
@@ -47,7 +92,7 @@ func Mean(dataset []interface{}) StatsResult {
 	}
 
 	mean /= float64(len(data))
-	roundedMean := math.Round(mean*1000) / 1000
+	roundedMean := roundStat(mean)
 
 	return StatsResult{"Mean", roundedMean, nil}
 }
@@ -69,12 +114,12 @@ func Median(dataset []interface{}) StatsResult {
 		upper := data[total/2]
 
 		median := (lower + upper) / 2
-		roundedMedian := math.Round(median*1000) / 1000
+		roundedMedian := roundStat(median)
 
 		return StatsResult{"Median", roundedMedian, nil}
 	} else {
 		median := data[(total+1)/2-1]
-		roundedMedian := math.Round(median*1000) / 1000
+		roundedMedian := roundStat(median)
 
 		return StatsResult{"Median", roundedMedian, nil}
 	}
@@ -88,13 +133,18 @@ func Std(dataset []interface{}) StatsResult {
 		return StatsResult{"Std", math.NaN(), meanResult.Err}
 	}
 
+	data, err := interface2F64Slice(dataset)
+	if err != nil {
+		return StatsResult{"Std", math.NaN(), err}
+	}
+
 	numerator := 0.0
-	for _, v := range dataset {
-		temp := math.Pow(v.(float64)-meanResult.Result, 2)
+	for _, v := range data {
+		temp := math.Pow(v-meanResult.Result, 2)
 		numerator += temp
 	}
-	std = math.Sqrt(numerator / float64(len(dataset)-1))
-	roundedStd := math.Round(std*1000) / 1000
+	std = math.Sqrt(numerator / float64(len(data)-1))
+	roundedStd := roundStat(std)
 
 	return StatsResult{"Std", roundedStd, nil}
 }
@@ -156,14 +206,14 @@ func Q1(dataset []interface{}) StatsResult {
 		if err != nil {
 			return StatsResult{"Q1", math.NaN(), err}
 		}
-		return StatsResult{"Q1", q1, nil}
+		return StatsResult{"Q1", roundStat(q1), nil}
 	} else {
 		lower := data[:(len(data)-1)/2]
 		q1, err := median(lower)
 		if err != nil {
 			return StatsResult{"Q1", math.NaN(), err}
 		}
-		return StatsResult{"Q1", q1, nil}
+		return StatsResult{"Q1", roundStat(q1), nil}
 	}
 }
 
@@ -193,13 +243,77 @@ func Q3(dataset []interface{}) StatsResult {
 		if err != nil {
 			return StatsResult{"Q3", math.NaN(), err}
 		}
-		return StatsResult{"Q3", q3, nil}
+		return StatsResult{"Q3", roundStat(q3), nil}
 	} else {
 		upper := data[(len(data)+1)/2:]
 		q3, err := median(upper)
 		if err != nil {
 			return StatsResult{"Q3", math.NaN(), err}
 		}
-		return StatsResult{"Q3", q3, nil}
+		return StatsResult{"Q3", roundStat(q3), nil}
 	}
 }
+
+// IQR returns the interquartile range (Q3 - Q1) of the elements in a dataset.
+func IQR(dataset []interface{}) StatsResult {
+	q1Result := Q1(dataset)
+	if q1Result.Err != nil {
+		return StatsResult{"IQR", math.NaN(), q1Result.Err}
+	}
+	q3Result := Q3(dataset)
+	if q3Result.Err != nil {
+		return StatsResult{"IQR", math.NaN(), q3Result.Err}
+	}
+
+	return StatsResult{"IQR", roundStat(q3Result.Result - q1Result.Result), nil}
+}
+
+// MAD returns the median absolute deviation of the elements in a dataset.
+// This is the median of the absolute differences between each element and the median of the dataset.
+func MAD(dataset []interface{}) StatsResult {
+	data, err := interface2F64Slice(dataset)
+	if err != nil {
+		return StatsResult{"MAD", math.NaN(), err}
+	}
+
+	med, err := median(data)
+	if err != nil {
+		return StatsResult{"MAD", math.NaN(), err}
+	}
+
+	deviations := make([]float64, len(data))
+	for i, v := range data {
+		deviations[i] = math.Abs(v - med)
+	}
+
+	mad, err := median(deviations)
+	if err != nil {
+		return StatsResult{"MAD", math.NaN(), err}
+	}
+
+	return StatsResult{"MAD", roundStat(mad), nil}
+}
+
+// First returns the first non-missing element in a dataset.
+// If every element is missing, NaN is returned.
+func First(dataset []interface{}) interface{} {
+	for _, v := range dataset {
+		if !isNaN(v) && v != "" {
+			return v
+		}
+	}
+
+	return math.NaN()
+}
+
+// Last returns the last non-missing element in a dataset.
+// If every element is missing, NaN is returned.
+func Last(dataset []interface{}) interface{} {
+	for i := len(dataset) - 1; i >= 0; i-- {
+		if !isNaN(dataset[i]) && dataset[i] != "" {
+			return dataset[i]
+		}
+	}
+
+	return math.NaN()
+}
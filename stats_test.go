@@ -10,6 +10,51 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
+func TestSetStatsPrecision(t *testing.T) {
+	defer SetStatsPrecision(3)
+
+	type setStatsPrecisionTest struct {
+		arg1          int
+		expected      []StatsResult
+		expectedError error
+	}
+	setStatsPrecisionTests := []setStatsPrecisionTest{
+		{
+			6,
+			[]StatsResult{{"Mean", 1.666667, nil}, {"Std", 0.57735, nil}, {"Median", 2.0, nil}},
+			nil,
+		},
+		{
+			-1,
+			[]StatsResult{{"Mean", 5.0 / 3.0, nil}, {"Std", math.Sqrt(1.0 / 3.0), nil}, {"Median", 2.0, nil}},
+			nil,
+		},
+		{
+			3,
+			[]StatsResult{{"Mean", 1.667, nil}, {"Std", 0.577, nil}, {"Median", 2.0, nil}},
+			nil,
+		},
+		{
+			-2,
+			[]StatsResult{{"Mean", 1.667, nil}, {"Std", 0.577, nil}, {"Median", 2.0, nil}},
+			fmt.Errorf("precision must be -1 or greater, got -2"),
+		},
+	}
+
+	dataset := []interface{}{1.0, 2.0, 2.0}
+	for _, test := range setStatsPrecisionTests {
+		err := SetStatsPrecision(test.arg1)
+		if fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected error %v, got %v", test.expectedError, err)
+		}
+
+		output := []StatsResult{Mean(dataset), Std(dataset), Median(dataset)}
+		if !cmp.Equal(output, test.expected, cmpopts.EquateApprox(0, 1e-12)) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
 func BenchmarkStatsCount(b *testing.B) {
 	list := make([]interface{}, 0)
 	for i := 0; i < 10000; i++ {
@@ -52,6 +97,14 @@ func TestStatsCount(t *testing.T) {
 				nil,
 			},
 		},
+		{
+			[]interface{}{1.0, math.NaN(), NA, nil, 4},
+			StatsResult{
+				"Count",
+				2.0,
+				nil,
+			},
+		},
 	}
 	for _, test := range countTests {
 		output := Count(test.arg1)
@@ -307,7 +360,7 @@ func TestStatsMin(t *testing.T) {
 			StatsResult{
 				"Min",
 				math.NaN(),
-				fmt.Errorf("data is not a float64: %v", "Avery"),
+				fmt.Errorf("data is not a number: %v", "Avery"),
 			},
 		},
 		{
@@ -386,7 +439,7 @@ func TestStatsMax(t *testing.T) {
 			StatsResult{
 				"Max",
 				math.NaN(),
-				fmt.Errorf("data is not a float64: %v", "Avery"),
+				fmt.Errorf("data is not a number: %v", "Avery"),
 			},
 		},
 		{
@@ -465,7 +518,7 @@ func TestStatsQ1(t *testing.T) {
 			StatsResult{
 				"Q1",
 				math.NaN(),
-				fmt.Errorf("data is not a float64: %v", "Avery"),
+				fmt.Errorf("data is not a number: %v", "Avery"),
 			},
 		},
 		{
@@ -678,3 +731,163 @@ func TestStatsQ3(t *testing.T) {
 		}
 	}
 }
+
+func BenchmarkStatsIQR(b *testing.B) {
+	list := make([]interface{}, 0)
+	for i := 0; i < 10000; i++ {
+		list = append(list, rand.Float64())
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		IQR(list)
+	}
+}
+
+func TestStatsIQR(t *testing.T) {
+	type iqrTest struct {
+		arg1     []interface{}
+		expected StatsResult
+	}
+	iqrTests := []iqrTest{
+		{
+			[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+			StatsResult{
+				"IQR",
+				math.NaN(),
+				fmt.Errorf("data is not a number: %v", "Avery"),
+			},
+		},
+		{
+			[]interface{}{},
+			StatsResult{
+				"IQR",
+				math.NaN(),
+				fmt.Errorf("no elements in this column"),
+			},
+		},
+		{
+			[]interface{}{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 100.0},
+			StatsResult{
+				"IQR",
+				Q3([]interface{}{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 100.0}).Result - Q1([]interface{}{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 100.0}).Result,
+				nil,
+			},
+		},
+		{
+			[]interface{}{10, 20, 30, 40, math.NaN(), 50, 60, 70, 80},
+			StatsResult{
+				"IQR",
+				40.0,
+				nil,
+			},
+		},
+	}
+	for _, test := range iqrTests {
+		output := IQR(test.arg1)
+		if !cmp.Equal(output, test.expected, cmpopts.EquateNaNs(), cmp.Comparer(func(x, y error) bool { return fmt.Sprint(x) == fmt.Sprint(y) })) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func BenchmarkStatsMAD(b *testing.B) {
+	list := make([]interface{}, 0)
+	for i := 0; i < 10000; i++ {
+		list = append(list, rand.Float64())
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		MAD(list)
+	}
+}
+
+func TestStatsMAD(t *testing.T) {
+	type madTest struct {
+		arg1     []interface{}
+		expected StatsResult
+	}
+	madTests := []madTest{
+		{
+			[]interface{}{"Avery", "Bradley", "Candice", "Diana"},
+			StatsResult{
+				"MAD",
+				math.NaN(),
+				fmt.Errorf("data is not a number: %v", "Avery"),
+			},
+		},
+		{
+			[]interface{}{},
+			StatsResult{
+				"MAD",
+				math.NaN(),
+				fmt.Errorf("no elements in this column"),
+			},
+		},
+		{
+			[]interface{}{1.0, 1.0, 2.0, 2.0, 4.0, 6.0, 9.0},
+			StatsResult{
+				"MAD",
+				1.0,
+				nil,
+			},
+		},
+		{
+			[]interface{}{1, 2, 3, math.NaN(), 4, 1000},
+			StatsResult{
+				"MAD",
+				1.0,
+				nil,
+			},
+		},
+	}
+	for _, test := range madTests {
+		output := MAD(test.arg1)
+		if !cmp.Equal(output, test.expected, cmpopts.EquateNaNs(), cmp.Comparer(func(x, y error) bool { return fmt.Sprint(x) == fmt.Sprint(y) })) {
+			t.Fatalf("expected %v, got %v", test.expected, output)
+		}
+	}
+}
+
+func TestStatsFirstLast(t *testing.T) {
+	type firstLastTest struct {
+		arg1          []interface{}
+		expectedFirst interface{}
+		expectedLast  interface{}
+	}
+	firstLastTests := []firstLastTest{
+		{
+			[]interface{}{"", "Bradley", "Candice", math.NaN()},
+			"Bradley",
+			"Candice",
+		},
+		{
+			[]interface{}{NA, 2, 3, NA},
+			2,
+			3,
+		},
+		{
+			[]interface{}{true, false},
+			true,
+			false,
+		},
+		{
+			[]interface{}{math.NaN(), "NaN"},
+			math.NaN(),
+			math.NaN(),
+		},
+		{
+			[]interface{}{},
+			math.NaN(),
+			math.NaN(),
+		},
+	}
+	for _, test := range firstLastTests {
+		first := First(test.arg1)
+		last := Last(test.arg1)
+		if !cmp.Equal(first, test.expectedFirst, cmpopts.EquateNaNs()) || !cmp.Equal(last, test.expectedLast, cmpopts.EquateNaNs()) {
+			t.Fatalf("expected %v and %v, got %v and %v", test.expectedFirst, test.expectedLast, first, last)
+		}
+	}
+}
@@ -1,28 +1,45 @@
 package gambas
 
 import (
+	"container/heap"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
+	"runtime"
 	"sort"
 	"strconv"
+	"strings"
 )
 
+// contextCheckInterval is the number of rows processed between checks for a cancelled context.
+const contextCheckInterval = 1000
+
 func checkTypeIntegrity(data []interface{}) (string, error) {
 	determinant := 0
 	isBool := 0
 	isInt := 0
 	isFloat64 := 0
 	isString := 0
+	hasList := false
+	hasScalar := false
 	dtype := ""
 
 	emptyValLocations := make([]int, 0)
 	for i, d := range data {
-		if d == "" {
+		if d == "" || d == NA {
 			emptyValLocations = append(emptyValLocations, i)
 			continue
 		}
+		if _, ok := d.([]interface{}); ok {
+			hasList = true
+			continue
+		}
+		if !isNaN(d) {
+			hasScalar = true
+		}
 		switch d.(type) {
 		case bool:
 			isBool = 1
@@ -35,17 +52,26 @@ func checkTypeIntegrity(data []interface{}) (string, error) {
 		}
 	}
 
+	// a column holding only lists and missing values, such as nested JSON arrays, keeps its values as they are,
+	// and lists mixed with other values make a string column like any other mix of types
+	if hasList {
+		if hasScalar {
+			return "string", nil
+		}
+		return "object", nil
+	}
+
 	determinant = isBool + isInt + isFloat64 + isString
 
 	switch determinant {
 	case 1:
-		dtype = "bool"
-	case 2:
 		if len(emptyValLocations) > 0 {
-			dtype = "float64"
+			dtype = "string"
 		} else {
-			dtype = "int"
+			dtype = "bool"
 		}
+	case 2:
+		dtype = "int"
 	case 4:
 		dtype = "float64"
 	case 8:
@@ -157,7 +183,7 @@ func tryInt(data string) (int, error) {
 
 // tryFloat64 checks if a string can be converted into float64.
 func tryFloat64(data string) (float64, error) {
-	if data == "" || data == "NaN" {
+	if isNullToken(data) {
 		return math.NaN(), nil
 	}
 	f, err := strconv.ParseFloat(data, 64)
@@ -169,7 +195,12 @@ func tryFloat64(data string) (float64, error) {
 
 // tryDataType accepts a string and tries to convert it to the correct data type.
 // It will try to convert the data into a bool, then int, then float64, and finally string.
+// Missing values are returned as empty strings, so that NewSeries can decide how to represent them
+// once the data type of the whole column is known.
 func tryDataType(data string) interface{} {
+	if isNullToken(data) {
+		return ""
+	}
 	b, err := tryBool(data)
 	if err != nil {
 		i, err := tryInt(data)
@@ -188,7 +219,7 @@ func tryDataType(data string) interface{} {
 // checkType checks to see if the data can be represented as a float64.
 // Because CSV is read as an array of strings, there has to be a way to check the type.
 func checkCSVDataType(data string) interface{} {
-	if data == "" {
+	if isNullToken(data) {
 		return math.NaN()
 	}
 	v, ok := strconv.ParseFloat(data, 64)
@@ -201,18 +232,125 @@ func checkCSVDataType(data string) interface{} {
 }
 
 // checkJsonDataType checks for JSON null data, and converts it into math.NaN().
+// Strings listed in NullTokens are also converted into math.NaN().
 func checkJsonDataType(data interface{}) interface{} {
 	if data == nil {
 		return math.NaN()
 	}
+	if str, ok := data.(string); ok && containsString(NullTokens, str) {
+		return math.NaN()
+	}
 	return data
 }
 
+// consolidateJsonColumn converts a column decoded from JSON with json.Decoder.UseNumber to the values of a single dtype.
+// Numbers written without a fraction or exponent become int, and other numbers become float64.
+// Missing values, found with checkJsonDataType, become NA or NaN depending on the dtype given by checkTypeIntegrity.
+func consolidateJsonColumn(data []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, len(data))
+	for i, d := range data {
+		switch v := checkJsonDataType(d).(type) {
+		case json.Number:
+			number, err := jsonNumberValue(v)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = number
+		case []interface{}:
+			// nested arrays are kept as list cells, with their numbers converted like the rest of the column
+			list := make([]interface{}, len(v))
+			for j, element := range v {
+				list[j] = element
+				if n, ok := element.(json.Number); ok {
+					number, err := jsonNumberValue(n)
+					if err != nil {
+						return nil, err
+					}
+					list[j] = number
+				}
+			}
+			result[i] = list
+		case float64:
+			if math.IsNaN(v) {
+				// checkTypeIntegrity treats NA as an empty value
+				result[i] = NA
+				continue
+			}
+			result[i] = v
+		default:
+			result[i] = v
+		}
+	}
+
+	dtype, err := checkTypeIntegrity(result)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range result {
+		if r == NA {
+			result[i] = missingValue(dtype)
+		}
+	}
+	return result, nil
+}
+
+// appendedDtype returns the dtype of a Series of the given dtype after value is appended to it.
+// Like in checkTypeIntegrity, int and float64 widen to float64, and other mixed types widen to string.
+// A missing value leaves the dtype unchanged, except for bool, which has no missing value and becomes string.
+func appendedDtype(dtype string, value interface{}) (string, error) {
+	var valueDtype string
+	switch value.(type) {
+	case bool:
+		valueDtype = "bool"
+	case int:
+		valueDtype = "int"
+	case float64:
+		valueDtype = "float64"
+	case string:
+		valueDtype = "string"
+	case naValue, nil:
+	default:
+		return "", fmt.Errorf("invalid data type %T; data type should be either bool, int, float64, or string", value)
+	}
+
+	switch {
+	case isNaN(value) && dtype == "bool":
+		return "string", nil
+	case isNaN(value) || valueDtype == dtype:
+		return dtype, nil
+	case (dtype == "int" || dtype == "float64") && (valueDtype == "int" || valueDtype == "float64"):
+		return "float64", nil
+	default:
+		return "string", nil
+	}
+}
+
+// jsonNumberValue converts a json.Number to an int if it is written without a fraction or exponent, and to a float64 otherwise.
+func jsonNumberValue(n json.Number) (interface{}, error) {
+	if i, err := n.Int64(); err == nil {
+		return int(i), nil
+	}
+	return n.Float64()
+}
+
+// missingValue returns the value that marks a missing element in a Series of the given dtype.
+func missingValue(dtype string) interface{} {
+	if dtype == "int" {
+		return NA
+	}
+	return math.NaN()
+}
+
+// isNullToken checks whether a string read from a file represents a missing value.
+func isNullToken(data string) bool {
+	return data == "" || containsString(NullTokens, data)
+}
+
 // consolidateToFloat64 consolidates all data in an []interface{} to float64.
 // This is necessary to convert empty string values into math.NaN().
 // In order to stay compatible with Series.data,
 // the data type of the slice is still an empty interface.
-func consolidateToFloat64(data []interface{}) []interface{} {
+func consolidateToFloat64(data []interface{}) ([]interface{}, error) {
 	result := make([]interface{}, len(data))
 	for i, d := range data {
 		switch dd := d.(type) {
@@ -220,16 +358,36 @@ func consolidateToFloat64(data []interface{}) []interface{} {
 			result[i] = dd
 		case int:
 			result[i] = float64(dd)
+		case naValue, nil:
+			result[i] = math.NaN()
 		case string:
 			if dd == "" || dd == "NaN" {
 				result[i] = math.NaN()
 			} else {
-				f, err := strconv.ParseFloat(fmt.Sprint(d), 64)
+				f, err := strconv.ParseFloat(dd, 64)
 				if err != nil {
-					panic("consolidateToFloat64 should be called only when data is in the form of float64")
+					return nil, fmt.Errorf("cannot convert %q at position %d to float64", dd, i)
 				}
 				result[i] = f
 			}
+		default:
+			return nil, fmt.Errorf("cannot convert %v of type %T at position %d to float64", d, d, i)
+		}
+	}
+
+	return result, nil
+}
+
+// consolidateToInt replaces every empty value in an int []interface{} with NA.
+// In order to stay compatible with Series.data,
+// the data type of the slice is still an empty interface.
+func consolidateToInt(data []interface{}) []interface{} {
+	result := make([]interface{}, len(data))
+	for i, d := range data {
+		if d == "" {
+			result[i] = NA
+		} else {
+			result[i] = d
 		}
 	}
 
@@ -242,7 +400,7 @@ func consolidateToFloat64(data []interface{}) []interface{} {
 func consolidateToString(data []interface{}) []interface{} {
 	result := make([]interface{}, len(data))
 	for i, d := range data {
-		if d == "" || d == "NaN" {
+		if d == "" || d == "NaN" || d == NA {
 			result[i] = math.NaN()
 		} else if conv, ok := d.(float64); ok && math.IsNaN(conv) {
 			result[i] = math.NaN()
@@ -254,21 +412,106 @@ func consolidateToString(data []interface{}) []interface{} {
 	return result
 }
 
+// isNaN checks whether a single element of a Series represents a missing value.
+// Missing values are nil, a float64 NaN, the string "NaN", or NA.
+func isNaN(data interface{}) bool {
+	switch v := data.(type) {
+	case nil:
+		return true
+	case float64:
+		return math.IsNaN(v)
+	case string:
+		return v == "NaN"
+	case naValue:
+		return true
+	}
+	return false
+}
+
+// approxSize returns the approximate number of bytes used to store a single element of a Series,
+// including the 16 bytes of the interface{} that holds it.
+func approxSize(data interface{}) int {
+	const interfaceSize = 16
+	switch v := data.(type) {
+	case bool:
+		return interfaceSize + 1
+	case int, float64:
+		return interfaceSize + 8
+	case string:
+		return interfaceSize + 16 + len(v)
+	}
+	return interfaceSize
+}
+
+// addInt64 returns the sum of a and b. ok is false if the sum overflows int64.
+func addInt64(a, b int64) (sum int64, ok bool) {
+	sum = a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, false
+	}
+	return sum, true
+}
+
+// mulInt64 returns the product of a and b. ok is false if the product overflows int64.
+func mulInt64(a, b int64) (product int64, ok bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product = a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+// roundData rounds every float64 and int element in an []interface{} to the given number of decimals.
+// int elements are only affected when decimals is negative, and they stay as int.
+// NaN and non-numeric elements are copied over as-is.
+func roundData(data []interface{}, decimals int) []interface{} {
+	factor := math.Pow(10, float64(decimals))
+	result := make([]interface{}, len(data))
+	for i, d := range data {
+		switch v := d.(type) {
+		case float64:
+			result[i] = math.Round(v*factor) / factor
+		case int:
+			if decimals < 0 {
+				result[i] = int(math.Round(float64(v)*factor) / factor)
+			} else {
+				result[i] = v
+			}
+		default:
+			result[i] = d
+		}
+	}
+
+	return result
+}
+
+// clipBound returns bound as an int if dtype is int and bound is a whole number, so that int Series stay int.
+func clipBound(bound float64, dtype string) interface{} {
+	if dtype == "int" && bound == math.Trunc(bound) {
+		return int(bound)
+	}
+	return bound
+}
+
 // interface2F64Data() converts a slice of interface{} into F64Data.
+// Any numeric type is converted using i2f, and NaN values are skipped.
 func interface2F64Slice(data []interface{}) ([]float64, error) {
 	fd := make([]float64, 0)
 	for _, v := range data {
-		switch converted := v.(type) {
-		case float64:
-			if math.IsNaN(converted) {
-				continue
-			}
-			fd = append(fd, converted)
-		// case int:
-		// 	fd = append(fd, float64(converted))
-		default:
-			return nil, fmt.Errorf("data is not a float64: %v", v)
+		if isNaN(v) {
+			continue
+		}
+		converted, err := i2f(v)
+		if err != nil {
+			return nil, fmt.Errorf("data is not a number: %v", v)
 		}
+		if math.IsNaN(converted) {
+			continue
+		}
+		fd = append(fd, converted)
 	}
 
 	return fd, nil
@@ -302,6 +545,183 @@ func slicesAreEqual(slice1, slice2 []interface{}) bool {
 	return true
 }
 
+// stringSlicesAreEqual checks whether two string slices are equal.
+func stringSlicesAreEqual(slice1, slice2 []string) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+	for i, v := range slice1 {
+		if v != slice2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// rowKey returns a string key for the values of a row in the given series.
+// Two rows get the same key only if all of their values are equal.
+func rowKey(series []Series, row int) string {
+	values := make([]interface{}, len(series))
+	for i, ser := range series {
+		values[i] = ser.data[row]
+	}
+	return fmt.Sprintf("%#v", values)
+}
+
+// compareValues returns -1 if a sorts before b, 1 if a sorts after b, and 0 if they are equal.
+// Numbers are compared by value, bools sort false first, and other values are compared as strings.
+// NaN values sort after everything else.
+func compareValues(a, b interface{}) int {
+	aIsNaN, bIsNaN := isNaN(a), isNaN(b)
+	switch {
+	case aIsNaN && bIsNaN:
+		return 0
+	case aIsNaN:
+		return 1
+	case bIsNaN:
+		return -1
+	}
+
+	fa, errA := i2f(a)
+	fb, errB := i2f(b)
+	if errA == nil && errB == nil {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+
+	ba, okA := a.(bool)
+	bb, okB := b.(bool)
+	if okA && okB {
+		switch {
+		case ba == bb:
+			return 0
+		case !ba:
+			return -1
+		}
+		return 1
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// floatsAreEqual checks whether two float64 values are equal, treating two NaN values as equal.
+func floatsAreEqual(v1, v2 float64) bool {
+	if math.IsNaN(v1) || math.IsNaN(v2) {
+		return math.IsNaN(v1) && math.IsNaN(v2)
+	}
+	return v1 == v2
+}
+
+// jsonValue returns data in a form that can be encoded to JSON. NaN float64 values become nil, which is encoded as null.
+// Whole float64 values keep a fraction, such as 19.0, so that they are not read back as int.
+// The elements of list cells are converted the same way.
+func jsonValue(data interface{}) interface{} {
+	if list, ok := data.([]interface{}); ok {
+		converted := make([]interface{}, len(list))
+		for i, element := range list {
+			converted[i] = jsonValue(element)
+		}
+		return converted
+	}
+
+	v, ok := data.(float64)
+	if !ok {
+		return data
+	}
+	if math.IsNaN(v) {
+		return nil
+	}
+	if math.IsInf(v, 0) {
+		return data
+	}
+	str := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(str, ".eE") {
+		str += ".0"
+	}
+	return json.Number(str)
+}
+
+// gobValue returns data in a form that can be encoded with gob.
+// NA has no exported fields, so it is sent as nil and restored by fromJsonValue.
+func gobValue(data interface{}) interface{} {
+	if data == NA {
+		return nil
+	}
+	return data
+}
+
+// fromJsonValue converts a value decoded from JSON back to the given dtype.
+// null becomes NA in int columns and NaN elsewhere.
+// JSON numbers are converted with jsonNumberValue, then turned into ints for int columns and float64 values for float64 columns.
+// The elements of list cells are converted without a dtype, so each number keeps the type it was written with.
+func fromJsonValue(data interface{}, dtype string) (interface{}, error) {
+	switch v := data.(type) {
+	case nil:
+		return missingValue(dtype), nil
+	case json.Number:
+		number, err := jsonNumberValue(v)
+		if err != nil {
+			return nil, err
+		}
+		data = number
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, element := range v {
+			converted, err := fromJsonValue(element, "")
+			if err != nil {
+				return nil, err
+			}
+			list[i] = converted
+		}
+		return list, nil
+	}
+
+	switch v := data.(type) {
+	case float64:
+		if dtype == "int" && v == math.Trunc(v) {
+			return int(v), nil
+		}
+	case int:
+		if dtype == "float64" {
+			return float64(v), nil
+		}
+	}
+	return data, nil
+}
+
+// labelsAreEqual checks whether two index labels are equal.
+// Numeric labels are compared by value, so an int label of 3 matches a float64 label of 3.0.
+func labelsAreEqual(label1, label2 interface{}) bool {
+	if label1 == label2 {
+		return true
+	}
+
+	f1, err1 := i2f(label1)
+	f2, err2 := i2f(label2)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return f1 == f2
+}
+
+// tuplesAreEqual checks whether two index tuples have the same length and equal labels at each level.
+func tuplesAreEqual(tuple1, tuple2 []interface{}) bool {
+	if len(tuple1) != len(tuple2) {
+		return false
+	}
+	for i := range tuple1 {
+		if !labelsAreEqual(tuple1[i], tuple2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // containsString checks whether a string exists in a slice of strings.
 func containsString(strSlice []string, str string) bool {
 	for _, data := range strSlice {
@@ -347,25 +767,47 @@ func containsSlice(s1 [][]interface{}, s2 []interface{}) bool {
 // Summary statistics functions (internal use only)
 
 // median() returns the median of the elements in an array.
+// The array is sorted in place.
 func median(data []float64) (float64, error) {
-	median := 0.0
-	sort.Float64s(data)
-
 	total := len(data)
 	if total == 0 {
 		return math.NaN(), fmt.Errorf("no elements in this column")
 	}
+	sort.Float64s(data)
+
 	if total%2 == 0 {
-		lower := data[total/2-1]
-		upper := data[total/2]
+		return (data[total/2-1] + data[total/2]) / 2, nil
+	}
+	return data[total/2], nil
+}
 
-		median = (lower + upper) / 2
-	} else {
-		median := data[(total+1)/2-1]
-		return median, nil
+// pearson returns the Pearson correlation coefficient of x and y, which must have the same length.
+func pearson(x, y []float64) (float64, error) {
+	if len(x) < 2 {
+		return math.NaN(), fmt.Errorf("not enough elements to compute correlation")
+	}
+
+	meanX, meanY := 0.0, 0.0
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
 	}
+	meanX /= float64(len(x))
+	meanY /= float64(len(y))
 
-	return median, nil
+	covariance, varianceX, varianceY := 0.0, 0.0, 0.0
+	for i := range x {
+		dx := x[i] - meanX
+		dy := y[i] - meanY
+		covariance += dx * dy
+		varianceX += dx * dx
+		varianceY += dy * dy
+	}
+	if varianceX == 0 || varianceY == 0 {
+		return math.NaN(), fmt.Errorf("cannot compute correlation of constant data")
+	}
+
+	return covariance / math.Sqrt(varianceX*varianceY), nil
 }
 
 // copyDf takes a source DataFrame and returns a copy of it with different memory address.
@@ -386,6 +828,60 @@ func copyDf(src *DataFrame) DataFrame {
 	return *newDf
 }
 
+// intSeriesToFloat64 converts the data of an int Series to float64 in place, turning NA into NaN,
+// so that float64 arithmetic and comparisons can be applied to it.
+// Series of other data types are left untouched.
+func intSeriesToFloat64(ser *Series) error {
+	if ser.dtype != "int" {
+		return nil
+	}
+	data, err := consolidateToFloat64(ser.data)
+	if err != nil {
+		return err
+	}
+	ser.data = data
+	ser.dtype = "float64"
+	return nil
+}
+
+// copySeries creates a deep copy of a Series object.
+func copySeries(src *Series) Series {
+	newS := new(Series)
+	newS.data = append(newS.data, src.data...)
+	newS.index.index = append(newS.index.index, src.index.index...)
+	newS.index.names = append(newS.index.names, src.index.names...)
+	newS.name = src.name
+	newS.dtype = src.dtype
+
+	return *newS
+}
+
+// selectRows returns a new DataFrame object containing the rows at the given positions, in the given order.
+// Index values and column dtypes are carried over from src.
+func selectRows(src *DataFrame, positions []int) DataFrame {
+	newDf := new(DataFrame)
+	newDf.index.index = make([]Index, len(positions))
+	for i, pos := range positions {
+		newDf.index.index[i] = src.index.index[pos]
+	}
+	newDf.index.names = append(newDf.index.names, src.index.names...)
+	newDf.columns = append(newDf.columns, src.columns...)
+
+	newDf.series = make([]Series, len(src.series))
+	for i, ser := range src.series {
+		newDf.series[i].data = make([]interface{}, len(positions))
+		for j, pos := range positions {
+			newDf.series[i].data[j] = ser.data[pos]
+		}
+		newDf.series[i].index.index = append(newDf.series[i].index.index, newDf.index.index...)
+		newDf.series[i].index.names = append(newDf.series[i].index.names, newDf.index.names...)
+		newDf.series[i].name = ser.name
+		newDf.series[i].dtype = ser.dtype
+	}
+
+	return *newDf
+}
+
 // readCsvColIntoData extracts a column in a CSV file to a [][]interface{}.
 func readCsvColIntoData(filepath string, col string) ([][]interface{}, error) {
 	f, err := os.Open(filepath)
@@ -471,3 +967,191 @@ func hoarePartition(arr []float64, lo int, hi int) int {
 		arr[i], arr[j] = arr[j], arr[i]
 	}
 }
+
+// valuePosition pairs a numeric value with the position it was found at.
+type valuePosition struct {
+	value float64
+	pos   int
+}
+
+// valuePositionHeap is a heap of valuePosition objects whose root is the "worst" item according to worse.
+type valuePositionHeap struct {
+	items []valuePosition
+	worse func(a, b valuePosition) bool
+}
+
+func (h valuePositionHeap) Len() int            { return len(h.items) }
+func (h valuePositionHeap) Less(i, j int) bool  { return h.worse(h.items[i], h.items[j]) }
+func (h valuePositionHeap) Swap(i, j int)       { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *valuePositionHeap) Push(x interface{}) { h.items = append(h.items, x.(valuePosition)) }
+func (h *valuePositionHeap) Pop() interface{} {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// nExtremePositions returns the positions of the n largest (or smallest) numeric values in data,
+// ordered from the most extreme value. Ties are broken by position, so earlier values come first.
+// NaN values are skipped. Only the n best candidates are kept in a heap, so the data is never fully sorted.
+func nExtremePositions(data []interface{}, n int, largest bool) ([]int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("n cannot be negative: %d", n)
+	}
+
+	h := &valuePositionHeap{
+		worse: func(a, b valuePosition) bool {
+			if a.value != b.value {
+				if largest {
+					return a.value < b.value
+				}
+				return a.value > b.value
+			}
+			return a.pos > b.pos
+		},
+	}
+
+	for i, d := range data {
+		if isNaN(d) {
+			continue
+		}
+		v, err := i2f(d)
+		if err != nil {
+			return nil, err
+		}
+
+		heap.Push(h, valuePosition{v, i})
+		if h.Len() > n {
+			heap.Pop(h)
+		}
+	}
+
+	positions := make([]int, h.Len())
+	for i := len(positions) - 1; i >= 0; i-- {
+		positions[i] = heap.Pop(h).(valuePosition).pos
+	}
+
+	return positions, nil
+}
+
+// checkReplaceValue checks that newValue can be used as a replacement in Replace.
+func checkReplaceValue(newValue interface{}) error {
+	switch newValue.(type) {
+	case bool, int, float64, string:
+		return nil
+	default:
+		return fmt.Errorf("new value %v is not a bool, int, float64, or string", newValue)
+	}
+}
+
+// wholeFloatsToInt converts float64 data to int if every value is a whole number within the range of int.
+// NaN values become NA. ok is false if any value cannot be converted, or if every value is NaN.
+func wholeFloatsToInt(data []interface{}) (converted []interface{}, ok bool) {
+	converted = make([]interface{}, len(data))
+	hasValue := false
+	for i, d := range data {
+		if isNaN(d) {
+			converted[i] = NA
+			continue
+		}
+		v, isFloat := d.(float64)
+		if !isFloat || v != math.Trunc(v) || v < float64(math.MinInt64) || v >= float64(math.MaxInt64) {
+			return nil, false
+		}
+		converted[i] = int(v)
+		hasValue = true
+	}
+
+	return converted, hasValue
+}
+
+// formatCell converts a value to the string used by Print and the CSV writers.
+// Missing values become nanRep, and float64 values are formatted with floatFormat if it is set.
+func formatCell(data interface{}) string {
+	if isNaN(data) {
+		return nanRep
+	}
+	if v, ok := data.(float64); ok && floatFormat != "" {
+		return fmt.Sprintf(floatFormat, v)
+	}
+	return fmt.Sprint(data)
+}
+
+// truncatedPositions returns the positions 0 to n-1, or if n is greater than max, the first and last positions
+// with -1 in between to mark the ones left out, so that max positions are shown in total.
+// A max of 0 or less means no limit.
+func truncatedPositions(n, max int) []int {
+	positions := make([]int, 0)
+	if max <= 0 || n <= max {
+		for i := 0; i < n; i++ {
+			positions = append(positions, i)
+		}
+		return positions
+	}
+
+	head := (max + 1) / 2
+	for i := 0; i < head; i++ {
+		positions = append(positions, i)
+	}
+	positions = append(positions, -1)
+	for i := n - (max - head); i < n; i++ {
+		positions = append(positions, i)
+	}
+	return positions
+}
+
+// globRoot returns the longest leading directory of pattern that holds no pattern characters.
+// Like in `filepath.Match`, a backslash escapes the next character except on Windows, where it is the path separator.
+func globRoot(pattern string) string {
+	metaChars := "*?["
+	if runtime.GOOS != "windows" {
+		metaChars += `\`
+	}
+
+	root := filepath.Dir(pattern)
+	for strings.ContainsAny(root, metaChars) {
+		root = filepath.Dir(root)
+	}
+	return root
+}
+
+// coerceSeries converts the values of ser to dtype, with missing values becoming the missing value of dtype.
+// It is used by ReadCsvChunks to give every chunk the dtypes inferred from the first chunk.
+func coerceSeries(ser *Series, dtype string) error {
+	if ser.dtype == dtype {
+		return nil
+	}
+
+	data := make([]interface{}, len(ser.data))
+	for i, d := range ser.data {
+		if isNaN(d) {
+			data[i] = missingValue(dtype)
+			continue
+		}
+
+		var err error
+		switch dtype {
+		case "string":
+			data[i] = fmt.Sprint(d)
+		case "float64":
+			data[i], err = i2f(d)
+		case "int":
+			v, isFloat := d.(float64)
+			if !isFloat || v != math.Trunc(v) || v < float64(math.MinInt64) || v >= float64(math.MaxInt64) {
+				err = fmt.Errorf("%v is not a whole number", d)
+				break
+			}
+			data[i] = int(v)
+		case "bool":
+			data[i], err = tryBool(fmt.Sprint(d))
+		default:
+			err = fmt.Errorf("unsupported dtype %v", dtype)
+		}
+		if err != nil {
+			return fmt.Errorf("cannot convert column '%v' to %v: %v", ser.name, dtype, err)
+		}
+	}
+
+	ser.data = data
+	ser.dtype = dtype
+	return nil
+}
@@ -49,9 +49,21 @@ func TestCheckTypeIntegrity(t *testing.T) {
 			[]interface{}{true, false, true},
 			"bool",
 		},
+		{
+			[]interface{}{true, "", false},
+			"string",
+		},
 		{
 			[]interface{}{"", 1, 2, 3},
-			"float64",
+			"int",
+		},
+		{
+			[]interface{}{[]interface{}{1, 2}, math.NaN(), []interface{}{"a"}},
+			"object",
+		},
+		{
+			[]interface{}{[]interface{}{1}, "x", 3},
+			"string",
 		},
 		{
 			[]interface{}{1.0, "", 2.0},
@@ -481,27 +493,46 @@ func BenchmarkConsolidateToFloat64(b *testing.B) {
 
 func TestConsolidateToFloat64(t *testing.T) {
 	type consolidateToFloat64Test struct {
-		arg1     []interface{}
-		expected []interface{}
+		arg1          []interface{}
+		expected      []interface{}
+		expectedError error
 	}
 	consolidateToFloat64Tests := []consolidateToFloat64Test{
 		{
 			[]interface{}{1.0, 2.0, 3.0},
 			[]interface{}{1.0, 2.0, 3.0},
+			nil,
 		},
 		{
 			[]interface{}{"", 2.0, 3.0},
 			[]interface{}{math.NaN(), 2.0, 3.0},
+			nil,
 		},
 		{
 			[]interface{}{"", ""},
 			[]interface{}{math.NaN(), math.NaN()},
+			nil,
+		},
+		{
+			[]interface{}{1, NA, "2.5"},
+			[]interface{}{1.0, math.NaN(), 2.5},
+			nil,
+		},
+		{
+			[]interface{}{1.0, "abc", 3.0},
+			nil,
+			fmt.Errorf("cannot convert \"abc\" at position 1 to float64"),
+		},
+		{
+			[]interface{}{1.0, true},
+			nil,
+			fmt.Errorf("cannot convert true of type bool at position 1 to float64"),
 		},
 	}
 	for _, test := range consolidateToFloat64Tests {
-		output := consolidateToFloat64(test.arg1)
-		if !cmp.Equal(output, test.expected, cmpopts.EquateNaNs()) {
-			t.Fatalf("expected %v, got %v", test.expected, output)
+		output, err := consolidateToFloat64(test.arg1)
+		if !cmp.Equal(output, test.expected, cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
 		}
 	}
 }
@@ -576,7 +607,7 @@ func TestInterface2F64Slice(t *testing.T) {
 		},
 		{
 			[]interface{}{0, 1, 2, 3, 4},
-			nil,
+			[]float64{0.0, 1.0, 2.0, 3.0, 4.0},
 		},
 		{
 			[]interface{}{"0", "1", "2", "3", "4"},
@@ -588,7 +619,11 @@ func TestInterface2F64Slice(t *testing.T) {
 		},
 		{
 			[]interface{}{0.5, 1, 1.5, 2, 2.5},
-			nil,
+			[]float64{0.5, 1.0, 1.5, 2.0, 2.5},
+		},
+		{
+			[]interface{}{0.5, math.NaN(), 2},
+			[]float64{0.5, 2.0},
 		},
 		{
 			[]interface{}{"a", 1, "b", 2, "c", 3},
@@ -603,6 +638,64 @@ func TestInterface2F64Slice(t *testing.T) {
 	}
 }
 
+func BenchmarkMedian(b *testing.B) {
+	list := make([]float64, 10000)
+	for i := range list {
+		list[i] = rand.Float64()
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		median(list)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	type medianTest struct {
+		arg1          []float64
+		expected      float64
+		expectedError error
+	}
+	medianTests := []medianTest{
+		{
+			[]float64{5.0},
+			5.0,
+			nil,
+		},
+		{
+			[]float64{3.0, 1.0},
+			2.0,
+			nil,
+		},
+		{
+			[]float64{9.0, 1.0, 5.0},
+			5.0,
+			nil,
+		},
+		{
+			[]float64{4.0, 8.0, 1.0, 2.0},
+			3.0,
+			nil,
+		},
+		{
+			[]float64{7.0, -2.0, 3.5, 10.0, 0.0},
+			3.5,
+			nil,
+		},
+		{
+			[]float64{},
+			math.NaN(),
+			fmt.Errorf("no elements in this column"),
+		},
+	}
+	for _, test := range medianTests {
+		output, err := median(test.arg1)
+		if !cmp.Equal(output, test.expected, cmpopts.EquateNaNs()) || fmt.Sprint(err) != fmt.Sprint(test.expectedError) {
+			t.Fatalf("expected %v, got %v, error %v", test.expected, output, err)
+		}
+	}
+}
+
 func BenchmarkInterface2StringSlice(b *testing.B) {
 	list := make([]interface{}, 0)
 	for i := 0; i < 10000; i++ {